@@ -0,0 +1,41 @@
+package interpolators
+
+import "math"
+
+// Align estimates the sub-sample delay of b relative to a, searching lags within
+// ±maxLag samples, and resamples b onto the grid of a so that aligned[i]
+// corresponds to a[i]. The correlation peak is refined with the given interpolator
+// and the same interpolator resamples b. Positions of aligned that fall outside b
+// are zero. A positive lag means b lags behind a.
+func Align(a, b []float64, maxLag float64, interpolatorType InterpolatorType) (lag float64, aligned []float64) {
+	aligned = make([]float64, len(a))
+	if len(a) == 0 || len(b) == 0 || maxLag < 0 {
+		return 0, aligned
+	}
+
+	searchLag := int(math.Ceil(maxLag))
+	if longest := len(a) + len(b) - 2; searchLag > longest {
+		searchLag = longest
+	}
+	r := crossCorrelate(a, b, searchLag)
+
+	best := 0
+	for k := range r {
+		if r[k] > r[best] {
+			best = k
+		}
+	}
+	peak, _ := RefinePeak(r, best, interpolatorType)
+	lag = math.Max(-maxLag, math.Min(maxLag, peak-float64(searchLag)))
+
+	f := newEvaluator(b, interpolatorType)
+	last := float64(len(b) - 1)
+	for i := range aligned {
+		pos := float64(i) + lag
+		if pos < 0 || pos > last {
+			continue
+		}
+		aligned[i] = f(pos)
+	}
+	return lag, aligned
+}
@@ -0,0 +1,48 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAlign(t *testing.T) {
+	n := 256
+	delay := 5.6
+	pulse := func(x float64) float64 { return math.Exp(-math.Pow(x-120, 2) / 80) }
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for i := range a {
+		a[i] = pulse(float64(i))
+		b[i] = pulse(float64(i) - delay)
+	}
+
+	for _, typ := range []InterpolatorType{CubicSpline, Lagrange4, Hermite4} {
+		lag, aligned := Align(a, b, 20, typ)
+		if math.Abs(lag-delay) > 0.05 {
+			t.Errorf("Align(%d) lag = %v, want %v", typ, lag, delay)
+		}
+		if len(aligned) != len(a) {
+			t.Fatalf("Align(%d) aligned length = %d, want %d", typ, len(aligned), len(a))
+		}
+		stats := Compare(a, aligned)
+		if stats.MaxError > 0.01 {
+			t.Errorf("Align(%d) residual max error = %v, want below 0.01", typ, stats.MaxError)
+		}
+	}
+}
+
+func TestAlignMaxLag(t *testing.T) {
+	a := []float64{0, 0, 1, 0, 0, 0, 0, 0}
+	b := []float64{0, 0, 0, 0, 0, 0, 1, 0}
+	lag, _ := Align(a, b, 2, Linear)
+	if math.Abs(lag) > 2 {
+		t.Errorf("Align() lag = %v, want within ±2", lag)
+	}
+}
+
+func TestAlignEmpty(t *testing.T) {
+	lag, aligned := Align(nil, []float64{1, 2}, 3, Linear)
+	if lag != 0 || len(aligned) != 0 {
+		t.Errorf("Align() on empty input = (%v, %v), want (0, [])", lag, aligned)
+	}
+}
@@ -0,0 +1,91 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// AngleUnit selects the unit of angular samples
+type AngleUnit int
+
+const (
+	// Radians measures a full turn as 2π
+	Radians AngleUnit = iota
+	// Degrees measures a full turn as 360
+	Degrees
+)
+
+// turn returns the size of a full turn in the unit
+func (u AngleUnit) turn() (float64, error) {
+	switch u {
+	case Radians:
+		return 2 * math.Pi, nil
+	case Degrees:
+		return 360, nil
+	}
+	return 0, fmt.Errorf("unknown angle unit %d", u)
+}
+
+// InterpolateAngles resamples angular samples such as headings or phases like
+// Interpolate, following the shortest arc between successive samples, so a
+// heading that crosses from 359° to 1° turns by 2° instead of swinging back
+// through 180°. The output is wrapped into [0, turn) when every input is
+// non-negative, as compass headings are, and into [-turn/2, turn/2) otherwise,
+// as phases from math.Atan2 are.
+func InterpolateAngles(in []float64, outSamples int, unit AngleUnit, interpolatorType InterpolatorType) ([]float64, error) {
+	turn, err := unit.turn()
+	if err != nil {
+		return nil, err
+	}
+	unwrapped, _ := UnwrapAngles(in, unit)
+	out, err := Interpolate(unwrapped, outSamples, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	lo := -turn / 2
+	if len(in) > 0 && minValue(in) >= 0 {
+		lo = 0
+	}
+	for i, v := range out {
+		out[i] = wrapAngle(v, lo, turn)
+	}
+	return out, nil
+}
+
+// UnwrapAngles returns the angles with whole turns added so that successive
+// samples differ by less than half a turn, starting from the first sample. The
+// result is continuous and can be fed to any interpolator or derivative.
+func UnwrapAngles(in []float64, unit AngleUnit) ([]float64, error) {
+	turn, err := unit.turn()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(in))
+	for i, v := range in {
+		if i == 0 {
+			out[i] = v
+			continue
+		}
+		out[i] = out[i-1] + wrapAngle(v-in[i-1], -turn/2, turn)
+	}
+	return out, nil
+}
+
+// wrapAngle returns v shifted by whole turns into [lo, lo+turn)
+func wrapAngle(v, lo, turn float64) float64 {
+	w := v - turn*math.Floor((v-lo)/turn)
+	if w >= lo+turn {
+		// Rounding can land exactly on the open end
+		w -= turn
+	}
+	return w
+}
+
+// minValue returns the smallest element of a non-empty slice
+func minValue(in []float64) float64 {
+	m := in[0]
+	for _, v := range in[1:] {
+		m = math.Min(m, v)
+	}
+	return m
+}
@@ -0,0 +1,84 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateAnglesWrap(t *testing.T) {
+	// A heading crossing north turns through 0° rather than back through 180°
+	out, err := InterpolateAngles([]float64{350, 10}, 5, Degrees, Linear)
+	if err != nil {
+		t.Fatalf("InterpolateAngles returned unexpected error: %v", err)
+	}
+	want := []float64{350, 355, 0, 5, 10}
+	for i := range want {
+		if math.Abs(out[i]-want[i]) > 1e-9 {
+			t.Errorf("InterpolateAngles(degrees)[%d] = %v, want %v", i, out[i], want[i])
+		}
+	}
+
+	// Phases from Atan2 stay in [-π, π) and cross the branch cut smoothly
+	out, err = InterpolateAngles([]float64{2.8, -3.0, -2.6}, 5, Radians, Linear)
+	if err != nil {
+		t.Fatalf("InterpolateAngles returned unexpected error: %v", err)
+	}
+	unwrapped := []float64{2.8, 2.8 + (2*math.Pi-5.8)/2, 2*math.Pi - 3.0, 2*math.Pi - 2.8, 2*math.Pi - 2.6}
+	for i, u := range unwrapped {
+		want := u
+		if want >= math.Pi {
+			want -= 2 * math.Pi
+		}
+		if math.Abs(out[i]-want) > 1e-9 {
+			t.Errorf("InterpolateAngles(radians)[%d] = %v, want %v", i, out[i], want)
+		}
+		if out[i] < -math.Pi || out[i] >= math.Pi {
+			t.Errorf("InterpolateAngles(radians)[%d] = %v, outside [-π, π)", i, out[i])
+		}
+	}
+}
+
+func TestInterpolateAnglesSmooth(t *testing.T) {
+	// A heading spinning steadily through many turns, which interpolants that
+	// reproduce straight lines follow exactly
+	in := make([]float64, 40)
+	for i := range in {
+		in[i] = math.Mod(37*float64(i), 360)
+	}
+	for _, typ := range []InterpolatorType{Linear, CubicSpline, MonotonicCubic, Akima} {
+		out, err := InterpolateAngles(in, 157, Degrees, typ)
+		if err != nil {
+			t.Fatalf("InterpolateAngles(%v) returned unexpected error: %v", typ, err)
+		}
+		for i, v := range out {
+			want := math.Mod(37*outputPosition(i, len(in), len(out)), 360)
+			d := math.Abs(v - want)
+			d = math.Min(d, 360-d)
+			if d > 1e-6 || v < 0 || v >= 360 {
+				t.Errorf("InterpolateAngles(%v)[%d] = %v, want %v", typ, i, v, want)
+			}
+		}
+	}
+}
+
+func TestUnwrapAngles(t *testing.T) {
+	got, err := UnwrapAngles([]float64{170, -170, -150, 160, 100}, Degrees)
+	if err != nil {
+		t.Fatalf("UnwrapAngles returned unexpected error: %v", err)
+	}
+	want := []float64{170, 190, 210, 160, 100}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Errorf("UnwrapAngles[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if _, err := UnwrapAngles([]float64{1}, AngleUnit(7)); err == nil {
+		t.Error("UnwrapAngles with an unknown unit returned nil error")
+	}
+	if _, err := InterpolateAngles([]float64{1, 2}, 4, AngleUnit(7), Linear); err == nil {
+		t.Error("InterpolateAngles with an unknown unit returned nil error")
+	}
+	if out, err := InterpolateAngles(nil, 4, Radians, Linear); err != nil || len(out) != 0 {
+		t.Errorf("InterpolateAngles(nil) = %v, %v, want empty output", out, err)
+	}
+}
@@ -0,0 +1,140 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// curve is a parametric curve through points, each coordinate interpolated over
+// the point index
+type curve struct {
+	coords []func(float64) float64
+	slopes []func(float64) float64
+	n      int
+}
+
+// newCurve checks points and fits a curve through them. All points must have the
+// same number of coordinates and the interpolator must be continuous.
+func newCurve(points [][]float64, interpolatorType InterpolatorType) (*curve, error) {
+	dim, err := checkPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	if info, ok := infoOf(interpolatorType); !ok || info.Continuity < 0 {
+		return nil, fmt.Errorf("arc length needs a continuous interpolator, got %v", interpolatorType)
+	}
+	if need := minPoints(interpolatorType); len(points) > 1 && len(points) < need {
+		return nil, fmt.Errorf("%w: %v needs at least %d points, got %d", ErrTooFewPoints, interpolatorType, need, len(points))
+	}
+
+	c := &curve{n: len(points)}
+	column := make([]float64, len(points))
+	for d := 0; d < dim; d++ {
+		for i, p := range points {
+			column[i] = p[d]
+		}
+		in := append([]float64(nil), column...)
+		slope, err := newDerivativeEvaluator(in, interpolatorType, 1)
+		if err != nil {
+			return nil, err
+		}
+		c.coords = append(c.coords, newEvaluator(in, interpolatorType))
+		c.slopes = append(c.slopes, slope)
+	}
+	return c, nil
+}
+
+// at evaluates the curve at parameter u
+func (c *curve) at(u float64) []float64 {
+	p := make([]float64, len(c.coords))
+	for d, f := range c.coords {
+		p[d] = f(u)
+	}
+	return p
+}
+
+// speed is the length of the curve's tangent at parameter u
+func (c *curve) speed(u float64) float64 {
+	sum := 0.0
+	for _, f := range c.slopes {
+		v := f(u)
+		sum += v * v
+	}
+	return math.Sqrt(sum)
+}
+
+// lengths returns the cumulative arc length at each integer parameter
+func (c *curve) lengths() []float64 {
+	cum := make([]float64, c.n)
+	for k := 1; k < c.n; k++ {
+		cum[k] = cum[k-1] + c.length(float64(k-1), float64(k))
+	}
+	return cum
+}
+
+// length is the arc length between parameters a and b within one unit interval,
+// integrated in quarters since the speed is not a polynomial
+func (c *curve) length(a, b float64) float64 {
+	const pieces = 4
+	sum := 0.0
+	h := (b - a) / pieces
+	for i := 0; i < pieces; i++ {
+		sum += integrate(c.speed, a+float64(i)*h, a+float64(i+1)*h)
+	}
+	return sum
+}
+
+// ArcLength returns the length of the curve through points, each a point with the
+// same number of coordinates, when every coordinate is interpolated over the point
+// index with the given continuous interpolator
+func ArcLength(points [][]float64, interpolatorType InterpolatorType) (float64, error) {
+	c, err := newCurve(points, interpolatorType)
+	if err != nil {
+		return 0, err
+	}
+	cum := c.lengths()
+	return cum[len(cum)-1], nil
+}
+
+// ResampleByArcLength returns outSamples points equally spaced along the curve
+// through points, from the first point to the last, so that traversing them at a
+// constant rate moves at constant speed. The curve is the one measured by ArcLength.
+func ResampleByArcLength(points [][]float64, outSamples int, interpolatorType InterpolatorType) ([][]float64, error) {
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	c, err := newCurve(points, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	cum := c.lengths()
+	total := cum[len(cum)-1]
+
+	out := make([][]float64, outSamples)
+	for i := range out {
+		target := outputPosition(i, 2, outSamples) * total
+		// Unit interval containing the target length
+		k := sort.SearchFloat64s(cum, target) - 1
+		if k < 0 || total == 0 {
+			out[i] = c.at(0)
+			continue
+		}
+		if k > c.n-2 {
+			k = c.n - 2
+		}
+		// Bisect for the parameter reaching the remaining length
+		rest := target - cum[k]
+		lo, hi := float64(k), float64(k+1)
+		for hi-lo > refineTolerance {
+			mid := (lo + hi) / 2
+			if c.length(float64(k), mid) < rest {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		out[i] = c.at((lo + hi) / 2)
+	}
+	return out, nil
+}
@@ -0,0 +1,88 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArcLengthPolyline(t *testing.T) {
+	points := [][]float64{{0, 0}, {3, 4}, {3, 10}, {0, 14}}
+	got, err := ArcLength(points, Linear)
+	if err != nil {
+		t.Fatalf("ArcLength() returned unexpected error: %v", err)
+	}
+	if want := 5.0 + 6 + 5; math.Abs(got-want) > 1e-12 {
+		t.Errorf("ArcLength() = %v, want %v", got, want)
+	}
+}
+
+func TestArcLengthCircle(t *testing.T) {
+	// A dense sampling of a unit circle is close to 2π long
+	points := make([][]float64, 65)
+	for i := range points {
+		a := 2 * math.Pi * float64(i) / 64
+		points[i] = []float64{math.Cos(a), math.Sin(a)}
+	}
+	got, _ := ArcLength(points, CubicSpline)
+	if math.Abs(got-2*math.Pi) > 1e-3 {
+		t.Errorf("ArcLength() = %v, want %v", got, 2*math.Pi)
+	}
+}
+
+func TestResampleByArcLength(t *testing.T) {
+	// Unevenly spaced samples along a straight line come out evenly spaced
+	points := [][]float64{{0, 0, 0}, {0.5, 1, 0}, {4, 8, 0}, {5, 10, 0}}
+	got, err := ResampleByArcLength(points, 6, Linear)
+	if err != nil {
+		t.Fatalf("ResampleByArcLength() returned unexpected error: %v", err)
+	}
+	for i, p := range got {
+		want := []float64{float64(i), 2 * float64(i), 0}
+		for d := range want {
+			if math.Abs(p[d]-want[d]) > 1e-8 {
+				t.Errorf("ResampleByArcLength()[%d] = %v, want %v", i, p, want)
+				break
+			}
+		}
+	}
+
+	// Consecutive outputs are equally far apart along a smooth curve; chords are
+	// slightly shorter than the arcs they span
+	curve := make([][]float64, 20)
+	for i := range curve {
+		x := float64(i * i) / 40
+		curve[i] = []float64{x, math.Sin(x)}
+	}
+	out, _ := ResampleByArcLength(curve, 50, CubicSpline)
+	total, _ := ArcLength(curve, CubicSpline)
+	for i := 1; i < len(out); i++ {
+		step, _ := ArcLength(out[i-1:i+1], Linear)
+		if math.Abs(step-total/49) > 1e-2*total/49 {
+			t.Errorf("chord %d = %v, want about %v", i, step, total/49)
+		}
+	}
+}
+
+func TestArcLengthErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		points [][]float64
+		typ    InterpolatorType
+	}{
+		{"no points", nil, Linear},
+		{"ragged", [][]float64{{0, 0}, {1}}, Linear},
+		{"no coordinates", [][]float64{{}, {}}, Linear},
+		{"discontinuous", [][]float64{{0}, {1}}, DropSample},
+		{"too few", [][]float64{{0}, {1}, {2}}, Lanczos3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ArcLength(tt.points, tt.typ); err == nil {
+				t.Error("ArcLength() should return an error")
+			}
+		})
+	}
+	if _, err := ResampleByArcLength([][]float64{{0}, {1}}, 0, Linear); err == nil {
+		t.Error("ResampleByArcLength() with no output samples should return an error")
+	}
+}
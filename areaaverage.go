@@ -0,0 +1,37 @@
+package interpolators
+
+// areaAverageInterpolate splits the input into outSamples contiguous bins of equal
+// width and returns the mean of the samples in each bin. Every input sample lands
+// in exactly one bin, so for large reduction ratios the output summarizes the whole
+// input instead of sampling a few points of it. When upsampling, bins narrower than
+// a sample take the sample they fall in.
+func areaAverageInterpolate(in []float64, outSamples int) []float64 {
+	if len(in) == 0 {
+		return []float64{}
+	}
+
+	n := len(in)
+	out := make([]float64, outSamples)
+	for i := range out {
+		start, end := binRange(i, n, outSamples)
+		sum := 0.0
+		for _, v := range in[start:end] {
+			sum += v
+		}
+		out[i] = sum / float64(end-start)
+	}
+
+	return out
+}
+
+// binRange returns the half-open sample range [start, end) of bin i when n samples
+// are split into bins contiguous bins of equal width. Bins narrower than a sample
+// hold the single sample they fall in.
+func binRange(i, n, bins int) (start, end int) {
+	start = i * n / bins
+	end = (i + 1) * n / bins
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
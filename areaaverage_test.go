@@ -0,0 +1,57 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateAreaAverage(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      []float64
+		outSamples int
+		expected   []float64
+	}{
+		{"empty input", []float64{}, 4, []float64{}},
+		{"exact reduction", []float64{1, 3, 5, 7, 2, 4}, 3, []float64{2, 6, 3}},
+		{"uneven bins", []float64{1, 2, 3, 4, 5}, 2, []float64{1.5, 4}},
+		{"single output", []float64{1, 2, 3, 6}, 1, []float64{3}},
+		{"identity", []float64{4, 5, 6}, 3, []float64{4, 5, 6}},
+		{"upsample", []float64{1, 2}, 4, []float64{1, 1, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Interpolate(tt.input, tt.outSamples, AreaAverage)
+			if err != nil {
+				t.Fatalf("Interpolate() returned unexpected error: %v", err)
+			}
+			if len(out) != len(tt.expected) {
+				t.Fatalf("Interpolate() length = %d, want %d", len(out), len(tt.expected))
+			}
+			for i := range out {
+				if math.Abs(out[i]-tt.expected[i]) > 1e-12 {
+					t.Errorf("Interpolate()[%d] = %v, want %v", i, out[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAreaAveragePreservesMean(t *testing.T) {
+	// A fast oscillation point-sampled at a large ratio aliases; the area average
+	// recovers the underlying level
+	in := make([]float64, 10000)
+	for i := range in {
+		in[i] = 5
+		if i%2 == 1 {
+			in[i] = -5
+		}
+	}
+	out, _ := Interpolate(in, 10, AreaAverage)
+	for i, v := range out {
+		if math.Abs(v) > 1e-12 {
+			t.Errorf("Interpolate()[%d] = %v, want 0", i, v)
+		}
+	}
+}
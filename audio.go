@@ -0,0 +1,156 @@
+package interpolators
+
+import (
+	"math"
+	"time"
+)
+
+// Quality selects the resampling filter used by Buffer.ResampleTo. Every quality
+// widens its kernel into an anti-aliasing low-pass when downsampling.
+type Quality int
+
+const (
+	// QualityLow uses linear interpolation, the cheapest option
+	QualityLow Quality = iota
+	// QualityMedium uses the 6-tap Lanczos3 windowed sinc
+	QualityMedium
+	// QualityHigh uses a 32-tap Lanczos windowed sinc, as ResampleTo48k does
+	QualityHigh
+)
+
+// kernel returns the resampling kernel of the quality; taps beyond the buffer
+// count as silence
+func (q Quality) kernel() kernel {
+	switch q {
+	case QualityLow:
+		k, _ := kernelFor(Linear)
+		return k.withBoundary(BoundaryZero)
+	case QualityMedium:
+		k, _ := kernelFor(Lanczos3)
+		return k.withBoundary(BoundaryZero)
+	}
+	return kernel{impulse: lanczosImpulse(loudnessRadius), radius: loudnessRadius, boundary: BoundaryZero}
+}
+
+// Buffer is a block of audio: interleaved samples with their channel count and
+// sample rate
+type Buffer struct {
+	// Samples holds the interleaved frames, Channels samples per frame
+	Samples    []float64
+	Channels   int
+	SampleRate int
+}
+
+// Frames returns the number of complete frames in the buffer
+func (b Buffer) Frames() int {
+	if b.Channels <= 0 {
+		return 0
+	}
+	return len(b.Samples) / b.Channels
+}
+
+// Duration returns the playing time of the buffer
+func (b Buffer) Duration() time.Duration {
+	if b.SampleRate <= 0 {
+		return 0
+	}
+	return time.Duration(b.Frames()) * time.Second / time.Duration(b.SampleRate)
+}
+
+// Channel returns a copy of the samples of channel c
+func (b Buffer) Channel(c int) []float64 {
+	out := make([]float64, b.Frames())
+	for i := range out {
+		out[i] = b.Samples[i*b.Channels+c]
+	}
+	return out
+}
+
+// ResampleTo converts the buffer to the given sample rate, resampling each channel
+// with the filter selected by quality. It returns an error if the buffer's or the
+// target sample rate is not positive.
+func (b Buffer) ResampleTo(rate int, quality Quality) (Buffer, error) {
+	if err := checkRates(float64(b.SampleRate), float64(rate)); err != nil {
+		return Buffer{}, err
+	}
+	if rate == b.SampleRate {
+		return Buffer{Samples: append([]float64(nil), b.Samples...), Channels: b.Channels, SampleRate: rate}, nil
+	}
+
+	frames := b.Frames()
+	out := Buffer{Channels: b.Channels, SampleRate: rate}
+	if frames == 0 {
+		out.Samples = []float64{}
+		return out, nil
+	}
+	k := quality.kernel()
+	for c := 0; c < b.Channels; c++ {
+		resampled := resampleKernel(b.Channel(c), b.SampleRate, rate, k)
+		if out.Samples == nil {
+			out.Samples = make([]float64, len(resampled)*b.Channels)
+		}
+		for i, v := range resampled {
+			out.Samples[i*b.Channels+c] = v
+		}
+	}
+	return out, nil
+}
+
+// Mixdown returns a mono buffer holding the mean of the channels in each frame
+func (b Buffer) Mixdown() Buffer {
+	frames := b.Frames()
+	out := Buffer{Samples: make([]float64, frames), Channels: 1, SampleRate: b.SampleRate}
+	for i := range out.Samples {
+		sum := 0.0
+		for _, v := range b.Samples[i*b.Channels : (i+1)*b.Channels] {
+			sum += v
+		}
+		out.Samples[i] = sum / float64(b.Channels)
+	}
+	return out
+}
+
+// Slice returns the frames from time t0 up to but excluding t1, clamped to the
+// buffer. Like a Go slice, the result shares the buffer's samples.
+func (b Buffer) Slice(t0, t1 time.Duration) Buffer {
+	frames := b.Frames()
+	frameAt := func(t time.Duration) int {
+		f := int(math.Floor(t.Seconds() * float64(b.SampleRate)))
+		return max(0, min(frames, f))
+	}
+	start, end := frameAt(t0), frameAt(t1)
+	if end < start {
+		end = start
+	}
+	return Buffer{
+		Samples:    b.Samples[start*b.Channels : end*b.Channels],
+		Channels:   b.Channels,
+		SampleRate: b.SampleRate,
+	}
+}
+
+// resampleKernel converts in from integer sample rate srIn to srOut with the
+// kernel, widened into an anti-aliasing filter when downsampling. A polyphase
+// table is used when the reduced ratio allows it.
+func resampleKernel(in []float64, srIn, srOut int, k kernel) []float64 {
+	if len(in) == 0 {
+		return []float64{}
+	}
+	if srIn > srOut {
+		k = k.stretched(float64(srIn) / float64(srOut))
+	}
+	if p, err := newPolyphase(srOut, srIn, k); err == nil {
+		return p.Resample(in)
+	}
+	step := float64(srIn) / float64(srOut)
+	last := float64(len(in) - 1)
+	out := make([]float64, 0, int(last/step)+1)
+	for i := 0; ; i++ {
+		pos := float64(i) * step
+		if pos > last {
+			break
+		}
+		out = append(out, k.eval(in, pos))
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// stereoTone returns a buffer with a sine of the given frequency on the left and
+// its negation on the right
+func stereoTone(freq float64, rate, frames int) Buffer {
+	b := Buffer{Samples: make([]float64, 2*frames), Channels: 2, SampleRate: rate}
+	for i := 0; i < frames; i++ {
+		v := math.Sin(2 * math.Pi * freq * float64(i) / float64(rate))
+		b.Samples[2*i] = v
+		b.Samples[2*i+1] = -v
+	}
+	return b
+}
+
+func TestBufferResampleTo(t *testing.T) {
+	in := stereoTone(440, 44100, 4410)
+	for _, quality := range []Quality{QualityLow, QualityMedium, QualityHigh} {
+		out, err := in.ResampleTo(48000, quality)
+		if err != nil {
+			t.Fatalf("ResampleTo(%d) returned unexpected error: %v", quality, err)
+		}
+		if out.SampleRate != 48000 || out.Channels != 2 {
+			t.Fatalf("ResampleTo(%d) = %d Hz x %d channels, want 48000 Hz x 2", quality, out.SampleRate, out.Channels)
+		}
+		if d := out.Duration() - in.Duration(); d < -time.Millisecond || d > time.Millisecond {
+			t.Errorf("ResampleTo(%d) duration = %v, want %v", quality, out.Duration(), in.Duration())
+		}
+		// Compare away from the edges against the ideal tone, allowing for the
+		// passband ripple of the windowed sincs
+		tolerance := map[Quality]float64{QualityLow: 2e-3, QualityMedium: 1e-2, QualityHigh: 5e-4}[quality]
+		for i := 100; i < out.Frames()-100; i++ {
+			want := math.Sin(2 * math.Pi * 440 * float64(i) / 48000)
+			if math.Abs(out.Samples[2*i]-want) > tolerance || math.Abs(out.Samples[2*i+1]+want) > tolerance {
+				t.Errorf("ResampleTo(%d) frame %d = %v, %v, want %v, %v", quality, i, out.Samples[2*i], out.Samples[2*i+1], want, -want)
+				break
+			}
+		}
+	}
+}
+
+func TestBufferResampleToAntiAliases(t *testing.T) {
+	// A 20 kHz tone is above the 8 kHz Nyquist rate of the target and is removed
+	in := stereoTone(20000, 48000, 4800)
+	out, err := in.ResampleTo(16000, QualityHigh)
+	if err != nil {
+		t.Fatalf("ResampleTo() returned unexpected error: %v", err)
+	}
+	peak := 0.0
+	for _, v := range out.Slice(10*time.Millisecond, 90*time.Millisecond).Samples {
+		peak = math.Max(peak, math.Abs(v))
+	}
+	if peak > 0.05 {
+		t.Errorf("ResampleTo() peak of an out-of-band tone = %v, want below 0.05", peak)
+	}
+}
+
+func TestBufferResampleToErrors(t *testing.T) {
+	if _, err := (Buffer{}).ResampleTo(48000, QualityLow); err == nil {
+		t.Error("ResampleTo() of a zero Buffer should return an error")
+	}
+	for _, rate := range []int{0, -8000} {
+		if _, err := stereoTone(440, 44100, 10).ResampleTo(rate, QualityLow); err == nil {
+			t.Errorf("ResampleTo(%d) should return an error", rate)
+		}
+	}
+}
+
+func TestBufferMixdown(t *testing.T) {
+	b := Buffer{Samples: []float64{1, 3, 2, 6, -1, 1}, Channels: 2, SampleRate: 8000}
+	mono := b.Mixdown()
+	want := []float64{2, 4, 0}
+	if mono.Channels != 1 || mono.SampleRate != 8000 || len(mono.Samples) != len(want) {
+		t.Fatalf("Mixdown() = %+v, want mono 8000 Hz with %d frames", mono, len(want))
+	}
+	for i := range want {
+		if mono.Samples[i] != want[i] {
+			t.Errorf("Mixdown()[%d] = %v, want %v", i, mono.Samples[i], want[i])
+		}
+	}
+}
+
+func TestBufferSlice(t *testing.T) {
+	b := stereoTone(100, 1000, 1000)
+	tests := []struct {
+		name   string
+		t0, t1 time.Duration
+		frames int
+		first  int
+	}{
+		{"middle", 250 * time.Millisecond, 500 * time.Millisecond, 250, 250},
+		{"clamped", -time.Second, 10 * time.Millisecond, 10, 0},
+		{"past the end", 900 * time.Millisecond, 2 * time.Second, 100, 900},
+		{"reversed", 500 * time.Millisecond, 100 * time.Millisecond, 0, 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := b.Slice(tt.t0, tt.t1)
+			if s.Frames() != tt.frames {
+				t.Fatalf("Slice().Frames() = %d, want %d", s.Frames(), tt.frames)
+			}
+			if tt.frames > 0 && s.Samples[0] != b.Samples[2*tt.first] {
+				t.Errorf("Slice() starts at %v, want frame %d", s.Samples[0], tt.first)
+			}
+		})
+	}
+}
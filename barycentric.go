@@ -0,0 +1,185 @@
+package interpolators
+
+import "math"
+
+// barycentric is the global interpolating polynomial through the samples y at the
+// nodes x, in the second (true) barycentric form
+//
+//	p(q) = Σ w[j]·y[j]/(q-x[j]) / Σ w[j]/(q-x[j])
+//
+// which is numerically stable and costs O(n) per evaluation once the weights are
+// known. High degrees oscillate between equispaced nodes (Runge's phenomenon), so
+// it is meant for small inputs.
+type barycentric struct {
+	x, y, w []float64
+}
+
+// newBarycentric returns the polynomial through the samples y at positions 0 ... n-1
+func newBarycentric(y []float64) barycentric {
+	n := len(y)
+	x := samplePositions(n)
+	w := make([]float64, n)
+	// Equispaced weights are (-1)^j·C(n-1, j), formed in logarithms and scaled so
+	// the largest is 1, which keeps them finite for long inputs
+	lgN, _ := math.Lgamma(float64(n))
+	lgMid, _ := math.Lgamma(float64((n-1)/2 + 1))
+	lgRest, _ := math.Lgamma(float64(n - (n-1)/2))
+	top := lgN - lgMid - lgRest
+	for j := range w {
+		lgJ, _ := math.Lgamma(float64(j + 1))
+		lgK, _ := math.Lgamma(float64(n - j))
+		w[j] = math.Exp(lgN - lgJ - lgK - top)
+		if j%2 == 1 {
+			w[j] = -w[j]
+		}
+	}
+	return barycentric{x: x, y: y, w: w}
+}
+
+// newBarycentricXY returns the polynomial through the samples y at the distinct
+// coordinates x. The weights 1/Π(x[j]-x[k]) are computed on coordinates scaled to
+// an interval of length 4, which keeps the products from overflowing.
+func newBarycentricXY(x, y []float64) barycentric {
+	n := len(x)
+	scale := 1.0
+	if span := x[n-1] - x[0]; span > 0 {
+		scale = 4 / span
+	}
+	w := make([]float64, n)
+	largest := 0.0
+	for j := range w {
+		p := 1.0
+		for k := range x {
+			if k != j {
+				p *= (x[j] - x[k]) * scale
+			}
+		}
+		w[j] = 1 / p
+		largest = max(largest, math.Abs(w[j]))
+	}
+	for j := range w {
+		w[j] /= largest
+	}
+	return barycentric{x: x, y: y, w: w}
+}
+
+// at evaluates the polynomial at q
+func (b barycentric) at(q float64) float64 {
+	num, den := 0.0, 0.0
+	for j, xj := range b.x {
+		d := q - xj
+		if d == 0 {
+			return b.y[j]
+		}
+		c := b.w[j] / d
+		num += c * b.y[j]
+		den += c
+	}
+	return num / den
+}
+
+// derivative evaluates the order-th derivative (1 or 2) of the polynomial at q.
+// Away from the nodes it differentiates p = N/D, with N and D the sums of at;
+// at a node it uses the barycentric differentiation formulas instead.
+func (b barycentric) derivative(q float64, order int) float64 {
+	for i, xi := range b.x {
+		if q == xi {
+			return b.nodeDerivative(i, order)
+		}
+	}
+	var n0, n1, n2, d0, d1, d2 float64
+	for j, xj := range b.x {
+		r := 1 / (q - xj)
+		c := b.w[j] * r
+		n0 += c * b.y[j]
+		d0 += c
+		c *= r
+		n1 -= c * b.y[j]
+		d1 -= c
+		c *= r
+		n2 += 2 * c * b.y[j]
+		d2 += 2 * c
+	}
+	// From N = p·D: N' = p'·D + p·D' and N'' = p''·D + 2p'·D' + p·D''
+	p := n0 / d0
+	p1 := (n1 - p*d1) / d0
+	if order == 1 {
+		return p1
+	}
+	return (n2 - 2*p1*d1 - p*d2) / d0
+}
+
+// nodeDerivative returns the order-th derivative (1 or 2) of the polynomial at
+// node i. The difference quotients (y[j]-y[i])/(x[j]-x[i]) are the values of the
+// polynomial (p-y[i])/(q-x[i]), whose value at node i is the first derivative of p
+// and whose slope there is half the second.
+func (b barycentric) nodeDerivative(i, order int) float64 {
+	slope := func(v func(j int) float64, vi float64) float64 {
+		s := 0.0
+		for j := range b.x {
+			if j != i {
+				s += b.w[j] / b.w[i] * (v(j) - vi) / (b.x[i] - b.x[j])
+			}
+		}
+		return s
+	}
+	quotient := func(j int) float64 { return (b.y[j] - b.y[i]) / (b.x[j] - b.x[i]) }
+	p1 := slope(func(j int) float64 { return b.y[j] }, b.y[i])
+	if order == 1 {
+		return p1
+	}
+	return 2 * slope(quotient, p1)
+}
+
+// barycentricInterpolate evaluates b, fitted to the samples at positions
+// 0 ... n-1, at the output positions of Interpolate
+func barycentricInterpolate(b barycentric, outSamples int) []float64 {
+	n := len(b.x)
+	if n == 0 {
+		return []float64{}
+	}
+	out := make([]float64, outSamples)
+	for i := range out {
+		out[i] = b.at(outputPosition(i, n, outSamples))
+	}
+	return out
+}
+
+// newFloaterHormann returns the Floater-Hormann rational interpolant through the
+// samples y at the increasing coordinates x with blend parameter d, or 3 for d =
+// 0. It blends the degree-d polynomials through every d+1 consecutive samples,
+// has no poles on the real line and avoids the Runge oscillation of the global
+// polynomial; d is capped at len(x)-1, where it becomes that polynomial.
+func newFloaterHormann(x, y []float64, d int) barycentric {
+	if d == 0 {
+		d = 3
+	}
+	n := len(x)
+	d = min(d, n-1)
+	w := make([]float64, n)
+	for k := range w {
+		// w[k] = (-1)^(k-d) Σ over the blended polynomials i ≤ k ≤ i+d of Π 1/|x[k]-x[j]|
+		for i := max(0, k-d); i <= min(k, n-1-d); i++ {
+			p := 1.0
+			for j := i; j <= i+d; j++ {
+				if j != k {
+					p /= math.Abs(x[k] - x[j])
+				}
+			}
+			w[k] += p
+		}
+		if (k-d)%2 != 0 {
+			w[k] = -w[k]
+		}
+	}
+	return barycentric{x: x, y: y, w: w}
+}
+
+// samplePositions returns the sample positions 0 ... n-1
+func samplePositions(n int) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	return x
+}
@@ -0,0 +1,161 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBarycentric(t *testing.T) {
+	// n samples of a polynomial of degree n-1 are reproduced everywhere
+	poly := func(x float64) float64 { return ((0.02*x-0.3)*x+1)*x*x - 2*x + 5 }
+	in := make([]float64, 5)
+	for i := range in {
+		in[i] = poly(float64(i))
+	}
+	out, err := Interpolate(in, 17, Barycentric)
+	if err != nil {
+		t.Fatalf("Interpolate(Barycentric) returned unexpected error: %v", err)
+	}
+	for i, v := range out {
+		pos := outputPosition(i, len(in), len(out))
+		if math.Abs(v-poly(pos)) > 1e-10 {
+			t.Errorf("Interpolate(Barycentric)[%d] = %v, want %v", i, v, poly(pos))
+		}
+	}
+	at, _ := InterpolateAt(in, []float64{-1.5, 5.25}, Barycentric)
+	for i, pos := range []float64{-1.5, 5.25} {
+		if math.Abs(at[i]-poly(pos)) > 1e-9 {
+			t.Errorf("InterpolateAt(Barycentric) at %v = %v, want %v", pos, at[i], poly(pos))
+		}
+	}
+}
+
+func TestBarycentricWeights(t *testing.T) {
+	// The equispaced weights match the general formula up to a common factor
+	for _, n := range []int{2, 7, 12} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = float64(i)
+		}
+		uniform, general := newBarycentric(make([]float64, n)), newBarycentricXY(x, make([]float64, n))
+		factor := general.w[0] / uniform.w[0]
+		for j := range x {
+			if math.Abs(uniform.w[j]*factor-general.w[j]) > 1e-12 {
+				t.Errorf("n=%d weight %d = %v, want %v", n, j, uniform.w[j]*factor, general.w[j])
+			}
+		}
+	}
+	// Long inputs keep finite weights
+	for _, w := range newBarycentric(make([]float64, 2000)).w {
+		if math.IsNaN(w) || math.IsInf(w, 0) {
+			t.Fatalf("2000-point weights contain %v", w)
+		}
+	}
+}
+
+func TestBarycentricXY(t *testing.T) {
+	x := []float64{-3, -1.2, 0.4, 2, 5.5}
+	poly := func(q float64) float64 { return (0.1*q-0.5)*q*q*q + q - 1 }
+	y := make([]float64, len(x))
+	for i := range y {
+		y[i] = poly(x[i])
+	}
+	out, err := InterpolateXY(x, y, []float64{-2.5, 0, 1.1, 4.9}, Barycentric)
+	if err != nil {
+		t.Fatalf("InterpolateXY(Barycentric) returned unexpected error: %v", err)
+	}
+	for i, q := range []float64{-2.5, 0, 1.1, 4.9} {
+		if math.Abs(out[i]-poly(q)) > 1e-10 {
+			t.Errorf("InterpolateXY(Barycentric) at %v = %v, want %v", q, out[i], poly(q))
+		}
+	}
+}
+
+func TestBarycentricDerivative(t *testing.T) {
+	poly := func(x float64) float64 { return ((0.02*x-0.3)*x+1)*x*x - 2*x + 5 }
+	d1 := func(x float64) float64 { return ((0.08*x-0.9)*x+2)*x - 2 }
+	d2 := func(x float64) float64 { return (0.24*x-1.8)*x + 2 }
+	in := make([]float64, 6)
+	for i := range in {
+		in[i] = poly(float64(i))
+	}
+	// Positions on and between the nodes use different formulas
+	positions := []float64{0, 1.3, 2, 3.5, 5, 6.2}
+	first, _ := DerivativeAt(in, positions, Barycentric)
+	second, _ := SecondDerivativeAt(in, positions, Barycentric)
+	for i, pos := range positions {
+		if math.Abs(first[i]-d1(pos)) > 1e-9 {
+			t.Errorf("DerivativeAt(Barycentric) at %v = %v, want %v", pos, first[i], d1(pos))
+		}
+		if math.Abs(second[i]-d2(pos)) > 1e-8 {
+			t.Errorf("SecondDerivativeAt(Barycentric) at %v = %v, want %v", pos, second[i], d2(pos))
+		}
+	}
+}
+
+func TestFloaterHormann(t *testing.T) {
+	// Runge's function on 21 equispaced samples: the global polynomial oscillates
+	// wildly near the ends, the rational interpolant does not
+	runge := func(x float64) float64 { return 1 / (1 + 25*x*x) }
+	in := make([]float64, 21)
+	for i := range in {
+		in[i] = runge(-1 + float64(i)/10)
+	}
+	maxErr := func(interpolatorType InterpolatorType, opts Options) float64 {
+		out, err := InterpolateWithOptions(in, 201, interpolatorType, opts)
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(%v) returned unexpected error: %v", interpolatorType, err)
+		}
+		worst := 0.0
+		for i, v := range out {
+			worst = max(worst, math.Abs(v-runge(-1+float64(i)/100)))
+		}
+		return worst
+	}
+	poly, rational := maxErr(Barycentric, Options{}), maxErr(FloaterHormann, Options{})
+	if poly < 10 || rational > 0.1 {
+		t.Errorf("Runge max error: Barycentric %v, FloaterHormann %v, want above 10 and below 0.1", poly, rational)
+	}
+	// d = n-1 is the global polynomial
+	if full := maxErr(FloaterHormann, Options{Kernel: KernelParams{Degree: 20}}); math.Abs(full-poly) > 1e-6*poly {
+		t.Errorf("FloaterHormann d=20 max error %v, want the polynomial's %v", full, poly)
+	}
+
+	// Polynomials of degree d are reproduced
+	cubic := func(x float64) float64 { return ((0.1*x-1)*x+2)*x - 3 }
+	x := []float64{0, 0.7, 1.5, 3, 3.2, 4.8, 6}
+	y := make([]float64, len(x))
+	for i := range y {
+		y[i] = cubic(x[i])
+	}
+	out, _ := InterpolateXY(x, y, []float64{0.3, 2.2, 5.5}, FloaterHormann)
+	for i, q := range []float64{0.3, 2.2, 5.5} {
+		if math.Abs(out[i]-cubic(q)) > 1e-10 {
+			t.Errorf("InterpolateXY(FloaterHormann) at %v = %v, want %v", q, out[i], cubic(q))
+		}
+	}
+}
+
+func TestFloaterHormannDerivative(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1}
+	positions := []float64{0, 1.3, 3, 6.5}
+	for _, order := range []int{1, 2} {
+		var got []float64
+		if order == 1 {
+			got, _ = DerivativeAt(in, positions, FloaterHormann)
+		} else {
+			got, _ = SecondDerivativeAt(in, positions, FloaterHormann)
+		}
+		for i, pos := range positions {
+			h := 1e-4
+			f, _ := InterpolateAt(in, []float64{pos - h, pos, pos + h}, FloaterHormann)
+			want := (f[2] - f[0]) / (2 * h)
+			if order == 2 {
+				want = (f[2] - 2*f[1] + f[0]) / (h * h)
+			}
+			if math.Abs(got[i]-want) > 1e-4*max(1, math.Abs(want)) {
+				t.Errorf("derivative %d of FloaterHormann at %v = %v, want %v", order, pos, got[i], want)
+			}
+		}
+	}
+}
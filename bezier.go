@@ -0,0 +1,241 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// BezierCurve is a chain of cubic Bezier segments in any number of dimensions.
+// Segment i runs from control point 3i to 3i+3 with the two points between as
+// its handles, so consecutive segments share their end points. The curve is
+// parameterized by u from 0 to Segments(), one unit per segment.
+type BezierCurve struct {
+	points [][]float64
+}
+
+// NewBezierCurve creates a curve from its control points: an end point, then two
+// handles and an end point for every segment, 3k+1 points for k segments
+func NewBezierCurve(controlPoints [][]float64) (*BezierCurve, error) {
+	if _, err := checkPoints(controlPoints); err != nil {
+		return nil, err
+	}
+	if len(controlPoints) < 4 || (len(controlPoints)-1)%3 != 0 {
+		return nil, fmt.Errorf("a cubic Bezier curve needs 3k+1 control points, got %d", len(controlPoints))
+	}
+	points := make([][]float64, len(controlPoints))
+	for i, p := range controlPoints {
+		points[i] = append([]float64(nil), p...)
+	}
+	return &BezierCurve{points: points}, nil
+}
+
+// NewBezierThrough creates a smooth curve passing through points, with one
+// segment between each pair. The handles are derived as for a Catmull-Rom
+// spline: the tangent at a point is parallel to the chord between its neighbours,
+// which makes the curve C¹, and the end tangents point at the second and
+// second-to-last points.
+func NewBezierThrough(points [][]float64) (*BezierCurve, error) {
+	dim, err := checkPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	n := len(points)
+	if n < 2 {
+		return nil, fmt.Errorf("%w: a Bezier curve needs at least 2 points, got %d", ErrTooFewPoints, n)
+	}
+	// tangent returns the derivative at point i per segment of parameter
+	tangent := func(i int) []float64 {
+		lo, hi, scale := max(i-1, 0), min(i+1, n-1), 0.5
+		if hi-lo == 1 {
+			scale = 1
+		}
+		t := make([]float64, dim)
+		for d := range t {
+			t[d] = (points[hi][d] - points[lo][d]) * scale
+		}
+		return t
+	}
+	control := make([][]float64, 0, 3*n-2)
+	for i := 0; i < n-1; i++ {
+		a, b := tangent(i), tangent(i+1)
+		out, in := make([]float64, dim), make([]float64, dim)
+		for d := 0; d < dim; d++ {
+			out[d] = points[i][d] + a[d]/3
+			in[d] = points[i+1][d] - b[d]/3
+		}
+		control = append(control, append([]float64(nil), points[i]...), out, in)
+	}
+	control = append(control, append([]float64(nil), points[n-1]...))
+	return &BezierCurve{points: control}, nil
+}
+
+// Segments returns the number of cubic segments
+func (b *BezierCurve) Segments() int {
+	return (len(b.points) - 1) / 3
+}
+
+// ControlPoints returns a copy of the control points
+func (b *BezierCurve) ControlPoints() [][]float64 {
+	out := make([][]float64, len(b.points))
+	for i, p := range b.points {
+		out[i] = append([]float64(nil), p...)
+	}
+	return out
+}
+
+// segment maps the curve parameter u, clamped to the curve, onto a segment and
+// the parameter t in [0, 1] within it
+func (b *BezierCurve) segment(u float64) (int, float64) {
+	n := b.Segments()
+	u = math.Max(0, math.Min(float64(n), u))
+	i := min(int(u), n-1)
+	return i, u - float64(i)
+}
+
+// At returns the point of the curve at parameter u
+func (b *BezierCurve) At(u float64) []float64 {
+	i, t := b.segment(u)
+	p := b.points[3*i : 3*i+4]
+	s := 1 - t
+	// Bernstein weights of the four control points
+	w0, w1, w2, w3 := s*s*s, 3*s*s*t, 3*s*t*t, t*t*t
+	out := make([]float64, len(p[0]))
+	for d := range out {
+		out[d] = w0*p[0][d] + w1*p[1][d] + w2*p[2][d] + w3*p[3][d]
+	}
+	return out
+}
+
+// Tangent returns the derivative of the curve with respect to u at parameter u
+func (b *BezierCurve) Tangent(u float64) []float64 {
+	i, t := b.segment(u)
+	p := b.points[3*i : 3*i+4]
+	s := 1 - t
+	// The derivative is the quadratic Bezier of the differences of the handles
+	w0, w1, w2 := 3*s*s, 6*s*t, 3*t*t
+	out := make([]float64, len(p[0]))
+	for d := range out {
+		out[d] = w0*(p[1][d]-p[0][d]) + w1*(p[2][d]-p[1][d]) + w2*(p[3][d]-p[2][d])
+	}
+	return out
+}
+
+// Split cuts the curve at parameter u, strictly inside the curve, into the part
+// before and the part after. A cut inside a segment divides it with de
+// Casteljau's algorithm, so both parts trace exactly the original curve.
+func (b *BezierCurve) Split(u float64) (*BezierCurve, *BezierCurve, error) {
+	n := b.Segments()
+	if !(u > 0 && u < float64(n)) {
+		return nil, nil, fmt.Errorf("split parameter must be inside (0, %d), got %v", n, u)
+	}
+	i, t := b.segment(u)
+	control := b.ControlPoints()
+	if t == 0 {
+		return &BezierCurve{points: control[:3*i+1]}, &BezierCurve{points: control[3*i:]}, nil
+	}
+	p := control[3*i : 3*i+4]
+	lerp := func(a, c []float64) []float64 {
+		out := make([]float64, len(a))
+		for d := range out {
+			out[d] = a[d] + t*(c[d]-a[d])
+		}
+		return out
+	}
+	p01, p12, p23 := lerp(p[0], p[1]), lerp(p[1], p[2]), lerp(p[2], p[3])
+	p012, p123 := lerp(p01, p12), lerp(p12, p23)
+	mid := lerp(p012, p123)
+
+	before := append(append([][]float64(nil), control[:3*i+1]...), p01, p012, mid)
+	after := append([][]float64{append([]float64(nil), mid...), p123, p23}, control[3*i+3:]...)
+	return &BezierCurve{points: before}, &BezierCurve{points: after}, nil
+}
+
+// Resample returns outSamples points equally spaced in the curve parameter from
+// the first end point to the last. Points crowd where the handles are short;
+// ResampleByArcLength spaces them evenly along the curve instead.
+func (b *BezierCurve) Resample(outSamples int) ([][]float64, error) {
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	out := make([][]float64, outSamples)
+	for i := range out {
+		out[i] = b.At(outputPosition(i, 2, outSamples) * float64(b.Segments()))
+	}
+	return out, nil
+}
+
+// bezierLengthPieces is the number of pieces per segment of the arc length
+// quadrature
+const bezierLengthPieces = 8
+
+// Length returns the arc length of the curve
+func (b *BezierCurve) Length() float64 {
+	lengths := b.lengths()
+	return lengths[len(lengths)-1]
+}
+
+// lengths returns the arc length from the start to the end of each quadrature
+// piece, with a leading 0
+func (b *BezierCurve) lengths() []float64 {
+	pieces := b.Segments() * bezierLengthPieces
+	lengths := make([]float64, pieces+1)
+	for p := 0; p < pieces; p++ {
+		lo := float64(p) / bezierLengthPieces
+		lengths[p+1] = lengths[p] + b.pieceLength(lo, lo+1.0/bezierLengthPieces)
+	}
+	return lengths
+}
+
+// pieceLength integrates the speed of the curve from parameter a to b within one
+// segment
+func (b *BezierCurve) pieceLength(a, c float64) float64 {
+	half, mid := (c-a)/2, (c+a)/2
+	sum := 0.0
+	for i, x := range gaussNodes {
+		sum += gaussWeights[i] * vectorNorm(b.Tangent(mid+half*x))
+	}
+	return sum * half
+}
+
+// ResampleByArcLength returns outSamples points equally spaced along the curve
+// from the first end point to the last, for constant-speed traversal
+func (b *BezierCurve) ResampleByArcLength(outSamples int) ([][]float64, error) {
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	lengths := b.lengths()
+	total := lengths[len(lengths)-1]
+	out := make([][]float64, outSamples)
+	p := 0
+	for i := range out {
+		target := outputPosition(i, 2, outSamples) * total
+		for p < len(lengths)-2 && lengths[p+1] < target {
+			p++
+		}
+		// Newton's method on the length within the piece, kept inside it
+		lo := float64(p) / bezierLengthPieces
+		hi := lo + 1.0/bezierLengthPieces
+		u := lo
+		if span := lengths[p+1] - lengths[p]; span > 0 {
+			u = lo + (hi-lo)*(target-lengths[p])/span
+		}
+		for iter := 0; iter < 8; iter++ {
+			v := vectorNorm(b.Tangent(u))
+			if v == 0 {
+				break
+			}
+			u = math.Max(lo, math.Min(hi, u-(lengths[p]+b.pieceLength(lo, u)-target)/v))
+		}
+		out[i] = b.At(u)
+	}
+	return out, nil
+}
+
+// vectorNorm returns the Euclidean length of v
+func vectorNorm(v []float64) float64 {
+	sum := 0.0
+	for _, x := range v {
+		sum += x * x
+	}
+	return math.Sqrt(sum)
+}
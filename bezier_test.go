@@ -0,0 +1,168 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBezierCurveAt(t *testing.T) {
+	b, err := NewBezierCurve([][]float64{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+	if err != nil {
+		t.Fatalf("NewBezierCurve returned unexpected error: %v", err)
+	}
+	for _, tc := range []struct {
+		u    float64
+		want []float64
+	}{
+		{0, []float64{0, 0}},
+		{0.5, []float64{2, 1.5}},
+		{1, []float64{4, 0}},
+		{-1, []float64{0, 0}},
+		{2, []float64{4, 0}},
+	} {
+		got := b.At(tc.u)
+		for d := range got {
+			if math.Abs(got[d]-tc.want[d]) > 1e-12 {
+				t.Errorf("At(%v) = %v, want %v", tc.u, got, tc.want)
+				break
+			}
+		}
+	}
+	// The end tangents are three times the handles
+	if got := b.Tangent(0); got[0] != 3 || got[1] != 6 {
+		t.Errorf("Tangent(0) = %v, want [3 6]", got)
+	}
+	if got := b.Tangent(1); got[0] != 3 || got[1] != -6 {
+		t.Errorf("Tangent(1) = %v, want [3 -6]", got)
+	}
+}
+
+func TestBezierCurveErrors(t *testing.T) {
+	for _, n := range []int{0, 1, 3, 5, 6} {
+		points := make([][]float64, n)
+		for i := range points {
+			points[i] = []float64{float64(i)}
+		}
+		if _, err := NewBezierCurve(points); err == nil {
+			t.Errorf("NewBezierCurve with %d control points returned nil error", n)
+		}
+	}
+	if _, err := NewBezierThrough([][]float64{{1, 2}}); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("NewBezierThrough with one point returned %v, want ErrTooFewPoints", err)
+	}
+	b, _ := NewBezierThrough([][]float64{{0}, {1}, {2}})
+	for _, u := range []float64{0, 2, -1, math.NaN()} {
+		if _, _, err := b.Split(u); err == nil {
+			t.Errorf("Split(%v) returned nil error", u)
+		}
+	}
+	if _, err := b.Resample(0); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("Resample(0) returned %v, want ErrInvalidOutSamples", err)
+	}
+}
+
+func TestBezierThrough(t *testing.T) {
+	points := [][]float64{{0, 0, 0}, {1, 2, 0}, {3, 3, 1}, {4, 1, 2}, {6, 0, 2}}
+	b, err := NewBezierThrough(points)
+	if err != nil {
+		t.Fatalf("NewBezierThrough returned unexpected error: %v", err)
+	}
+	if b.Segments() != len(points)-1 {
+		t.Fatalf("Segments() = %d, want %d", b.Segments(), len(points)-1)
+	}
+	for i, p := range points {
+		got := b.At(float64(i))
+		for d := range p {
+			if math.Abs(got[d]-p[d]) > 1e-12 {
+				t.Errorf("At(%d) = %v, want %v", i, got, p)
+				break
+			}
+		}
+	}
+	// The tangent is continuous across the joints and follows the neighbours' chord
+	for i := 1; i < len(points)-1; i++ {
+		before, after := b.Tangent(float64(i)-1e-9), b.Tangent(float64(i))
+		for d := range before {
+			want := (points[i+1][d] - points[i-1][d]) / 2
+			if math.Abs(before[d]-want) > 1e-6 || math.Abs(after[d]-want) > 1e-12 {
+				t.Errorf("tangent at point %d = %v and %v, want %v in dimension %d", i, before, after, want, d)
+			}
+		}
+	}
+}
+
+func TestBezierCurveSplit(t *testing.T) {
+	b, _ := NewBezierThrough([][]float64{{0, 0}, {1, 3}, {4, 1}, {5, 5}})
+	for _, u := range []float64{0.3, 1, 1.75, 2.5} {
+		before, after, err := b.Split(u)
+		if err != nil {
+			t.Fatalf("Split(%v) returned unexpected error: %v", u, err)
+		}
+		if math.Abs(before.Length()+after.Length()-b.Length()) > 1e-6 {
+			t.Errorf("Split(%v) lengths %v + %v, want %v", u, before.Length(), after.Length(), b.Length())
+		}
+		// Every point of the halves lies on the original curve at the matching
+		// parameter, after rescaling the split segment's parameter
+		i := min(int(u), b.Segments()-1)
+		frac := u - float64(i)
+		for _, s := range []float64{0, 0.2, 0.5, 0.9, 1} {
+			check := func(half *BezierCurve, hu, ou float64) {
+				got, want := half.At(hu), b.At(ou)
+				for d := range got {
+					if math.Abs(got[d]-want[d]) > 1e-12 {
+						t.Errorf("Split(%v) half at %v = %v, want %v", u, hu, got, want)
+						return
+					}
+				}
+			}
+			if frac == 0 {
+				check(before, s*u, s*u)
+				check(after, s*(3-u), u+s*(3-u))
+				continue
+			}
+			check(before, float64(i)+s, float64(i)+s*frac)
+			check(after, s, u+s*(1-frac))
+		}
+	}
+}
+
+func TestBezierCurveResample(t *testing.T) {
+	// A straight line with unequal handles moves at varying speed in u
+	b, _ := NewBezierCurve([][]float64{{0, 0}, {0.1, 0}, {0.2, 0}, {3, 0}})
+	if got := b.Length(); math.Abs(got-3) > 1e-9 {
+		t.Errorf("Length() = %v, want 3", got)
+	}
+	even, err := b.ResampleByArcLength(7)
+	if err != nil {
+		t.Fatalf("ResampleByArcLength returned unexpected error: %v", err)
+	}
+	for i, p := range even {
+		if want := 0.5 * float64(i); math.Abs(p[0]-want) > 1e-9 || p[1] != 0 {
+			t.Errorf("ResampleByArcLength[%d] = %v, want [%v 0]", i, p, want)
+		}
+	}
+	uniform, _ := b.Resample(7)
+	for i, p := range uniform {
+		want := b.At(float64(i) / 6)
+		if math.Abs(p[0]-want[0]) > 1e-12 {
+			t.Errorf("Resample[%d] = %v, want %v", i, p, want)
+		}
+	}
+	if uniform[3][0] > 1 {
+		t.Errorf("Resample midpoint = %v, want it crowded towards the short handles", uniform[3])
+	}
+
+	// A quarter circle approximated with the standard handle length
+	const k = 0.5522847498
+	arc, _ := NewBezierCurve([][]float64{{1, 0}, {1, k}, {k, 1}, {0, 1}})
+	if got := arc.Length(); math.Abs(got-math.Pi/2) > 1e-3 {
+		t.Errorf("quarter circle Length() = %v, want about %v", got, math.Pi/2)
+	}
+	points, _ := arc.ResampleByArcLength(5)
+	for i := 1; i < len(points); i++ {
+		if d := distance(points[i], points[i-1]); math.Abs(d-0.3902) > 2e-3 {
+			t.Errorf("quarter circle chord %d = %v, want about 0.3902", i, d)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package interpolators
+
+// biquad is a second-order IIR section in transposed direct form II, with the
+// coefficients normalized so that a0 = 1
+type biquad struct {
+	b0, b1, b2 float64
+	a1, a2     float64
+	z1, z2     float64
+}
+
+// process filters one sample
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.z1
+	f.z1 = f.b1*x - f.a1*y + f.z2
+	f.z2 = f.b2*x - f.a2*y
+	return y
+}
+
+// reset clears the filter state
+func (f *biquad) reset() {
+	f.z1 = 0
+	f.z2 = 0
+}
+
+// prime sets the state to the steady state for a constant input x, assuming unity
+// gain at DC, so filtering starts without a transient
+func (f *biquad) prime(x float64) {
+	f.z2 = (f.b2 - f.a2) * x
+	f.z1 = (1 - f.b0) * x
+}
@@ -0,0 +1,56 @@
+package interpolators
+
+// Boundary controls how the convolution-based interpolators treat kernel taps that
+// fall outside the input
+type Boundary int
+
+const (
+	// BoundaryDefault keeps each interpolator's built-in edge handling: the B-spline,
+	// Lagrange, Watte, parabolic and osculating kernels drop out-of-range taps and the
+	// others clamp them to the edge samples
+	BoundaryDefault Boundary = iota
+	// BoundaryZero treats samples outside the input as zero
+	BoundaryZero
+	// BoundaryClamp repeats the first and last samples
+	BoundaryClamp
+	// BoundaryMirror reflects the input about its first and last samples
+	BoundaryMirror
+	// BoundaryWrap treats the input as periodic
+	BoundaryWrap
+)
+
+// index maps tap j onto an index into an input of length n. ok is false when the
+// tap contributes nothing, which only happens for zero padding.
+func (b Boundary) index(j, n int) (idx int, ok bool) {
+	if j >= 0 && j < n {
+		return j, true
+	}
+	switch b {
+	case BoundaryClamp:
+		if j < 0 {
+			return 0, true
+		}
+		return n - 1, true
+	case BoundaryMirror:
+		if n == 1 {
+			return 0, true
+		}
+		// Reflection about both ends repeats with period 2(n-1)
+		period := 2 * (n - 1)
+		j %= period
+		if j < 0 {
+			j += period
+		}
+		if j >= n {
+			j = period - j
+		}
+		return j, true
+	case BoundaryWrap:
+		j %= n
+		if j < 0 {
+			j += n
+		}
+		return j, true
+	}
+	return 0, false
+}
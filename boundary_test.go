@@ -0,0 +1,39 @@
+package interpolators
+
+import "testing"
+
+func TestBoundaryIndex(t *testing.T) {
+	n := 4
+	tests := []struct {
+		name     string
+		boundary Boundary
+		taps     []int
+		expected []int // -1 marks a dropped tap
+	}{
+		{"zero", BoundaryZero, []int{-2, -1, 0, 3, 4, 5}, []int{-1, -1, 0, 3, -1, -1}},
+		{"default drops", BoundaryDefault, []int{-1, 4}, []int{-1, -1}},
+		{"clamp", BoundaryClamp, []int{-2, -1, 0, 3, 4, 5}, []int{0, 0, 0, 3, 3, 3}},
+		{"mirror", BoundaryMirror, []int{-3, -2, -1, 0, 3, 4, 5, 6}, []int{3, 2, 1, 0, 3, 2, 1, 0}},
+		{"wrap", BoundaryWrap, []int{-5, -1, 0, 3, 4, 9}, []int{3, 3, 0, 3, 0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, j := range tt.taps {
+				idx, ok := tt.boundary.index(j, n)
+				if !ok {
+					idx = -1
+				}
+				if idx != tt.expected[i] {
+					t.Errorf("index(%d) = %d, want %d", j, idx, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBoundaryMirrorSingleSample(t *testing.T) {
+	if idx, ok := BoundaryMirror.index(-3, 1); !ok || idx != 0 {
+		t.Errorf("index(-3) on single sample = %d, %v, want 0, true", idx, ok)
+	}
+}
@@ -0,0 +1,62 @@
+package interpolators
+
+// bsplineNKernel returns the centered B-spline kernel of the given degree, or the
+// cubic for degree 0. It smooths like BSpline3 and BSpline5, which it reproduces
+// for degrees 3 and 5.
+func bsplineNKernel(degree int) kernel {
+	if degree == 0 {
+		degree = 3
+	}
+	derivative := func(x float64, order int) float64 {
+		// β'ₙ(x) = βₙ₋₁(x+½) - βₙ₋₁(x-½), applied order times
+		if order == 1 {
+			return bsplineBasis(degree-1, x+0.5) - bsplineBasis(degree-1, x-0.5)
+		}
+		return bsplineBasis(degree-2, x+1) - 2*bsplineBasis(degree-2, x) + bsplineBasis(degree-2, x-1)
+	}
+	return kernel{
+		impulse:    bsplineImpulse(degree),
+		radius:     (degree + 2) / 2,
+		boundary:   BoundaryZero,
+		derivative: derivative,
+	}
+}
+
+// bsplineImpulse returns the centered B-spline of the given degree, using the
+// hand-expanded polynomials for the common low degrees
+func bsplineImpulse(degree int) func(float64) float64 {
+	switch degree {
+	case 1:
+		return linearImpulse
+	case 3:
+		return bspline3Impulse
+	case 5:
+		return bspline5Impulse
+	}
+	return func(x float64) float64 { return bsplineBasis(degree, x) }
+}
+
+// bsplineBasis evaluates the centered B-spline of the given degree at x with the
+// Cox-de Boor recursion on the integer knots -(degree+1)/2 ... (degree+1)/2.
+// Negative degrees are zero, which ends the derivative identities.
+func bsplineBasis(degree int, x float64) float64 {
+	if degree < 0 {
+		return 0
+	}
+	// Shift so the knots are 0, 1, ..., degree+1
+	u := x + float64(degree+1)/2
+	if u < 0 || u >= float64(degree+1) {
+		return 0
+	}
+	span := int(u)
+	// n[j] holds N_{j,p}(u), the basis function of degree p starting at knot j;
+	// only the one starting at the span is non-zero at degree 0
+	n := make([]float64, degree+2)
+	n[span] = 1
+	for p := 1; p <= degree; p++ {
+		for j := 0; j <= degree-p; j++ {
+			n[j] = ((u-float64(j))*n[j] + (float64(j+p+1)-u)*n[j+1]) / float64(p)
+		}
+	}
+	return n[0]
+}
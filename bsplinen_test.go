@@ -0,0 +1,76 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBSplineBasis(t *testing.T) {
+	// The recursion reproduces the hand-expanded kernels
+	for degree, impulse := range map[int]func(float64) float64{1: linearImpulse, 3: bspline3Impulse, 5: bspline5Impulse} {
+		for x := -3.2; x < 3.2; x += 0.17 {
+			if got, want := bsplineBasis(degree, x), impulse(x); math.Abs(got-want) > 1e-12 {
+				t.Errorf("bsplineBasis(%d, %v) = %v, want %v", degree, x, got, want)
+			}
+		}
+	}
+	// Every degree is a partition of unity with the known center value
+	centers := map[int]float64{2: 0.75, 4: 115.0 / 192, 7: 151.0 / 315, 9: 15619.0 / 36288}
+	for degree, center := range centers {
+		if got := bsplineBasis(degree, 0); math.Abs(got-center) > 1e-12 {
+			t.Errorf("bsplineBasis(%d, 0) = %v, want %v", degree, got, center)
+		}
+		for _, x := range []float64{0, 0.3, 0.5, 0.81} {
+			sum := 0.0
+			for j := -6; j <= 6; j++ {
+				sum += bsplineBasis(degree, x-float64(j))
+			}
+			if math.Abs(sum-1) > 1e-12 {
+				t.Errorf("degree %d B-splines at %v sum to %v, want 1", degree, x, sum)
+			}
+		}
+	}
+}
+
+func TestBSplineN(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2, 6, -3}
+	for degree, fixed := range map[int]InterpolatorType{0: BSpline3, 5: BSpline5} {
+		want, _ := Interpolate(in, 37, fixed)
+		got, err := InterpolateWithOptions(in, 37, BSplineN, Options{Kernel: KernelParams{Degree: degree}})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(BSplineN, degree %d) returned unexpected error: %v", degree, err)
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Errorf("BSplineN degree %d [%d] = %v, want %v", degree, i, got[i], want[i])
+			}
+		}
+	}
+	for degree, radius := range map[int]int{2: 2, 4: 3, 7: 4, 9: 5} {
+		if k := bsplineNKernel(degree); k.radius != radius {
+			t.Errorf("BSplineN degree %d radius = %d, want %d", degree, k.radius, radius)
+		}
+	}
+	if _, err := InterpolateWithOptions(in, 5, BSplineN, Options{Kernel: KernelParams{Degree: -1}}); err == nil {
+		t.Error("InterpolateWithOptions() with a negative degree should return an error")
+	}
+}
+
+func TestBSplineNDerivative(t *testing.T) {
+	for _, degree := range []int{2, 4, 7} {
+		k := bsplineNKernel(degree)
+		for _, order := range []int{1, 2} {
+			d := mustKernelDerivative(t, BSplineN, k, order)
+			f := k.impulse
+			if order == 2 {
+				f = mustKernelDerivative(t, BSplineN, k, 1)
+			}
+			for _, x := range []float64{-2.3, -0.7, 0.2, 1.9} {
+				h := 1e-5
+				if want := (f(x+h) - f(x-h)) / (2 * h); math.Abs(d(x)-want) > 1e-6 {
+					t.Errorf("BSplineN degree %d derivative %d at %v = %v, want %v", degree, order, x, d(x), want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package interpolators
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// InterpolateChannels resamples each channel of planar multi-channel data, such
+// as the left and right channels of stereo audio, to outSamples like Interpolate.
+// All channels must have the same length. For the convolution-based interpolators
+// the taps and weights of every output sample are computed once and applied to
+// all channels; the others fit each channel separately.
+func InterpolateChannels(chs [][]float64, outSamples int, interpolatorType InterpolatorType) ([][]float64, error) {
+	return interpolateChannels(chs, outSamples, interpolatorType, 1)
+}
+
+// InterpolateChannelsParallel is InterpolateChannels with the channels spread over
+// up to GOMAXPROCS goroutines. The output is identical.
+func InterpolateChannelsParallel(chs [][]float64, outSamples int, interpolatorType InterpolatorType) ([][]float64, error) {
+	return interpolateChannels(chs, outSamples, interpolatorType, runtime.GOMAXPROCS(0))
+}
+
+// interpolateChannels resamples the channels with at most workers goroutines
+func interpolateChannels(chs [][]float64, outSamples int, interpolatorType InterpolatorType, workers int) ([][]float64, error) {
+	out := make([][]float64, len(chs))
+	if len(chs) == 0 {
+		return out, nil
+	}
+	n := len(chs[0])
+	for c, ch := range chs {
+		if len(ch) != n {
+			return nil, fmt.Errorf("channel %d has %d samples, want %d like channel 0", c, len(ch), n)
+		}
+	}
+	if err := validate(chs[0], outSamples, interpolatorType); err != nil {
+		return nil, err
+	}
+
+	k, ok := kernelFor(interpolatorType)
+	var resample func(in []float64) []float64
+	if ok && n > 1 {
+		table := newTapTable(k, n, outSamples)
+		resample = table.apply
+	} else {
+		resample = func(in []float64) []float64 {
+			// The input was validated above, so Interpolate cannot fail
+			out, _ := Interpolate(in, outSamples, interpolatorType)
+			return out
+		}
+	}
+
+	workers = max(1, min(workers, len(chs)))
+	if workers == 1 {
+		for c, ch := range chs {
+			out[c] = resample(ch)
+		}
+		return out, nil
+	}
+	var wg sync.WaitGroup
+	next := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range next {
+				out[c] = resample(chs[c])
+			}
+		}()
+	}
+	for c := range chs {
+		next <- c
+	}
+	close(next)
+	wg.Wait()
+	return out, nil
+}
+
+// tapTable holds the input indices and weights of a kernel at each output
+// position of Interpolate, for applying the same resampling to many signals of
+// one length
+type tapTable struct {
+	taps      int
+	normalize bool
+	// index and weight hold taps entries per output sample; an index of -1 marks
+	// a tap dropped by the boundary
+	index  []int
+	weight []float64
+}
+
+// newTapTable evaluates kernel k at the Interpolate output positions for n input
+// samples, visiting the taps in the order of kernel.eval so results match it
+// exactly
+func newTapTable(k kernel, n, outSamples int) *tapTable {
+	taps := 2 * k.radius
+	t := &tapTable{
+		taps:      taps,
+		normalize: k.normalize,
+		index:     make([]int, outSamples*taps),
+		weight:    make([]float64, outSamples*taps),
+	}
+	for i := 0; i < outSamples; i++ {
+		pos := outputPosition(i, n, outSamples)
+		first := k.windowBase(pos) - k.radius + 1
+		for tap := 0; tap < taps; tap++ {
+			j := first + tap
+			idx, ok := k.boundary.index(j, n)
+			if !ok {
+				t.index[i*taps+tap] = -1
+				continue
+			}
+			t.index[i*taps+tap] = idx
+			t.weight[i*taps+tap] = k.impulse(pos - float64(j))
+		}
+	}
+	return t
+}
+
+// apply resamples in, which must have the length the table was built for
+func (t *tapTable) apply(in []float64) []float64 {
+	out := make([]float64, len(t.index)/t.taps)
+	t.applyStrided(in, 0, 1, out, 0, 1)
+	return out
+}
+
+// applyStrided resamples the signal held at in[inOffset+j*inStride] into
+// out[outOffset+i*outStride], so interleaved and strided layouts need no copies
+func (t *tapTable) applyStrided(in []float64, inOffset, inStride int, out []float64, outOffset, outStride int) {
+	for i := 0; i < len(t.index)/t.taps; i++ {
+		sum, weights := 0.0, 0.0
+		for tap := i * t.taps; tap < (i+1)*t.taps; tap++ {
+			idx := t.index[tap]
+			if idx < 0 {
+				continue
+			}
+			sum += in[inOffset+idx*inStride] * t.weight[tap]
+			weights += t.weight[tap]
+		}
+		if t.normalize && weights != 0 {
+			sum /= weights
+		}
+		out[outOffset+i*outStride] = sum
+	}
+}
@@ -0,0 +1,87 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterpolateChannels(t *testing.T) {
+	chs := [][]float64{
+		{0, 1, 4, 9, 16, 25, 36, 49, 64, 81},
+		{1, -1, 2, -2, 3, -3, 4, -4, 5, -5},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+	}
+	for _, info := range All() {
+		typ := info.Type
+		for _, outSamples := range []int{4, 10, 23} {
+			for _, parallel := range []bool{false, true} {
+				f := InterpolateChannels
+				if parallel {
+					f = InterpolateChannelsParallel
+				}
+				got, err := f(chs, outSamples, typ)
+				if err != nil {
+					t.Fatalf("InterpolateChannels(%v) returned unexpected error: %v", typ, err)
+				}
+				if len(got) != len(chs) {
+					t.Fatalf("InterpolateChannels(%v) returned %d channels, want %d", typ, len(got), len(chs))
+				}
+				for c, ch := range chs {
+					want, _ := Interpolate(ch, outSamples, typ)
+					if len(got[c]) != len(want) {
+						t.Fatalf("%v channel %d has %d samples, want %d", typ, c, len(got[c]), len(want))
+					}
+					for i := range want {
+						if math.Abs(got[c][i]-want[i]) > 1e-9 {
+							t.Errorf("%v channel %d [%d] = %v, want %v", typ, c, i, got[c][i], want[i])
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestInterpolateChannelsErrors(t *testing.T) {
+	if out, err := InterpolateChannels(nil, 5, Linear); err != nil || len(out) != 0 {
+		t.Errorf("InterpolateChannels(nil) = %v, %v, want no channels", out, err)
+	}
+	if _, err := InterpolateChannels([][]float64{{1, 2, 3}, {1, 2}}, 5, Linear); err == nil {
+		t.Error("InterpolateChannels with unequal channels returned nil error")
+	}
+	if _, err := InterpolateChannels([][]float64{{1, 2, 3}}, 0, Linear); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateChannels(outSamples=0) returned %v, want ErrInvalidOutSamples", err)
+	}
+	if _, err := InterpolateChannelsParallel([][]float64{{1, 2}, {3, 4}}, 5, Lanczos3); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateChannelsParallel(Lanczos3, 2 samples) returned %v, want ErrTooFewPoints", err)
+	}
+}
+
+func BenchmarkInterpolateChannels(b *testing.B) {
+	chs := make([][]float64, 8)
+	for c := range chs {
+		chs[c] = make([]float64, 4096)
+		for i := range chs[c] {
+			chs[c][i] = math.Sin(float64(i*(c+1)) * 0.01)
+		}
+	}
+	b.Run("Loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, ch := range chs {
+				Interpolate(ch, 9000, LanczosN)
+			}
+		}
+	})
+	b.Run("Channels", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			InterpolateChannels(chs, 9000, LanczosN)
+		}
+	})
+	b.Run("Parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			InterpolateChannelsParallel(chs, 9000, LanczosN)
+		}
+	})
+}
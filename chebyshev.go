@@ -0,0 +1,111 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Chebyshev is a polynomial on [A, B] in the Chebyshev basis, Σ Coeffs[k]·T_k(t)
+// with t = (2x-A-B)/(B-A). For smooth data the coefficients decay geometrically,
+// so a short expansion represents the data to near machine precision, and every
+// evaluation costs O(len(Coeffs)) however long the original signal was.
+type Chebyshev struct {
+	A, B   float64
+	Coeffs []float64
+}
+
+// ChebyshevPoints returns the n Chebyshev points of the second kind on [a, b], the
+// extrema of T_{n-1}, in increasing order. They cluster towards the ends, which
+// keeps polynomial interpolation through them free of Runge oscillation.
+func ChebyshevPoints(n int, a, b float64) []float64 {
+	if n < 1 {
+		return []float64{}
+	}
+	if n == 1 {
+		return []float64{(a + b) / 2}
+	}
+	x := make([]float64, n)
+	for j := range x {
+		t := -math.Cos(math.Pi * float64(j) / float64(n-1))
+		x[j] = (a+b)/2 + (b-a)/2*t
+	}
+	return x
+}
+
+// NewChebyshev returns the polynomial interpolating values at
+// ChebyshevPoints(len(values), a, b). The coefficients come from a discrete cosine
+// transform computed with an FFT, in O(n log n).
+func NewChebyshev(values []float64, a, b float64) (*Chebyshev, error) {
+	n := len(values)
+	if n == 0 {
+		return nil, fmt.Errorf("%w: a Chebyshev expansion needs at least 1 value", ErrTooFewPoints)
+	}
+	if !(a < b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return nil, fmt.Errorf("interval must be finite with a < b, got [%v, %v]", a, b)
+	}
+	if n == 1 {
+		return &Chebyshev{A: a, B: b, Coeffs: []float64{values[0]}}, nil
+	}
+
+	// Mirror the values, taken at t = cos(πj/(n-1)) from t = 1 downwards, into an
+	// even sequence of period 2(n-1), whose FFT is the cosine transform
+	m := n - 1
+	v := make([]float64, 2*m)
+	for j := 0; j <= m; j++ {
+		v[j] = values[n-1-j]
+	}
+	for j := 1; j < m; j++ {
+		v[2*m-j] = v[j]
+	}
+	spectrum := realFFT(v)
+	coeffs := make([]float64, n)
+	for k := range coeffs {
+		coeffs[k] = real(spectrum[k]) / float64(m)
+	}
+	coeffs[0] /= 2
+	coeffs[m] /= 2
+	return &Chebyshev{A: a, B: b, Coeffs: coeffs}, nil
+}
+
+// ChebyshevApprox samples the interpolant of in, with sample i at position i,
+// at n Chebyshev points on [0, len(in)-1] and returns their Chebyshev expansion.
+// Use Resample or At to evaluate it.
+func ChebyshevApprox(in []float64, n int, interpolatorType InterpolatorType) (*Chebyshev, error) {
+	if len(in) < 2 {
+		return nil, fmt.Errorf("%w: a Chebyshev approximation needs at least 2 samples, got %d", ErrTooFewPoints, len(in))
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("%w: a Chebyshev expansion needs at least 1 point, got %d", ErrTooFewPoints, n)
+	}
+	b := float64(len(in) - 1)
+	values, err := InterpolateAt(in, ChebyshevPoints(n, 0, b), interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	return NewChebyshev(values, 0, b)
+}
+
+// At evaluates the expansion at x with Clenshaw's recurrence. Positions outside
+// [A, B] extrapolate the polynomial.
+func (c *Chebyshev) At(x float64) float64 {
+	t := (2*x - c.A - c.B) / (c.B - c.A)
+	var b1, b2 float64
+	for k := len(c.Coeffs) - 1; k >= 1; k-- {
+		b1, b2 = 2*t*b1-b2+c.Coeffs[k], b1
+	}
+	return t*b1 - b2 + c.Coeffs[0]
+}
+
+// Resample evaluates the expansion at outSamples evenly spaced positions from A to
+// B, the first and last on the interval ends as in Interpolate
+func (c *Chebyshev) Resample(outSamples int) []float64 {
+	out := make([]float64, outSamples)
+	for i := range out {
+		x := c.A
+		if outSamples > 1 {
+			x += (c.B - c.A) * float64(i) / float64(outSamples-1)
+		}
+		out[i] = c.At(x)
+	}
+	return out
+}
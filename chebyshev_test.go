@@ -0,0 +1,83 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestChebyshevPoints(t *testing.T) {
+	x := ChebyshevPoints(5, 2, 6)
+	want := []float64{2, 4 - 2*math.Sqrt2/2, 4, 4 + 2*math.Sqrt2/2, 6}
+	for i := range want {
+		if math.Abs(x[i]-want[i]) > 1e-12 {
+			t.Errorf("ChebyshevPoints(5, 2, 6)[%d] = %v, want %v", i, x[i], want[i])
+		}
+	}
+	if got := ChebyshevPoints(1, 2, 6); len(got) != 1 || got[0] != 4 {
+		t.Errorf("ChebyshevPoints(1, 2, 6) = %v, want [4]", got)
+	}
+}
+
+func TestNewChebyshev(t *testing.T) {
+	// T_3(t) = 4t³ - 3t has the single coefficient c₃ = 1
+	x := ChebyshevPoints(6, -1, 1)
+	values := make([]float64, len(x))
+	for i, t := range x {
+		values[i] = 4*t*t*t - 3*t
+	}
+	c, err := NewChebyshev(values, -1, 1)
+	if err != nil {
+		t.Fatalf("NewChebyshev() returned unexpected error: %v", err)
+	}
+	for k, v := range c.Coeffs {
+		want := 0.0
+		if k == 3 {
+			want = 1
+		}
+		if math.Abs(v-want) > 1e-12 {
+			t.Errorf("NewChebyshev() coefficient %d = %v, want %v", k, v, want)
+		}
+	}
+
+	// A smooth function is represented to near machine precision
+	f := func(x float64) float64 { return math.Exp(x/3) * math.Sin(x) }
+	x = ChebyshevPoints(30, 0, 10)
+	values = make([]float64, len(x))
+	for i, v := range x {
+		values[i] = f(v)
+	}
+	c, _ = NewChebyshev(values, 0, 10)
+	for q := 0.0; q <= 10; q += 0.37 {
+		if math.Abs(c.At(q)-f(q)) > 1e-10 {
+			t.Errorf("Chebyshev.At(%v) = %v, want %v", q, c.At(q), f(q))
+		}
+	}
+
+	if _, err := NewChebyshev(nil, 0, 1); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("NewChebyshev(nil) error = %v, want %v", err, ErrTooFewPoints)
+	}
+	if _, err := NewChebyshev([]float64{1, 2}, 1, 1); err == nil {
+		t.Error("NewChebyshev() on an empty interval should return an error")
+	}
+}
+
+func TestChebyshevApprox(t *testing.T) {
+	in := make([]float64, 200)
+	for i := range in {
+		in[i] = math.Sin(float64(i) / 15)
+	}
+	c, err := ChebyshevApprox(in, 40, CubicSpline)
+	if err != nil {
+		t.Fatalf("ChebyshevApprox() returned unexpected error: %v", err)
+	}
+	want, _ := Interpolate(in, 57, CubicSpline)
+	for i, v := range c.Resample(57) {
+		if math.Abs(v-want[i]) > 1e-4 {
+			t.Errorf("ChebyshevApprox().Resample()[%d] = %v, want %v", i, v, want[i])
+		}
+	}
+	if _, err := ChebyshevApprox([]float64{1}, 5, Linear); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("ChebyshevApprox() of one sample error = %v, want %v", err, ErrTooFewPoints)
+	}
+}
@@ -0,0 +1,91 @@
+package interpolators
+
+import "fmt"
+
+// ClampedSpline evaluates the cubic spline through the samples y at the strictly
+// increasing coordinates x whose first derivatives at the two ends are startSlope
+// and endSlope, instead of the zero second derivatives of the natural spline, at
+// the coordinates xq. Known end slopes let interpolated segments be spliced into
+// longer signals without a kink. Queries outside x extend the end cubics.
+func ClampedSpline(x, y, xq []float64, startSlope, endSlope float64) ([]float64, error) {
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+	if len(x) < 2 {
+		return nil, fmt.Errorf("%w: a clamped spline needs at least 2 samples, got %d", ErrTooFewPoints, len(x))
+	}
+	a, b, c, d := clampedSplineCoefficients(x, y, startSlope, endSlope)
+	out := make([]float64, len(xq))
+	for i, q := range xq {
+		j := searchSegment(x, q)
+		dx := q - x[j]
+		out[i] = a[j] + b[j]*dx + c[j]*dx*dx + d[j]*dx*dx*dx
+	}
+	return out, nil
+}
+
+// InterpolateClampedSpline fits a clamped cubic spline to uniformly spaced samples
+// and evaluates it on the same output grid as Interpolate, with the end slopes in
+// value per input sample
+func InterpolateClampedSpline(in []float64, outSamples int, startSlope, endSlope float64) ([]float64, error) {
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	xq := make([]float64, outSamples)
+	for i := range xq {
+		xq[i] = outputPosition(i, len(in), outSamples)
+	}
+	return ClampedSpline(samplePositions(len(in)), in, xq, startSlope, endSlope)
+}
+
+// clampedSplineCoefficients is cubicSplineCoefficients with the first derivatives
+// s0 and sn imposed at the ends
+func clampedSplineCoefficients(x, y []float64, s0, sn float64) (a, b, c, d []float64) {
+	n := len(x) - 1
+	h := make([]float64, n)
+	for i := 0; i < n; i++ {
+		h[i] = x[i+1] - x[i]
+	}
+
+	// Solve tridiagonal system for second derivatives, with the end rows
+	// enforcing the slopes
+	alpha := make([]float64, n+1)
+	alpha[0] = 3*(y[1]-y[0])/h[0] - 3*s0
+	alpha[n] = 3*sn - 3*(y[n]-y[n-1])/h[n-1]
+	for i := 1; i < n; i++ {
+		alpha[i] = (3/h[i])*(y[i+1]-y[i]) - (3/h[i-1])*(y[i]-y[i-1])
+	}
+
+	l := make([]float64, n+1)
+	mu := make([]float64, n+1)
+	z := make([]float64, n+1)
+	l[0] = 2 * h[0]
+	mu[0] = 0.5
+	z[0] = alpha[0] / l[0]
+
+	for i := 1; i < n; i++ {
+		l[i] = 2*(x[i+1]-x[i-1]) - h[i-1]*mu[i-1]
+		mu[i] = h[i] / l[i]
+		z[i] = (alpha[i] - h[i-1]*z[i-1]) / l[i]
+	}
+
+	l[n] = h[n-1] * (2 - mu[n-1])
+	z[n] = (alpha[n] - h[n-1]*z[n-1]) / l[n]
+	c = make([]float64, n+1)
+	b = make([]float64, n)
+	d = make([]float64, n)
+	a = make([]float64, n)
+
+	c[n] = z[n]
+	for j := n - 1; j >= 0; j-- {
+		c[j] = z[j] - mu[j]*c[j+1]
+		b[j] = (y[j+1]-y[j])/h[j] - h[j]*(c[j+1]+2*c[j])/3
+		d[j] = (c[j+1] - c[j]) / (3 * h[j])
+		a[j] = y[j]
+	}
+
+	return a, b, c, d
+}
@@ -0,0 +1,62 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestClampedSpline(t *testing.T) {
+	// A cubic is reproduced exactly when its true end slopes are given
+	cubic := func(x float64) float64 { return ((0.5*x-2)*x+1)*x + 3 }
+	slope := func(x float64) float64 { return (1.5*x-4)*x + 1 }
+	x := []float64{0, 0.5, 1.7, 2.2, 3.6, 5}
+	y := make([]float64, len(x))
+	for i := range y {
+		y[i] = cubic(x[i])
+	}
+	xq := []float64{-0.5, 0.2, 1.1, 2, 3, 4.4, 5.5}
+	got, err := ClampedSpline(x, y, xq, slope(0), slope(5))
+	if err != nil {
+		t.Fatalf("ClampedSpline() returned unexpected error: %v", err)
+	}
+	for i, q := range xq {
+		if math.Abs(got[i]-cubic(q)) > 1e-10 {
+			t.Errorf("ClampedSpline() at %v = %v, want %v", q, got[i], cubic(q))
+		}
+	}
+}
+
+func TestInterpolateClampedSpline(t *testing.T) {
+	in := []float64{1, 3, 2, 5, 4}
+	for _, slopes := range [][2]float64{{0, 0}, {2, -1}, {-3, 4}} {
+		out, err := InterpolateClampedSpline(in, 401, slopes[0], slopes[1])
+		if err != nil {
+			t.Fatalf("InterpolateClampedSpline() returned unexpected error: %v", err)
+		}
+		h := 1e-6
+		ends, _ := ClampedSpline(samplePositions(len(in)), in, []float64{-h, h, 4 - h, 4 + h}, slopes[0], slopes[1])
+		if got := (ends[1] - ends[0]) / (2 * h); math.Abs(got-slopes[0]) > 1e-6 {
+			t.Errorf("start slope = %v, want %v", got, slopes[0])
+		}
+		if got := (ends[3] - ends[2]) / (2 * h); math.Abs(got-slopes[1]) > 1e-6 {
+			t.Errorf("end slope = %v, want %v", got, slopes[1])
+		}
+		for i, v := range in {
+			if math.Abs(out[100*i]-v) > 1e-12 {
+				t.Errorf("InterpolateClampedSpline() at sample %d = %v, want %v", i, out[100*i], v)
+			}
+		}
+	}
+	// Two samples give the Hermite cubic between the slopes
+	out, _ := InterpolateClampedSpline([]float64{0, 1}, 3, 0, 0)
+	if math.Abs(out[1]-0.5) > 1e-12 {
+		t.Errorf("InterpolateClampedSpline() midpoint = %v, want 0.5", out[1])
+	}
+	if _, err := InterpolateClampedSpline([]float64{1}, 3, 0, 0); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateClampedSpline() of one sample error = %v, want %v", err, ErrTooFewPoints)
+	}
+	if _, err := InterpolateClampedSpline(in, 0, 0, 0); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateClampedSpline(outSamples=0) error = %v, want %v", err, ErrInvalidOutSamples)
+	}
+}
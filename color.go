@@ -0,0 +1,292 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// RGB is an sRGB color with gamma-encoded components in [0, 1], as stored in
+// images and written in CSS
+type RGB struct {
+	R, G, B float64
+}
+
+// ColorSpace selects the space InterpolateColors blends colors in
+type ColorSpace int
+
+const (
+	// ColorSRGB blends the gamma-encoded components directly, as naive gradients
+	// do; blends between saturated colors come out dark and muddy
+	ColorSRGB ColorSpace = iota
+	// ColorLinearRGB blends light intensities, which mixes like light but makes
+	// dark ends of a gradient appear to change too quickly
+	ColorLinearRGB
+	// ColorHSL blends hue, saturation and lightness, taking the shorter way round
+	// the hue circle, for gradients that walk through the rainbow
+	ColorHSL
+	// ColorLab blends in CIE L*a*b* with a D65 white point, which is roughly
+	// perceptually uniform
+	ColorLab
+	// ColorOKLab blends in Björn Ottosson's OKLab, a more uniform perceptual
+	// space that keeps hue steady in blends such as blue to white
+	ColorOKLab
+)
+
+// InterpolateColors resamples a sequence of colors, such as the stops of a
+// gradient, to outSamples like Interpolate, blending the three components of the
+// chosen color space. In HSL the hue follows the shorter arc between successive
+// colors, and grays, which have no hue, take the hue of their nearest colored
+// neighbour so the gradient does not swing through unrelated hues. Results
+// outside the sRGB gamut, from overshooting interpolators or from the perceptual
+// spaces, are clamped to [0, 1].
+func InterpolateColors(in []RGB, outSamples int, space ColorSpace, interpolatorType InterpolatorType) ([]RGB, error) {
+	to, from, err := space.conversions()
+	if err != nil {
+		return nil, err
+	}
+	chs := [][]float64{make([]float64, len(in)), make([]float64, len(in)), make([]float64, len(in))}
+	for i, c := range in {
+		chs[0][i], chs[1][i], chs[2][i] = to(c)
+	}
+	if space == ColorHSL {
+		fillHues(chs[0], chs[1])
+		if chs[0], err = UnwrapAngles(chs[0], Degrees); err != nil {
+			return nil, err
+		}
+	}
+	resampled, err := InterpolateChannels(chs, outSamples, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]RGB, len(resampled[0]))
+	for i := range out {
+		a, b, c := resampled[0][i], resampled[1][i], resampled[2][i]
+		if space == ColorHSL {
+			a = wrapAngle(a, 0, 360)
+		}
+		out[i] = from(a, b, c).clamped()
+	}
+	return out, nil
+}
+
+// conversions returns the functions converting sRGB colors to the space and back
+func (s ColorSpace) conversions() (to func(RGB) (float64, float64, float64), from func(a, b, c float64) RGB, err error) {
+	switch s {
+	case ColorSRGB:
+		return func(c RGB) (float64, float64, float64) { return c.R, c.G, c.B },
+			func(r, g, b float64) RGB { return RGB{r, g, b} }, nil
+	case ColorLinearRGB:
+		return RGB.linear, fromLinear, nil
+	case ColorHSL:
+		return RGB.hsl, fromHSL, nil
+	case ColorLab:
+		return RGB.lab, fromLab, nil
+	case ColorOKLab:
+		return RGB.oklab, fromOKLab, nil
+	}
+	return nil, nil, fmt.Errorf("unknown color space %d", s)
+}
+
+// clamped returns c with each component limited to [0, 1]
+func (c RGB) clamped() RGB {
+	clamp := func(v float64) float64 { return math.Max(0, math.Min(1, v)) }
+	return RGB{clamp(c.R), clamp(c.G), clamp(c.B)}
+}
+
+// srgbToLinear decodes an sRGB component into linear light
+func srgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB encodes linear light as an sRGB component
+func linearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// linear returns the linear-light components of c
+func (c RGB) linear() (float64, float64, float64) {
+	return srgbToLinear(c.R), srgbToLinear(c.G), srgbToLinear(c.B)
+}
+
+// fromLinear encodes linear-light components as an sRGB color
+func fromLinear(r, g, b float64) RGB {
+	return RGB{linearToSRGB(r), linearToSRGB(g), linearToSRGB(b)}
+}
+
+// hsl returns the hue in degrees, saturation and lightness of c
+func (c RGB) hsl() (h, s, l float64) {
+	hi := math.Max(c.R, math.Max(c.G, c.B))
+	lo := math.Min(c.R, math.Min(c.G, c.B))
+	l = (hi + lo) / 2
+	d := hi - lo
+	if d == 0 {
+		return 0, 0, l
+	}
+	s = d / (1 - math.Abs(2*l-1))
+	switch hi {
+	case c.R:
+		h = math.Mod((c.G-c.B)/d+6, 6)
+	case c.G:
+		h = (c.B-c.R)/d + 2
+	default:
+		h = (c.R-c.G)/d + 4
+	}
+	return 60 * h, s, l
+}
+
+// fromHSL returns the color of hue h in degrees, saturation s and lightness l
+func fromHSL(h, s, l float64) RGB {
+	chroma := (1 - math.Abs(2*l-1)) * s
+	k := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		return l - chroma/2*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+	return RGB{k(0), k(8), k(4)}
+}
+
+// fillHues gives each gray, whose saturation is zero and hue meaningless, the
+// hue of the nearest color that has one, preferring the earlier on a tie
+func fillHues(hue, saturation []float64) {
+	n := len(hue)
+	source := make([]int, n)
+	last := -1
+	for i := range source {
+		if saturation[i] > 0 {
+			last = i
+		}
+		source[i] = last
+	}
+	next := -1
+	for i := n - 1; i >= 0; i-- {
+		if saturation[i] > 0 {
+			next = i
+			continue
+		}
+		if next >= 0 && (source[i] < 0 || next-i < i-source[i]) {
+			source[i] = next
+		}
+	}
+	for i, j := range source {
+		if j >= 0 {
+			hue[i] = hue[j]
+		}
+	}
+}
+
+// D65 reference white of CIE L*a*b*
+const (
+	labWhiteX = 0.95047
+	labWhiteY = 1.0
+	labWhiteZ = 1.08883
+)
+
+// labF is the companding function of CIE L*a*b*
+func labF(t float64) float64 {
+	const delta = 6.0 / 29
+	if t > delta*delta*delta {
+		return math.Cbrt(t)
+	}
+	return t/(3*delta*delta) + 4.0/29
+}
+
+// labFInverse undoes labF
+func labFInverse(t float64) float64 {
+	const delta = 6.0 / 29
+	if t > delta {
+		return t * t * t
+	}
+	return 3 * delta * delta * (t - 4.0/29)
+}
+
+// xyzFromLinear converts linear sRGB to CIE XYZ, and linearFromXYZ back
+var (
+	xyzFromLinear = matrix3{
+		{0.4124564, 0.3575761, 0.1804375},
+		{0.2126729, 0.7151522, 0.0721750},
+		{0.0193339, 0.1191920, 0.9503041},
+	}
+	linearFromXYZ = xyzFromLinear.inverse()
+)
+
+// lab returns the CIE L*a*b* components of c, with L* from 0 to 100
+func (c RGB) lab() (float64, float64, float64) {
+	x, y, z := xyzFromLinear.apply(c.linear())
+	fx, fy, fz := labF(x/labWhiteX), labF(y/labWhiteY), labF(z/labWhiteZ)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// fromLab returns the sRGB color of CIE L*a*b* components
+func fromLab(l, a, b float64) RGB {
+	fy := (l + 16) / 116
+	return fromLinear(linearFromXYZ.apply(
+		labWhiteX*labFInverse(fy+a/500),
+		labWhiteY*labFInverse(fy),
+		labWhiteZ*labFInverse(fy-b/200),
+	))
+}
+
+// The two matrices of OKLab: linear sRGB to cone responses, and cube-rooted cone
+// responses to L, a and b
+var (
+	lmsFromLinear = matrix3{
+		{0.4122214708, 0.5363325363, 0.0514459929},
+		{0.2119034982, 0.6806995451, 0.1073969566},
+		{0.0883024619, 0.2817188376, 0.6299787005},
+	}
+	linearFromLMS = lmsFromLinear.inverse()
+	oklabFromLMS  = matrix3{
+		{0.2104542553, 0.7936177850, -0.0040720468},
+		{1.9779984951, -2.4285922050, 0.4505937099},
+		{0.0259040371, 0.7827717662, -0.8086757660},
+	}
+	lmsFromOKLab = oklabFromLMS.inverse()
+)
+
+// oklab returns the OKLab components of c, with L from 0 to 1
+func (c RGB) oklab() (float64, float64, float64) {
+	l, m, s := lmsFromLinear.apply(c.linear())
+	return oklabFromLMS.apply(math.Cbrt(l), math.Cbrt(m), math.Cbrt(s))
+}
+
+// fromOKLab returns the sRGB color of OKLab components
+func fromOKLab(L, a, b float64) RGB {
+	l, m, s := lmsFromOKLab.apply(L, a, b)
+	return fromLinear(linearFromLMS.apply(l*l*l, m*m*m, s*s*s))
+}
+
+// matrix3 is a 3×3 matrix in row-major order
+type matrix3 [3][3]float64
+
+// apply returns the product of m and the column vector (a, b, c)
+func (m matrix3) apply(a, b, c float64) (float64, float64, float64) {
+	return m[0][0]*a + m[0][1]*b + m[0][2]*c,
+		m[1][0]*a + m[1][1]*b + m[1][2]*c,
+		m[2][0]*a + m[2][1]*b + m[2][2]*c
+}
+
+// inverse returns the inverse of m by cofactors; the color matrices are far from
+// singular
+func (m matrix3) inverse() matrix3 {
+	var inv matrix3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			// The cofactor of m[j][i], from the cyclic minors
+			r0, r1 := (j+1)%3, (j+2)%3
+			c0, c1 := (i+1)%3, (i+2)%3
+			inv[i][j] = m[r0][c0]*m[r1][c1] - m[r0][c1]*m[r1][c0]
+		}
+	}
+	det := m[0][0]*inv[0][0] + m[0][1]*inv[1][0] + m[0][2]*inv[2][0]
+	for i := range inv {
+		for j := range inv[i] {
+			inv[i][j] /= det
+		}
+	}
+	return inv
+}
@@ -0,0 +1,112 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+// closeColor reports whether two colors agree to within tol in every component
+func closeColor(a, b RGB, tol float64) bool {
+	return math.Abs(a.R-b.R) <= tol && math.Abs(a.G-b.G) <= tol && math.Abs(a.B-b.B) <= tol
+}
+
+func TestColorSpaceRoundTrip(t *testing.T) {
+	colors := []RGB{{0, 0, 0}, {1, 1, 1}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {0.2, 0.5, 0.9}, {0.93, 0.61, 0.02}, {0.5, 0.5, 0.5}, {0.01, 0.002, 0.003}}
+	for _, space := range []ColorSpace{ColorSRGB, ColorLinearRGB, ColorHSL, ColorLab, ColorOKLab} {
+		to, from, err := space.conversions()
+		if err != nil {
+			t.Fatalf("conversions(%d) returned unexpected error: %v", space, err)
+		}
+		for _, c := range colors {
+			if got := from(to(c)); !closeColor(got, c, 1e-9) {
+				t.Errorf("space %d round trip of %v = %v", space, c, got)
+			}
+		}
+	}
+	// Reference values: sRGB red in CIE L*a*b* and OKLab
+	if l, a, b := (RGB{1, 0, 0}).lab(); math.Abs(l-53.24) > 0.01 || math.Abs(a-80.09) > 0.01 || math.Abs(b-67.20) > 0.01 {
+		t.Errorf("lab(red) = %v, %v, %v, want 53.24, 80.09, 67.20", l, a, b)
+	}
+	if l, a, b := (RGB{1, 0, 0}).oklab(); math.Abs(l-0.62796) > 1e-4 || math.Abs(a-0.22486) > 1e-4 || math.Abs(b-0.12585) > 1e-4 {
+		t.Errorf("oklab(red) = %v, %v, %v, want 0.62796, 0.22486, 0.12585", l, a, b)
+	}
+}
+
+func TestInterpolateColors(t *testing.T) {
+	red, blue, white := RGB{1, 0, 0}, RGB{0, 0, 1}, RGB{1, 1, 1}
+	mid := func(a, b RGB, space ColorSpace) RGB {
+		out, err := InterpolateColors([]RGB{a, b}, 3, space, Linear)
+		if err != nil {
+			t.Fatalf("InterpolateColors(%d) returned unexpected error: %v", space, err)
+		}
+		return out[1]
+	}
+	half := linearToSRGB(0.5)
+	for _, tc := range []struct {
+		name  string
+		got   RGB
+		want  RGB
+		space ColorSpace
+	}{
+		{"sRGB red to blue", mid(red, blue, ColorSRGB), RGB{0.5, 0, 0.5}, ColorSRGB},
+		{"linear red to blue", mid(red, blue, ColorLinearRGB), RGB{half, 0, half}, ColorLinearRGB},
+		// Hue 0° to 240° goes the short way, through magenta at 300°
+		{"HSL red to blue", mid(red, blue, ColorHSL), RGB{1, 0, 1}, ColorHSL},
+		// White has no hue and takes red's, so the blend is pink, not cyan
+		{"HSL white to red", mid(white, red, ColorHSL), RGB{0.875, 0.625, 0.625}, ColorHSL},
+	} {
+		if !closeColor(tc.got, tc.want, 1e-9) {
+			t.Errorf("%s midpoint = %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+
+	// HSL hues crossing 0° go through red, not round through cyan
+	out, _ := InterpolateColors([]RGB{fromHSL(340, 1, 0.5), fromHSL(20, 1, 0.5)}, 5, ColorHSL, Linear)
+	for i, c := range out {
+		h, _, _ := c.hsl()
+		want := wrapAngle(340+10*float64(i), 0, 360)
+		if d := math.Abs(h - want); math.Min(d, 360-d) > 1e-6 {
+			t.Errorf("HSL hue [%d] = %v, want %v", i, h, want)
+		}
+	}
+
+	// Perceptual spaces put the midpoint halfway in lightness
+	sky := RGB{0.3, 0.45, 0.85}
+	for _, space := range []ColorSpace{ColorLab, ColorOKLab} {
+		to, _, _ := space.conversions()
+		m := mid(sky, white, space)
+		l0, _, _ := to(sky)
+		l1, _, _ := to(white)
+		if l, _, _ := to(m); math.Abs(l-(l0+l1)/2) > 1e-6 {
+			t.Errorf("space %d sky to white midpoint lightness = %v, want %v", space, l, (l0+l1)/2)
+		}
+	}
+	// OKLab keeps the hue on the way to white, since white sits at a = b = 0
+	_, a0, b0 := sky.oklab()
+	_, a, b := mid(sky, white, ColorOKLab).oklab()
+	if d := math.Abs(math.Atan2(b, a) - math.Atan2(b0, a0)); d > 1e-6 {
+		t.Errorf("OKLab sky to white midpoint hue differs from the sky's by %v radians", d)
+	}
+}
+
+func TestInterpolateColorsClamp(t *testing.T) {
+	// The overshoot of a cubic spline through sharp changes stays inside the gamut
+	in := []RGB{{0, 0, 0}, {0, 0, 0}, {1, 1, 1}, {1, 1, 1}, {0, 0, 0}}
+	for _, space := range []ColorSpace{ColorSRGB, ColorLinearRGB, ColorHSL, ColorLab, ColorOKLab} {
+		out, err := InterpolateColors(in, 40, space, CubicSpline)
+		if err != nil {
+			t.Fatalf("InterpolateColors(%d) returned unexpected error: %v", space, err)
+		}
+		for i, c := range out {
+			if c != c.clamped() {
+				t.Errorf("space %d output %d = %v, outside [0, 1]", space, i, c)
+			}
+		}
+	}
+	if _, err := InterpolateColors(in, 4, ColorSpace(42), Linear); err == nil {
+		t.Error("InterpolateColors with an unknown space returned nil error")
+	}
+	if out, err := InterpolateColors(nil, 4, ColorOKLab, Linear); err != nil || len(out) != 0 {
+		t.Errorf("InterpolateColors(nil) = %v, %v, want empty output", out, err)
+	}
+}
@@ -0,0 +1,128 @@
+package interpolators
+
+import "math"
+
+// compareMaxLag bounds the cross-correlation lag search performed by Compare
+const compareMaxLag = 1024
+
+// DiffStats summarizes the differences between two signals
+type DiffStats struct {
+	// Samples is the number of samples compared (the shorter of the two lengths)
+	Samples int
+	// MaxError is the largest absolute sample difference
+	MaxError float64
+	// RMSError is the root-mean-square sample difference
+	RMSError float64
+	// Correlation is the Pearson correlation coefficient of the two signals
+	Correlation float64
+	// Lag is the estimated delay of b relative to a in (fractional) samples,
+	// positive when b lags behind a
+	Lag float64
+}
+
+// Compare computes difference statistics between a and b, for null tests between
+// kernels or validation against reference implementations. Only the first
+// min(len(a), len(b)) samples are compared. The lag is found from the peak of the
+// cross-correlation and refined to sub-sample precision with parabolic interpolation.
+func Compare(a, b []float64) DiffStats {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	stats := DiffStats{Samples: n}
+	if n == 0 {
+		return stats
+	}
+	a = a[:n]
+	b = b[:n]
+
+	var sumSq, meanA, meanB float64
+	for i := 0; i < n; i++ {
+		diff := math.Abs(a[i] - b[i])
+		if diff > stats.MaxError {
+			stats.MaxError = diff
+		}
+		sumSq += diff * diff
+		meanA += a[i]
+		meanB += b[i]
+	}
+	stats.RMSError = math.Sqrt(sumSq / float64(n))
+	meanA /= float64(n)
+	meanB /= float64(n)
+
+	var cov, varA, varB float64
+	for i := 0; i < n; i++ {
+		da := a[i] - meanA
+		db := b[i] - meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+	if varA > 0 && varB > 0 {
+		stats.Correlation = cov / math.Sqrt(varA*varB)
+	}
+
+	maxLag := n - 1
+	if maxLag > compareMaxLag {
+		maxLag = compareMaxLag
+	}
+	stats.Lag = estimateLag(a, b, maxLag)
+
+	return stats
+}
+
+// crossCorrelate returns r[k+maxLag] = sum_i a[i]*b[i+k] for k in [-maxLag, maxLag]
+func crossCorrelate(a, b []float64, maxLag int) []float64 {
+	r := make([]float64, 2*maxLag+1)
+	for k := -maxLag; k <= maxLag; k++ {
+		sum := 0.0
+		for i := range a {
+			j := i + k
+			if j < 0 || j >= len(b) {
+				continue
+			}
+			sum += a[i] * b[j]
+		}
+		r[k+maxLag] = sum
+	}
+	return r
+}
+
+// estimateLag returns the sub-sample lag of b relative to a within ±maxLag
+func estimateLag(a, b []float64, maxLag int) float64 {
+	if maxLag < 0 {
+		return 0
+	}
+	r := crossCorrelate(a, b, maxLag)
+
+	best := 0
+	for k := range r {
+		if r[k] > r[best] {
+			best = k
+		}
+	}
+
+	lag := float64(best - maxLag)
+	if best > 0 && best < len(r)-1 {
+		offset, _ := parabolicPeak(r[best-1], r[best], r[best+1])
+		lag += offset
+	}
+	return lag
+}
+
+// parabolicPeak fits a parabola through three equally spaced values centered on y1
+// and returns the offset of its vertex from the center (in [-1, 1]) and its value
+func parabolicPeak(y0, y1, y2 float64) (offset, value float64) {
+	denom := y0 - 2*y1 + y2
+	if denom == 0 {
+		return 0, y1
+	}
+	offset = 0.5 * (y0 - y2) / denom
+	if offset < -1 {
+		offset = -1
+	} else if offset > 1 {
+		offset = 1
+	}
+	value = y1 - 0.25*(y0-y2)*offset
+	return offset, value
+}
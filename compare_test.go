@@ -0,0 +1,105 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name        string
+		a, b        []float64
+		maxError    float64
+		rmsError    float64
+		correlation float64
+	}{
+		{
+			name:        "identical",
+			a:           []float64{1, 2, 3, 4},
+			b:           []float64{1, 2, 3, 4},
+			maxError:    0,
+			rmsError:    0,
+			correlation: 1,
+		},
+		{
+			name:        "constant offset",
+			a:           []float64{1, 2, 3, 4},
+			b:           []float64{2, 3, 4, 5},
+			maxError:    1,
+			rmsError:    1,
+			correlation: 1,
+		},
+		{
+			name:        "inverted",
+			a:           []float64{1, -1, 1, -1},
+			b:           []float64{-1, 1, -1, 1},
+			maxError:    2,
+			rmsError:    2,
+			correlation: -1,
+		},
+		{
+			name:        "different lengths",
+			a:           []float64{1, 2, 3},
+			b:           []float64{1, 2, 3, 100},
+			maxError:    0,
+			rmsError:    0,
+			correlation: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := Compare(tt.a, tt.b)
+			if math.Abs(stats.MaxError-tt.maxError) > 1e-10 {
+				t.Errorf("Compare() MaxError = %v, want %v", stats.MaxError, tt.maxError)
+			}
+			if math.Abs(stats.RMSError-tt.rmsError) > 1e-10 {
+				t.Errorf("Compare() RMSError = %v, want %v", stats.RMSError, tt.rmsError)
+			}
+			if math.Abs(stats.Correlation-tt.correlation) > 1e-10 {
+				t.Errorf("Compare() Correlation = %v, want %v", stats.Correlation, tt.correlation)
+			}
+		})
+	}
+}
+
+func TestCompareEmpty(t *testing.T) {
+	stats := Compare(nil, []float64{1, 2})
+	if stats != (DiffStats{}) {
+		t.Errorf("Compare() on empty input = %+v, want zero value", stats)
+	}
+}
+
+func TestCompareLag(t *testing.T) {
+	// A smooth pulse and the same pulse delayed by a fractional number of samples
+	n := 200
+	delay := 3.4
+	a := make([]float64, n)
+	b := make([]float64, n)
+	for i := 0; i < n; i++ {
+		a[i] = math.Exp(-math.Pow(float64(i)-100, 2) / 50)
+		b[i] = math.Exp(-math.Pow(float64(i)-100-delay, 2) / 50)
+	}
+
+	stats := Compare(a, b)
+	if math.Abs(stats.Lag-delay) > 0.1 {
+		t.Errorf("Compare() Lag = %v, want %v", stats.Lag, delay)
+	}
+
+	stats = Compare(b, a)
+	if math.Abs(stats.Lag+delay) > 0.1 {
+		t.Errorf("Compare() reversed Lag = %v, want %v", stats.Lag, -delay)
+	}
+}
+
+func TestParabolicPeak(t *testing.T) {
+	// Samples of y = 5 - (x-0.25)^2 at x = -1, 0, 1
+	f := func(x float64) float64 { return 5 - (x-0.25)*(x-0.25) }
+	offset, value := parabolicPeak(f(-1), f(0), f(1))
+	if math.Abs(offset-0.25) > 1e-10 {
+		t.Errorf("parabolicPeak() offset = %v, want 0.25", offset)
+	}
+	if math.Abs(value-5) > 1e-10 {
+		t.Errorf("parabolicPeak() value = %v, want 5", value)
+	}
+}
@@ -0,0 +1,39 @@
+package interpolators
+
+import "fmt"
+
+// InterpolateComplex resamples complex samples, such as IQ data or a spectrum,
+// like Interpolate. The interpolators are linear in the data, so resampling the
+// real and imaginary parts with the same weights is the same as resampling the
+// complex values; both parts share one set of weights. The shape-preserving
+// interpolators (MonotonicCubic, Akima, Hyman and Schumaker) and LTTB adapt to
+// the data and would treat the parts differently, so they are rejected.
+func InterpolateComplex(in []complex128, outSamples int, interpolatorType InterpolatorType) ([]complex128, error) {
+	if !linearInData(interpolatorType) {
+		return nil, fmt.Errorf("interpolator type %v adapts to the data and has no complex form", interpolatorType)
+	}
+	re, im := make([]float64, len(in)), make([]float64, len(in))
+	for i, v := range in {
+		re[i], im[i] = real(v), imag(v)
+	}
+	parts, err := InterpolateChannels([][]float64{re, im}, outSamples, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]complex128, len(parts[0]))
+	for i := range out {
+		out[i] = complex(parts[0][i], parts[1][i])
+	}
+	return out, nil
+}
+
+// linearInData reports whether the interpolant of a sum of signals is the sum of
+// their interpolants, which holds for every interpolator except those whose
+// weights depend on the samples
+func linearInData(interpolatorType InterpolatorType) bool {
+	switch interpolatorType {
+	case MonotonicCubic, Akima, Hyman, Schumaker, LTTB:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestInterpolateComplex(t *testing.T) {
+	// A complex tone oversampled enough that the interpolants follow it closely
+	in := make([]complex128, 32)
+	for i := range in {
+		in[i] = cmplx.Rect(1+0.1*float64(i%3), 0.3*float64(i))
+	}
+	re, im := make([]float64, len(in)), make([]float64, len(in))
+	for i, v := range in {
+		re[i], im[i] = real(v), imag(v)
+	}
+	for _, info := range All() {
+		typ := info.Type
+		if !linearInData(typ) {
+			continue
+		}
+		got, err := InterpolateComplex(in, 45, typ)
+		if err != nil {
+			t.Fatalf("InterpolateComplex(%v) returned unexpected error: %v", typ, err)
+		}
+		wantRe, _ := Interpolate(re, 45, typ)
+		wantIm, _ := Interpolate(im, 45, typ)
+		if len(got) != len(wantRe) {
+			t.Fatalf("InterpolateComplex(%v) has %d samples, want %d", typ, len(got), len(wantRe))
+		}
+		for i := range got {
+			if cmplx.Abs(got[i]-complex(wantRe[i], wantIm[i])) > 1e-9 {
+				t.Errorf("InterpolateComplex(%v)[%d] = %v, want %v", typ, i, got[i], complex(wantRe[i], wantIm[i]))
+				break
+			}
+		}
+	}
+
+	// Rotating the input by a constant phase rotates the output by the same phase
+	rotation := cmplx.Rect(1, 1.1)
+	rotated := make([]complex128, len(in))
+	for i, v := range in {
+		rotated[i] = v * rotation
+	}
+	a, _ := InterpolateComplex(in, 77, Lanczos3)
+	b, _ := InterpolateComplex(rotated, 77, Lanczos3)
+	for i := range a {
+		if cmplx.Abs(a[i]*rotation-b[i]) > 1e-12 {
+			t.Errorf("rotated output [%d] = %v, want %v", i, b[i], a[i]*rotation)
+		}
+	}
+}
+
+func TestInterpolateComplexErrors(t *testing.T) {
+	for _, typ := range []InterpolatorType{MonotonicCubic, Akima, Hyman, Schumaker, LTTB} {
+		if _, err := InterpolateComplex([]complex128{1, 1i, -1, -1i}, 8, typ); err == nil {
+			t.Errorf("InterpolateComplex(%v) returned nil error", typ)
+		}
+	}
+	if _, err := InterpolateComplex([]complex128{1, 1i}, 0, Linear); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateComplex(outSamples=0) returned %v, want ErrInvalidOutSamples", err)
+	}
+	if out, err := InterpolateComplex(nil, 4, Linear); err != nil || len(out) != 0 {
+		t.Errorf("InterpolateComplex(nil) = %v, %v, want empty output", out, err)
+	}
+	if out, _ := InterpolateComplex([]complex128{complex(2, -3)}, 3, CubicSpline); len(out) != 3 || math.Abs(real(out[2])-2) > 0 || imag(out[2]) != -3 {
+		t.Errorf("InterpolateComplex of one sample = %v, want 3 copies of (2-3i)", out)
+	}
+}
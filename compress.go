@@ -0,0 +1,59 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Compress reduces a series with the swinging-door algorithm for storage, keeping
+// the points from which linear interpolation reproduces every original value within
+// tolerance, the companion of ReconstructDeadband and InterpolateXY(..., Linear)
+// for display. Times must be strictly increasing.
+//
+// Each archived point opens a pair of doors through the tolerance band of every
+// following point, and a new point is archived when the doors close. Classic
+// swinging door archives the last original value, which can drift slightly beyond
+// tolerance; here the archived value is the nearest one the doors still allow. It
+// differs from the original by at most tolerance and equals it whenever possible,
+// so the round-trip error never exceeds tolerance.
+func Compress(times, values []float64, tolerance float64) (compressedTimes, compressedValues []float64, err error) {
+	if err := checkXY(times, values); err != nil {
+		return nil, nil, err
+	}
+	if !(tolerance >= 0) || math.IsInf(tolerance, 0) {
+		return nil, nil, fmt.Errorf("tolerance must be finite and not negative, got %v", tolerance)
+	}
+	n := len(times)
+	if n <= 2 {
+		return append([]float64(nil), times...), append([]float64(nil), values...), nil
+	}
+
+	compressedTimes = []float64{times[0]}
+	compressedValues = []float64{values[0]}
+	ta, va := times[0], values[0]
+	lower, upper := math.Inf(-1), math.Inf(1)
+	// archive stores the point at time times[p] on the segment from the archived
+	// point whose slope is closest to the original value within the open doors
+	archive := func(p int) {
+		slope := math.Max(lower, math.Min(upper, (values[p]-va)/(times[p]-ta)))
+		ta, va = times[p], va+slope*(times[p]-ta)
+		compressedTimes = append(compressedTimes, ta)
+		compressedValues = append(compressedValues, va)
+	}
+	for i := 1; i < n; i++ {
+		dt := times[i] - ta
+		lo := (values[i] - tolerance - va) / dt
+		hi := (values[i] + tolerance - va) / dt
+		if math.Max(lower, lo) > math.Min(upper, hi) {
+			// The doors close at i; the previous point ends the segment
+			archive(i - 1)
+			dt = times[i] - ta
+			lower = (values[i] - tolerance - va) / dt
+			upper = (values[i] + tolerance - va) / dt
+			continue
+		}
+		lower, upper = math.Max(lower, lo), math.Min(upper, hi)
+	}
+	archive(n - 1)
+	return compressedTimes, compressedValues, nil
+}
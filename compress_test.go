@@ -0,0 +1,61 @@
+package interpolators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCompressRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	times := make([]float64, 2000)
+	values := make([]float64, len(times))
+	now := 0.0
+	for i := range times {
+		now += 0.5 + rng.Float64()
+		times[i] = now
+		values[i] = 10*math.Sin(now/40) + rng.NormFloat64()*0.05
+	}
+
+	for _, tolerance := range []float64{0, 0.05, 0.2, 1} {
+		ct, cv, err := Compress(times, values, tolerance)
+		if err != nil {
+			t.Fatalf("Compress() returned unexpected error: %v", err)
+		}
+		if ct[0] != times[0] || ct[len(ct)-1] != times[len(times)-1] {
+			t.Errorf("Compress(%v) does not span the series", tolerance)
+		}
+		restored, _ := InterpolateXY(ct, cv, times, Linear)
+		worst := 0.0
+		for i := range values {
+			worst = math.Max(worst, math.Abs(restored[i]-values[i]))
+		}
+		if worst > tolerance+1e-9 {
+			t.Errorf("Compress(%v) round-trip error = %v, want at most the tolerance", tolerance, worst)
+		}
+		if tolerance >= 0.2 && len(ct) > len(times)/5 {
+			t.Errorf("Compress(%v) kept %d of %d points, want at most a fifth", tolerance, len(ct), len(times))
+		}
+	}
+}
+
+func TestCompressLine(t *testing.T) {
+	times := []float64{0, 1, 2, 3, 5, 8}
+	values := []float64{1, 3, 5, 7, 11, 17}
+	ct, cv, err := Compress(times, values, 0.01)
+	if err != nil {
+		t.Fatalf("Compress() returned unexpected error: %v", err)
+	}
+	if len(ct) != 2 || cv[0] != 1 || cv[1] != 17 {
+		t.Errorf("Compress() of a line = %v, %v, want its end points", ct, cv)
+	}
+}
+
+func TestCompressErrors(t *testing.T) {
+	if _, _, err := Compress([]float64{0, 1}, []float64{0, 1}, -1); err == nil {
+		t.Error("Compress() with a negative tolerance should return an error")
+	}
+	if _, _, err := Compress([]float64{1, 0}, []float64{0, 1}, 1); err == nil {
+		t.Error("Compress() with decreasing times should return an error")
+	}
+}
@@ -0,0 +1,66 @@
+package interpolators
+
+import "math"
+
+// SecondDerivative returns the analytic second derivative of the interpolant at the
+// output positions of Interpolate, in units per input sample squared. The cubic
+// Hermite-type interpolants (Hermite4, MonotonicCubic, Akima and similar) are only
+// C¹, so their second derivative jumps at the input samples; there the value of the
+// piece to the right is returned.
+func SecondDerivative(in []float64, outSamples int, interpolatorType InterpolatorType) ([]float64, error) {
+	return derivative(in, outSamples, interpolatorType, 2)
+}
+
+// SecondDerivativeAt returns the analytic second derivative of the interpolant at
+// arbitrary fractional positions
+func SecondDerivativeAt(in []float64, positions []float64, interpolatorType InterpolatorType) ([]float64, error) {
+	return derivativeAt(in, positions, interpolatorType, 2)
+}
+
+// Curvature returns the signed curvature f''/(1+f'²)^(3/2) of the interpolant at the
+// output positions of Interpolate, treating one input sample as one unit along x.
+// Its magnitude at a peak measures the peak's sharpness.
+func Curvature(in []float64, outSamples int, interpolatorType InterpolatorType) ([]float64, error) {
+	d1, err := derivative(in, outSamples, interpolatorType, 1)
+	if err != nil {
+		return nil, err
+	}
+	d2, err := derivative(in, outSamples, interpolatorType, 2)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(d1))
+	for i := range out {
+		out[i] = d2[i] / math.Pow(1+d1[i]*d1[i], 1.5)
+	}
+	return out, nil
+}
+
+// Inflections returns the fractional positions where the second derivative of the
+// interpolant changes sign, in increasing order. The second derivative is sampled at
+// the input positions and each sign change is located by bisection; stretches where
+// it is exactly zero, such as the ends of a natural cubic spline, are skipped.
+func Inflections(in []float64, interpolatorType InterpolatorType) []float64 {
+	inflections := []float64{}
+	if len(in) < 3 {
+		return inflections
+	}
+
+	f, err := newDerivativeEvaluator(in, interpolatorType, 2)
+	if err != nil {
+		// No second derivative, so no sign changes to locate
+		return inflections
+	}
+	last, lastPos := 0.0, 0.0
+	for k := 0; k < len(in); k++ {
+		cur := f(float64(k))
+		if cur == 0 {
+			continue
+		}
+		if last*cur < 0 {
+			inflections = append(inflections, bisect(f, lastPos, float64(k), last))
+		}
+		last, lastPos = cur, float64(k)
+	}
+	return inflections
+}
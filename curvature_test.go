@@ -0,0 +1,81 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSecondDerivativeOfQuadratic(t *testing.T) {
+	in := make([]float64, 12)
+	for i := range in {
+		x := float64(i)
+		in[i] = 0.5*x*x - 3*x + 1
+	}
+	// Exact for interpolants reproducing quadratics, away from the edges
+	for _, typ := range []InterpolatorType{Lagrange4, Lagrange6, Hermite4, Hermite6_5, Osculating6, Akima} {
+		got, err := SecondDerivativeAt(in, []float64{4.3, 5.5, 6.9}, typ)
+		if err != nil {
+			t.Fatalf("SecondDerivativeAt(%v) returned unexpected error: %v", typ, err)
+		}
+		for i, v := range got {
+			if math.Abs(v-1) > 1e-9 {
+				t.Errorf("SecondDerivativeAt(%v)[%d] = %v, want 1", typ, i, v)
+			}
+		}
+	}
+}
+
+func TestSecondDerivativeMatchesFiniteDifference(t *testing.T) {
+	in := []float64{0.5, 2, -1, 3, 4, 1, -2, 0, 1.5, 2.5}
+	positions := []float64{1.7, 3.2, 4.45, 6.6}
+	const h = 1e-5
+	for _, typ := range []InterpolatorType{BSpline3, BSpline5, Lanczos3, CubicSpline, MonotonicCubic, Akima, Hermite4} {
+		got, _ := SecondDerivativeAt(in, positions, typ)
+		for i, pos := range positions {
+			f, _ := DerivativeAt(in, []float64{pos - h, pos + h}, typ)
+			want := (f[1] - f[0]) / (2 * h)
+			if math.Abs(got[i]-want) > 1e-4*math.Max(1, math.Abs(want)) {
+				t.Errorf("SecondDerivativeAt(%v) at %v = %v, want %v", typ, pos, got[i], want)
+			}
+		}
+	}
+}
+
+func TestCurvature(t *testing.T) {
+	// Samples of a circle of radius 20 around its top; curvature is -1/20
+	in := make([]float64, 21)
+	for i := range in {
+		x := float64(i - 10)
+		in[i] = math.Sqrt(400 - x*x)
+	}
+	out, err := Curvature(in, 41, CubicSpline)
+	if err != nil {
+		t.Fatalf("Curvature() returned unexpected error: %v", err)
+	}
+	for i := 10; i <= 30; i++ {
+		if math.Abs(out[i]+0.05) > 0.002 {
+			t.Errorf("Curvature()[%d] = %v, want -0.05", i, out[i])
+		}
+	}
+}
+
+func TestInflections(t *testing.T) {
+	// sin(x/3) inflects at multiples of 3π
+	in := make([]float64, 35)
+	for i := range in {
+		in[i] = math.Sin(float64(i) / 3)
+	}
+	want := []float64{3 * math.Pi, 6 * math.Pi, 9 * math.Pi}
+	got := Inflections(in, CubicSpline)
+	if len(got) != len(want) {
+		t.Fatalf("Inflections() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 0.05 {
+			t.Errorf("Inflections()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got := Inflections([]float64{1, 2, 3, 4}, CubicSpline); len(got) != 0 {
+		t.Errorf("Inflections() of a line = %v, want none", got)
+	}
+}
@@ -0,0 +1,112 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Parameterization selects how the parameter of a curve advances between points
+type Parameterization int
+
+const (
+	// ParamUniform advances the parameter by one per point
+	ParamUniform Parameterization = iota
+	// ParamChordal advances the parameter by the distance between points, which
+	// suits unevenly spaced waypoints
+	ParamChordal
+	// ParamCentripetal advances the parameter by the square root of the distance,
+	// which keeps cubic curves free of cusps and self-intersections
+	ParamCentripetal
+)
+
+// InterpolateCurve returns a smooth path of outSamples points through points in any
+// number of dimensions, each point having the same number of coordinates. Every
+// coordinate is interpolated with the given interpolator over a shared parameter
+// chosen by param, and the output is equally spaced in that parameter from the
+// first point to the last. Use ResampleByArcLength for points equally spaced
+// along the path instead.
+func InterpolateCurve(points [][]float64, outSamples int, param Parameterization, interpolatorType InterpolatorType) ([][]float64, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	dim, err := checkPoints(points)
+	if err != nil {
+		return nil, err
+	}
+	if need := minPoints(interpolatorType); len(points) > 1 && len(points) < need {
+		return nil, fmt.Errorf("%w: %v needs at least %d points, got %d", ErrTooFewPoints, interpolatorType, need, len(points))
+	}
+	knots, err := curveKnots(points, param)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]float64, outSamples)
+	for i := range out {
+		out[i] = make([]float64, dim)
+	}
+	if len(points) == 1 {
+		for i := range out {
+			copy(out[i], points[0])
+		}
+		return out, nil
+	}
+
+	last := knots[len(knots)-1]
+	column := make([]float64, len(points))
+	for d := 0; d < dim; d++ {
+		for i, p := range points {
+			column[i] = p[d]
+		}
+		f := newXYEvaluator(knots, append([]float64(nil), column...), interpolatorType)
+		for i := range out {
+			out[i][d] = f(outputPosition(i, 2, outSamples) * last)
+		}
+	}
+	return out, nil
+}
+
+// curveKnots returns the parameter value at each point
+func curveKnots(points [][]float64, param Parameterization) ([]float64, error) {
+	var exponent float64
+	switch param {
+	case ParamUniform:
+		exponent = 0
+	case ParamChordal:
+		exponent = 1
+	case ParamCentripetal:
+		exponent = 0.5
+	default:
+		return nil, fmt.Errorf("unknown parameterization %d", param)
+	}
+	knots := make([]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		d := distance(points[i-1], points[i])
+		if d == 0 && param != ParamUniform {
+			return nil, fmt.Errorf("points %d and %d coincide", i-1, i)
+		}
+		knots[i] = knots[i-1] + math.Pow(d, exponent)
+	}
+	return knots, nil
+}
+
+// checkPoints validates a list of points and returns their dimension
+func checkPoints(points [][]float64) (int, error) {
+	if len(points) == 0 {
+		return 0, fmt.Errorf("%w: got no points", ErrTooFewPoints)
+	}
+	dim := len(points[0])
+	if dim == 0 {
+		return 0, errors.New("points must have at least one coordinate")
+	}
+	for i, p := range points {
+		if len(p) != dim {
+			return 0, fmt.Errorf("point %d has %d coordinates, want %d", i, len(p), dim)
+		}
+	}
+	return dim, nil
+}
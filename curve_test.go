@@ -0,0 +1,75 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateCurvePassesThroughWaypoints(t *testing.T) {
+	points := [][]float64{{0, 0, 0}, {1, 2, 1}, {3, 3, 2}, {4, 1, 4}, {6, 0, 5}}
+	for _, param := range []Parameterization{ParamUniform, ParamChordal, ParamCentripetal} {
+		out, err := InterpolateCurve(points, 9, param, CubicSpline)
+		if err != nil {
+			t.Fatalf("InterpolateCurve(%d) returned unexpected error: %v", param, err)
+		}
+		for _, i := range []int{0, 8} {
+			want := points[i/2]
+			for d := range want {
+				if math.Abs(out[i][d]-want[d]) > 1e-12 {
+					t.Errorf("InterpolateCurve(%d)[%d] = %v, want %v", param, i, out[i], want)
+					break
+				}
+			}
+		}
+	}
+
+	// Uniform parameterization hits every waypoint on the matching output grid
+	out, _ := InterpolateCurve(points, 9, ParamUniform, Hermite4)
+	for k, want := range points {
+		for d := range want {
+			if math.Abs(out[2*k][d]-want[d]) > 1e-12 {
+				t.Errorf("InterpolateCurve()[%d] = %v, want %v", 2*k, out[2*k], want)
+				break
+			}
+		}
+	}
+}
+
+func TestInterpolateCurveMatchesPerCoordinate(t *testing.T) {
+	points := [][]float64{{0, 5}, {1, 3}, {4, 4}, {2, 8}, {0, 6}, {3, 1}}
+	out, _ := InterpolateCurve(points, 21, ParamUniform, Lanczos3)
+	for d := 0; d < 2; d++ {
+		column := make([]float64, len(points))
+		for i, p := range points {
+			column[i] = p[d]
+		}
+		want, _ := Interpolate(column, 21, Lanczos3)
+		for i := range want {
+			if math.Abs(out[i][d]-want[i]) > 1e-12 {
+				t.Errorf("InterpolateCurve()[%d][%d] = %v, want %v", i, d, out[i][d], want[i])
+			}
+		}
+	}
+}
+
+func TestInterpolateCurveErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		points [][]float64
+		param  Parameterization
+		n      int
+	}{
+		{"no points", nil, ParamUniform, 4},
+		{"ragged", [][]float64{{0, 0}, {1}}, ParamUniform, 4},
+		{"no output", [][]float64{{0}, {1}}, ParamUniform, 0},
+		{"coincident chordal", [][]float64{{0, 0}, {0, 0}, {1, 1}}, ParamChordal, 4},
+		{"unknown parameterization", [][]float64{{0}, {1}}, Parameterization(7), 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := InterpolateCurve(tt.points, tt.n, tt.param, Linear); err == nil {
+				t.Error("InterpolateCurve() should return an error")
+			}
+		})
+	}
+}
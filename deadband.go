@@ -0,0 +1,58 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ReconstructDeadband reconstructs a series recorded by a historian that stores a
+// value only when it moves more than deadband away from the last stored value. The
+// recorded points (times, values) are interpolated at the grid coordinates with the
+// given interpolator, and alongside each estimate the bounds the true value is
+// known to lie within are returned.
+//
+// Between two records the true value stayed within ±deadband of the earlier one,
+// so the estimate is clamped into that band; at a record the bounds collapse onto
+// the recorded value. After the last record the value is assumed to have stayed in
+// its band. Grid coordinates before the first record are NaN.
+func ReconstructDeadband(times, values []float64, deadband float64, grid []float64, interpolatorType InterpolatorType) (estimate, lower, upper []float64, err error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkXY(times, values); err != nil {
+		return nil, nil, nil, err
+	}
+	if len(times) == 0 {
+		return nil, nil, nil, fmt.Errorf("%w: got no records", ErrTooFewPoints)
+	}
+	if !(deadband >= 0) || math.IsInf(deadband, 0) {
+		return nil, nil, nil, fmt.Errorf("deadband must be finite and not negative, got %v", deadband)
+	}
+
+	f := newXYEvaluator(times, values, interpolatorType)
+	estimate = make([]float64, len(grid))
+	lower = make([]float64, len(grid))
+	upper = make([]float64, len(grid))
+	last := len(times) - 1
+	for i, q := range grid {
+		// Last record at or before q
+		j := sort.Search(len(times), func(k int) bool { return times[k] > q }) - 1
+		switch {
+		case j < 0 || math.IsNaN(q):
+			estimate[i], lower[i], upper[i] = math.NaN(), math.NaN(), math.NaN()
+			continue
+		case times[j] == q:
+			estimate[i], lower[i], upper[i] = values[j], values[j], values[j]
+			continue
+		}
+		lower[i] = values[j] - deadband
+		upper[i] = values[j] + deadband
+		if j == last {
+			estimate[i] = values[j]
+			continue
+		}
+		estimate[i] = math.Max(lower[i], math.Min(upper[i], f(q)))
+	}
+	return estimate, lower, upper, nil
+}
@@ -0,0 +1,65 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReconstructDeadband(t *testing.T) {
+	times := []float64{0, 10, 12}
+	values := []float64{0, 5, 0}
+	grid := []float64{-1, 0, 2, 9, 10, 11, 15}
+	est, lo, hi, err := ReconstructDeadband(times, values, 1, grid, Linear)
+	if err != nil {
+		t.Fatalf("ReconstructDeadband() returned unexpected error: %v", err)
+	}
+	nan := math.NaN()
+	wantEst := []float64{nan, 0, 1, 1, 5, 4, 0}
+	wantLo := []float64{nan, 0, -1, -1, 5, 4, -1}
+	wantHi := []float64{nan, 0, 1, 1, 5, 6, 1}
+	same := func(a, b float64) bool {
+		return math.IsNaN(a) == math.IsNaN(b) && (math.IsNaN(a) || math.Abs(a-b) < 1e-12)
+	}
+	for i := range grid {
+		if !same(est[i], wantEst[i]) || !same(lo[i], wantLo[i]) || !same(hi[i], wantHi[i]) {
+			t.Errorf("ReconstructDeadband() at %v = (%v, %v, %v), want (%v, %v, %v)",
+				grid[i], est[i], lo[i], hi[i], wantEst[i], wantLo[i], wantHi[i])
+		}
+	}
+}
+
+func TestReconstructDeadbandContainsTruth(t *testing.T) {
+	// Record a slow signal with a deadband and check the bounds hold the truth
+	const deadband = 0.1
+	truth := func(x float64) float64 { return math.Sin(x / 5) }
+	var times, values []float64
+	for x := 0.0; x <= 60; x += 0.25 {
+		if len(values) == 0 || math.Abs(truth(x)-values[len(values)-1]) > deadband {
+			times = append(times, x)
+			values = append(values, truth(x))
+		}
+	}
+	grid := make([]float64, 241)
+	for i := range grid {
+		grid[i] = float64(i) / 4
+	}
+	est, lo, hi, _ := ReconstructDeadband(times, values, deadband, grid, Linear)
+	for i, x := range grid {
+		v := truth(x)
+		if v < lo[i]-1e-12 || v > hi[i]+1e-12 {
+			t.Errorf("truth %v at %v outside bounds [%v, %v]", v, x, lo[i], hi[i])
+		}
+		if est[i] < lo[i] || est[i] > hi[i] {
+			t.Errorf("estimate %v at %v outside bounds [%v, %v]", est[i], x, lo[i], hi[i])
+		}
+	}
+}
+
+func TestReconstructDeadbandErrors(t *testing.T) {
+	if _, _, _, err := ReconstructDeadband(nil, nil, 1, []float64{0}, Linear); err == nil {
+		t.Error("ReconstructDeadband() with no records should return an error")
+	}
+	if _, _, _, err := ReconstructDeadband([]float64{0}, []float64{1}, -1, []float64{0}, Linear); err == nil {
+		t.Error("ReconstructDeadband() with a negative deadband should return an error")
+	}
+}
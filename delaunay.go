@@ -0,0 +1,184 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+)
+
+// triangleEpsilon is the barycentric tolerance for points on a triangle edge
+const triangleEpsilon = 1e-12
+
+// Triangulation is a Delaunay triangulation of scattered (x, y, z) samples that
+// interpolates linearly within each triangle. Linear interpolation on a Delaunay
+// triangulation is exact for planar data, never overshoots the samples and needs
+// no tuning, which makes it the robust first choice for scattered data.
+type Triangulation struct {
+	x, y, z   []float64
+	triangles [][3]int
+}
+
+// ghost is the vertex at infinity that closes the triangulation outside the
+// convex hull
+const ghost = -1
+
+// triangle is a triangle under construction with its circumcircle. A ghost
+// triangle has the ghost as its third vertex and stands for the half-plane
+// outside the hull edge v[0]→v[1], which lies on its left.
+type triangle struct {
+	v      [3]int
+	cx, cy float64
+	r2     float64
+}
+
+// NewTriangulation builds the Delaunay triangulation of the points (x, y) with the
+// Bowyer-Watson algorithm. Duplicate points are rejected, and at least three
+// points that are not all collinear are required.
+func NewTriangulation(x, y, z []float64) (*Triangulation, error) {
+	if err := checkScattered(x, y, z); err != nil {
+		return nil, err
+	}
+	n := len(x)
+	seen := make(map[[2]float64]bool, n)
+	for i := range x {
+		key := [2]float64{x[i], y[i]}
+		if seen[key] {
+			return nil, errors.New("duplicate points cannot be triangulated")
+		}
+		seen[key] = true
+	}
+
+	// Start from the first three points that are not collinear
+	third := -1
+	for i := 2; i < n; i++ {
+		if orient(x[0], y[0], x[1], y[1], x[i], y[i]) != 0 {
+			third = i
+			break
+		}
+	}
+	if third < 0 {
+		return nil, errors.New("points are collinear or too few to triangulate")
+	}
+
+	newTriangle := func(a, b, c int) triangle {
+		// Keep the ghost last, which preserves the orientation
+		switch {
+		case a == ghost:
+			return triangle{v: [3]int{b, c, ghost}}
+		case b == ghost:
+			return triangle{v: [3]int{c, a, ghost}}
+		case c == ghost:
+			return triangle{v: [3]int{a, b, ghost}}
+		}
+		cx, cy := circumcenter(x[a], y[a], x[b], y[b], x[c], y[c])
+		dx, dy := x[a]-cx, y[a]-cy
+		return triangle{v: [3]int{a, b, c}, cx: cx, cy: cy, r2: dx*dx + dy*dy}
+	}
+	// inConflict reports whether point p lies inside the circumcircle of t. The
+	// circumcircle of a ghost triangle is the open half-plane outside its hull edge
+	// together with the inside of the edge, which is the limit of the circles
+	// through the edge as the third vertex recedes to infinity.
+	inConflict := func(t triangle, p int) bool {
+		if t.v[2] == ghost {
+			a, b := t.v[0], t.v[1]
+			side := orient(x[a], y[a], x[b], y[b], x[p], y[p])
+			if side != 0 {
+				return side > 0
+			}
+			return (x[p]-x[a])*(x[p]-x[b])+(y[p]-y[a])*(y[p]-y[b]) < 0
+		}
+		dx, dy := x[p]-t.cx, y[p]-t.cy
+		return dx*dx+dy*dy <= t.r2*(1+triangleEpsilon)
+	}
+
+	a, b, c := 0, 1, third
+	if orient(x[a], y[a], x[b], y[b], x[c], y[c]) < 0 {
+		b, c = c, b
+	}
+	tris := []triangle{newTriangle(a, b, c), newTriangle(b, a, ghost), newTriangle(c, b, ghost), newTriangle(a, c, ghost)}
+	for p := 2; p < n; p++ {
+		if p == third {
+			continue
+		}
+		// Remove every triangle whose circumcircle contains the point and connect
+		// the point to the boundary of the resulting cavity
+		edges := map[[2]int]bool{}
+		kept := tris[:0]
+		for _, t := range tris {
+			if !inConflict(t, p) {
+				kept = append(kept, t)
+				continue
+			}
+			for e := 0; e < 3; e++ {
+				edges[[2]int{t.v[e], t.v[(e+1)%3]}] = true
+			}
+		}
+		tris = kept
+		for e := range edges {
+			if !edges[[2]int{e[1], e[0]}] {
+				tris = append(tris, newTriangle(e[0], e[1], p))
+			}
+		}
+	}
+
+	t := &Triangulation{x: x, y: y, z: z}
+	for _, tri := range tris {
+		if tri.v[2] == ghost {
+			continue
+		}
+		if orient(x[tri.v[0]], y[tri.v[0]], x[tri.v[1]], y[tri.v[1]], x[tri.v[2]], y[tri.v[2]]) == 0 {
+			continue
+		}
+		t.triangles = append(t.triangles, tri.v)
+	}
+	return t, nil
+}
+
+// Triangles returns the vertex indices of each triangle, counter-clockwise
+func (t *Triangulation) Triangles() [][3]int {
+	return append([][3]int(nil), t.triangles...)
+}
+
+// At interpolates linearly at (qx, qy), returning NaN outside the convex hull of
+// the points
+func (t *Triangulation) At(qx, qy float64) float64 {
+	for _, tri := range t.triangles {
+		a, b, c := tri[0], tri[1], tri[2]
+		det := (t.y[b]-t.y[c])*(t.x[a]-t.x[c]) + (t.x[c]-t.x[b])*(t.y[a]-t.y[c])
+		l1 := ((t.y[b]-t.y[c])*(qx-t.x[c]) + (t.x[c]-t.x[b])*(qy-t.y[c])) / det
+		l2 := ((t.y[c]-t.y[a])*(qx-t.x[c]) + (t.x[a]-t.x[c])*(qy-t.y[c])) / det
+		l3 := 1 - l1 - l2
+		if l1 >= -triangleEpsilon && l2 >= -triangleEpsilon && l3 >= -triangleEpsilon {
+			return l1*t.z[a] + l2*t.z[b] + l3*t.z[c]
+		}
+	}
+	return math.NaN()
+}
+
+// Grid evaluates the interpolant on the regular grid spanned by the axes gridX and
+// gridY, indexed [row][column] like IDW, with NaN outside the convex hull
+func (t *Triangulation) Grid(gridX, gridY []float64) [][]float64 {
+	out := newMatrix(len(gridY), len(gridX))
+	for r, gy := range gridY {
+		for c, gx := range gridX {
+			out[r][c] = t.At(gx, gy)
+		}
+	}
+	return out
+}
+
+// orient returns twice the signed area of triangle abc, positive when
+// counter-clockwise
+func orient(ax, ay, bx, by, cx, cy float64) float64 {
+	return (bx-ax)*(cy-ay) - (by-ay)*(cx-ax)
+}
+
+// circumcenter returns the center of the circle through a, b and c
+func circumcenter(ax, ay, bx, by, cx, cy float64) (x, y float64) {
+	d := 2 * (ax*(by-cy) + bx*(cy-ay) + cx*(ay-by))
+	a2 := ax*ax + ay*ay
+	b2 := bx*bx + by*by
+	c2 := cx*cx + cy*cy
+	x = (a2*(by-cy) + b2*(cy-ay) + c2*(ay-by)) / d
+	y = (a2*(cx-bx) + b2*(ax-cx) + c2*(bx-ax)) / d
+	return x, y
+}
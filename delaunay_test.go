@@ -0,0 +1,129 @@
+package interpolators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTriangulationReproducesPlane(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	plane := func(x, y float64) float64 { return 3 - 2*x + 0.5*y }
+
+	// Corners make the convex hull the unit square
+	x := []float64{0, 1, 0, 1}
+	y := []float64{0, 0, 1, 1}
+	for i := 0; i < 50; i++ {
+		x = append(x, rng.Float64())
+		y = append(y, rng.Float64())
+	}
+	z := make([]float64, len(x))
+	for i := range z {
+		z[i] = plane(x[i], y[i])
+	}
+
+	tri, err := NewTriangulation(x, y, z)
+	if err != nil {
+		t.Fatalf("NewTriangulation() returned unexpected error: %v", err)
+	}
+	// Euler: a triangulation of n points with h on the hull has 2n-2-h triangles
+	if got, want := len(tri.Triangles()), 2*len(x)-2-4; got != want {
+		t.Errorf("len(Triangles()) = %d, want %d", got, want)
+	}
+
+	grid := linspace(0, 1, 11)
+	out := tri.Grid(grid, grid)
+	for r, gy := range grid {
+		for c, gx := range grid {
+			if want := plane(gx, gy); math.Abs(out[r][c]-want) > 1e-9 {
+				t.Errorf("Grid()[%d][%d] = %v, want %v", r, c, out[r][c], want)
+			}
+		}
+	}
+	if v := tri.At(1.5, 0.5); !math.IsNaN(v) {
+		t.Errorf("At() outside the hull = %v, want NaN", v)
+	}
+}
+
+func TestTriangulationRegularGrid(t *testing.T) {
+	// Cocircular points stress the circumcircle test
+	var x, y, z []float64
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			x = append(x, float64(i))
+			y = append(y, float64(j))
+			z = append(z, float64(i*j))
+		}
+	}
+	tri, err := NewTriangulation(x, y, z)
+	if err != nil {
+		t.Fatalf("NewTriangulation() returned unexpected error: %v", err)
+	}
+	area := 0.0
+	for _, v := range tri.Triangles() {
+		area += orient(x[v[0]], y[v[0]], x[v[1]], y[v[1]], x[v[2]], y[v[2]]) / 2
+	}
+	if math.Abs(area-16) > 1e-9 {
+		t.Errorf("triangulated area = %v, want 16", area)
+	}
+	for i := range x {
+		if got := tri.At(x[i], y[i]); got != z[i] {
+			t.Errorf("At(%v, %v) = %v, want %v", x[i], y[i], got, z[i])
+		}
+	}
+}
+
+func TestTriangulationThinHull(t *testing.T) {
+	// A thin strip has nearly collinear hull points whose circumcircles are huge,
+	// so every point inside the hull must still fall in some triangle
+	rng := rand.New(rand.NewSource(2))
+	plane := func(x, y float64) float64 { return 1 + 4*x - 30*y }
+	var x, y, z []float64
+	for i := 0; i < 200; i++ {
+		x = append(x, rng.Float64())
+		y = append(y, 0.01*rng.Float64())
+		z = append(z, plane(x[i], y[i]))
+	}
+	tri, err := NewTriangulation(x, y, z)
+	if err != nil {
+		t.Fatalf("NewTriangulation() returned unexpected error: %v", err)
+	}
+	check := func(qx, qy float64) {
+		t.Helper()
+		if got, want := tri.At(qx, qy), plane(qx, qy); !(math.Abs(got-want) <= 1e-9) {
+			t.Errorf("At(%v, %v) = %v, want %v", qx, qy, got, want)
+		}
+	}
+	for _, v := range tri.Triangles() {
+		check((x[v[0]]+x[v[1]]+x[v[2]])/3, (y[v[0]]+y[v[1]]+y[v[2]])/3)
+	}
+	// Convex combinations of sample points lie inside the hull
+	for i := 0; i < 2000; i++ {
+		a, b, c := rng.Intn(len(x)), rng.Intn(len(x)), rng.Intn(len(x))
+		wa, wb := rng.Float64(), rng.Float64()
+		if wa+wb > 1 {
+			wa, wb = 1-wa, 1-wb
+		}
+		wc := 1 - wa - wb
+		check(wa*x[a]+wb*x[b]+wc*x[c], wa*y[a]+wb*y[b]+wc*y[c])
+	}
+}
+
+func TestNewTriangulationInvalidInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		x, y, z []float64
+	}{
+		{"empty", nil, nil, nil},
+		{"too few", []float64{0, 1}, []float64{0, 1}, []float64{0, 1}},
+		{"collinear", []float64{0, 1, 2}, []float64{0, 1, 2}, []float64{0, 1, 2}},
+		{"duplicate", []float64{0, 1, 0, 0}, []float64{0, 0, 1, 0}, []float64{0, 1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTriangulation(tt.x, tt.y, tt.z); err == nil {
+				t.Error("NewTriangulation() should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// polyPieceDegree is the highest polynomial degree among the piecewise-polynomial
+// kernels, so fitting that many plus one points recovers each piece exactly
+const polyPieceDegree = 5
+
+// Derivative returns the analytic first derivative of the interpolant at the
+// output positions of Interpolate, in units per input sample. Where the
+// interpolant is discontinuous (DropSample, Bezier) the derivative of the piece
+// containing the position is returned; the non-interpolating types and None are
+// treated like DropSample and have zero derivative.
+func Derivative(in []float64, outSamples int, interpolatorType InterpolatorType) ([]float64, error) {
+	return derivative(in, outSamples, interpolatorType, 1)
+}
+
+// DerivativeAt returns the analytic first derivative of the interpolant at
+// arbitrary fractional positions, the derivative counterpart of InterpolateAt
+func DerivativeAt(in []float64, positions []float64, interpolatorType InterpolatorType) ([]float64, error) {
+	return derivativeAt(in, positions, interpolatorType, 1)
+}
+
+// derivative evaluates the order-th derivative on the output grid of Interpolate
+func derivative(in []float64, outSamples int, interpolatorType InterpolatorType, order int) ([]float64, error) {
+	if interpolatorType == None {
+		interpolatorType = DropSample
+	}
+	if err := validate(in, outSamples, interpolatorType); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	positions := make([]float64, outSamples)
+	for i := range positions {
+		positions[i] = outputPosition(i, len(in), outSamples)
+	}
+	return derivativeAt(in, positions, interpolatorType, order)
+}
+
+// derivativeAt evaluates the order-th derivative at arbitrary positions
+func derivativeAt(in []float64, positions []float64, interpolatorType InterpolatorType, order int) ([]float64, error) {
+	f, err := newDerivativeEvaluator(in, interpolatorType, order)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(positions))
+	for i, pos := range positions {
+		out[i] = f(pos)
+	}
+	return out, nil
+}
+
+// newDerivativeEvaluator returns a function evaluating the order-th derivative (1
+// or 2) of the interpolant that newEvaluator evaluates
+func newDerivativeEvaluator(in []float64, interpolatorType InterpolatorType, order int) (func(pos float64) float64, error) {
+	zero := func(float64) float64 { return 0 }
+	n := len(in)
+	if n < 2 {
+		return zero, nil
+	}
+
+	if k, ok := kernelFor(interpolatorType); ok {
+		if interpolatorType == DropSample {
+			return zero, nil
+		}
+		if k.normalize {
+			return normalizedDerivative(in, interpolatorType, k, order)
+		}
+		d, err := kernelDerivative(interpolatorType, k, order)
+		if err != nil {
+			return nil, err
+		}
+		k.impulse = d
+		return func(pos float64) float64 { return k.eval(in, pos) }, nil
+	}
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+
+	switch interpolatorType {
+	case CubicSpline:
+		_, b, c, d := cubicSplineCoefficients(x, in)
+		return func(pos float64) float64 {
+			j := segmentIndex(pos, n)
+			dx := pos - float64(j)
+			if order == 1 {
+				return b[j] + 2*c[j]*dx + 3*d[j]*dx*dx
+			}
+			return 2*c[j] + 6*d[j]*dx
+		}, nil
+	case MonotonicCubic:
+		m := monotonicCubicSlopes(x, in)
+		return func(pos float64) float64 { return hermiteSegmentDerivative(in, m, pos, order) }, nil
+	case Akima:
+		m := akimaSlopes(x, in)
+		return func(pos float64) float64 { return hermiteSegmentDerivative(in, m, pos, order) }, nil
+	case Hyman:
+		m := hymanSlopes(x, in)
+		return func(pos float64) float64 { return hermiteSegmentDerivative(in, m, pos, order) }, nil
+	case OMOMS:
+		c := omomsCoefficients(in)
+		k := omomsKernel
+		d, err := polyDerivative(k.impulse, k.radius, order)
+		if err != nil {
+			return nil, err
+		}
+		k.impulse = d
+		return func(pos float64) float64 { return k.eval(c, pos) }, nil
+	case Sinc:
+		d := func(x float64) float64 {
+			_, d1, d2 := sincDerivatives(x)
+			if order == 1 {
+				return d1
+			}
+			return d2
+		}
+		return func(pos float64) float64 { return sincSum(in, pos, d) }, nil
+	case Barycentric:
+		b := newBarycentric(in)
+		return func(pos float64) float64 { return b.derivative(pos, order) }, nil
+	case FloaterHormann:
+		b := newFloaterHormann(x, in, 0)
+		return func(pos float64) float64 { return b.derivative(pos, order) }, nil
+	case TensionSpline:
+		s := newTensionSpline(x, in, 0)
+		return func(pos float64) float64 { return s.derivative(pos, order) }, nil
+	case Schumaker:
+		s := newSchumaker(x, in)
+		return func(pos float64) float64 { return s.derivative(pos, order) }, nil
+	}
+
+	// Everything else is evaluated like DropSample, which is piecewise constant
+	return zero, nil
+}
+
+// hermiteSegmentDerivative is the order-th derivative (1 or 2) of hermiteSegment
+func hermiteSegmentDerivative(in, m []float64, pos float64, order int) float64 {
+	j := segmentIndex(pos, len(in))
+	t := pos - float64(j)
+
+	var h00, h10, h01, h11 float64
+	if order == 1 {
+		t2 := t * t
+		h00 = 6*t2 - 6*t
+		h10 = 3*t2 - 4*t + 1
+		h01 = -6*t2 + 6*t
+		h11 = 3*t2 - 2*t
+	} else {
+		h00 = 12*t - 6
+		h10 = 6*t - 4
+		h01 = -12*t + 6
+		h11 = 6*t - 2
+	}
+	return h00*in[j] + h10*m[j] + h01*in[j+1] + h11*m[j+1]
+}
+
+// kernelDerivative returns the order-th derivative of the kernel's impulse
+// response. Lanczos kernels and kernels carrying their own derivative are
+// differentiated analytically; the others are polynomials between integer
+// positions, so each piece is recovered exactly from a few samples and
+// differentiated term by term.
+func kernelDerivative(interpolatorType InterpolatorType, k kernel, order int) (func(float64) float64, error) {
+	if k.derivative != nil {
+		return func(x float64) float64 { return k.derivative(x, order) }, nil
+	}
+	switch interpolatorType {
+	case Lanczos2, Lanczos3:
+		return lanczosDerivative(float64(k.radius), order), nil
+	}
+	return polyDerivative(k.impulse, k.radius, order)
+}
+
+// polyDerivative differentiates an impulse response that is a polynomial of
+// degree at most polyPieceDegree on each interval [j, j+1) of its support ±radius
+func polyDerivative(impulse func(float64) float64, radius, order int) (func(float64) float64, error) {
+	pieces, err := polyPieces(impulse, radius, polyPieceDegree)
+	if err != nil {
+		return nil, err
+	}
+	for _, coeffs := range pieces {
+		// Differentiate term by term
+		for d := 0; d < order; d++ {
+			for e := 0; e < len(coeffs)-1; e++ {
+				coeffs[e] = coeffs[e+1] * float64(e+1)
+			}
+			coeffs[len(coeffs)-1] = 0
+		}
+	}
+
+	return func(x float64) float64 {
+		p := int(math.Floor(x)) + radius
+		if p < 0 || p >= len(pieces) {
+			return 0
+		}
+		return hornerEval(pieces[p], x-math.Floor(x))
+	}, nil
+}
+
+// lanczosDerivative returns the order-th derivative (1 or 2) of the Lanczos kernel
+// with support ±a
+func lanczosDerivative(a float64, order int) func(float64) float64 {
+	return func(x float64) float64 {
+		if math.Abs(x) >= a {
+			return 0
+		}
+		s0, s1, s2 := sincDerivatives(x)
+		u0, u1, u2 := sincDerivatives(x / a)
+		if order == 1 {
+			return s1*u0 + s0*u1/a
+		}
+		return s2*u0 + 2*s1*u1/a + s0*u2/(a*a)
+	}
+}
+
+// sincDerivatives returns sinc(u) = sin(πu)/(πu) and its first two derivatives
+func sincDerivatives(u float64) (s, d1, d2 float64) {
+	if math.Abs(u) < 1e-4 {
+		// Taylor series about zero: 1 - (πu)²/6 + (πu)⁴/120
+		p2 := math.Pi * math.Pi
+		u2 := u * u
+		s = 1 - p2*u2/6 + p2*p2*u2*u2/120
+		d1 = -p2*u/3 + p2*p2*u2*u/30
+		d2 = -p2/3 + p2*p2*u2/10
+		return s, d1, d2
+	}
+	pu := math.Pi * u
+	sin, cos := math.Sin(pu), math.Cos(pu)
+	s = sin / pu
+	d1 = cos/u - sin/(pu*u)
+	d2 = -math.Pi*sin/u - 2*cos/(u*u) + 2*sin/(pu*u*u)
+	return s, d1, d2
+}
+
+// normalizedDerivative differentiates the interpolant S/W of a kernel that divides
+// the weighted sum S by the sum of the weights W, by the quotient rule
+func normalizedDerivative(in []float64, interpolatorType InterpolatorType, k kernel, order int) (func(pos float64) float64, error) {
+	d1, err := kernelDerivative(interpolatorType, k, 1)
+	if err != nil {
+		return nil, err
+	}
+	d2, err := kernelDerivative(interpolatorType, k, 2)
+	if err != nil {
+		return nil, err
+	}
+	return func(pos float64) float64 {
+		var s, s1, s2, w, w1, w2 float64
+		base := k.windowBase(pos)
+		for j := base - k.radius + 1; j <= base+k.radius; j++ {
+			idx, ok := k.boundary.index(j, len(in))
+			if !ok {
+				continue
+			}
+			x := pos - float64(j)
+			v, v1, v2 := k.impulse(x), d1(x), d2(x)
+			s, s1, s2 = s+in[idx]*v, s1+in[idx]*v1, s2+in[idx]*v2
+			w, w1, w2 = w+v, w1+v1, w2+v2
+		}
+		if w == 0 {
+			return 0
+		}
+		f := s / w
+		f1 := (s1 - f*w1) / w
+		if order == 1 {
+			return f1
+		}
+		return (s2 - 2*f1*w1 - f*w2) / w
+	}, nil
+}
+
+// polyPieces recovers an impulse response that is a polynomial of the given
+// degree on each interval [j, j+1) of its support ±radius. pieces[p] holds the
+// coefficients c0 ... c_degree in the local coordinate t = x - (p - radius). It
+// fails if the impulse response is not finite at a fitting node.
+func polyPieces(impulse func(float64) float64, radius, degree int) ([][]float64, error) {
+	nodes := degree + 1
+	pieces := make([][]float64, 2*radius)
+	for p := range pieces {
+		left := float64(p - radius)
+		// Fit the nodes strictly inside the interval to stay clear of jumps at its ends
+		v := newMatrix(nodes, nodes)
+		rhs := make([]float64, nodes)
+		for i := range v {
+			t := (float64(i) + 0.5) / float64(nodes)
+			pow := 1.0
+			for e := range v[i] {
+				v[i][e] = pow
+				pow *= t
+			}
+			rhs[i] = impulse(left + t)
+			if math.IsNaN(rhs[i]) || math.IsInf(rhs[i], 0) {
+				return nil, fmt.Errorf("kernel impulse response is %v at %v", rhs[i], left+t)
+			}
+		}
+		coeffs, err := solveLinear(v, rhs)
+		if err != nil {
+			return nil, fmt.Errorf("fitting kernel piece %d: %w", p, err)
+		}
+		pieces[p] = coeffs
+	}
+	return pieces, nil
+}
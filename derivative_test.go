@@ -0,0 +1,116 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+// mustKernelDerivative returns kernelDerivative, failing the test on error
+func mustKernelDerivative(t *testing.T, interpolatorType InterpolatorType, k kernel, order int) func(float64) float64 {
+	t.Helper()
+	d, err := kernelDerivative(interpolatorType, k, order)
+	if err != nil {
+		t.Fatalf("kernelDerivative(%v) returned unexpected error: %v", interpolatorType, err)
+	}
+	return d
+}
+
+func TestDerivativeAtMatchesFiniteDifference(t *testing.T) {
+	in := []float64{0.5, 2, -1, 3, 4, 1, -2, 0, 1.5, 2.5}
+	// Positions avoid the integer and half-integer knots
+	positions := []float64{0.3, 1.7, 3.2, 4.45, 6.6, 8.1}
+	const h = 1e-6
+	for typ := DropSample; typ <= Akima; typ++ {
+		got, err := DerivativeAt(in, positions, typ)
+		if err != nil {
+			t.Fatalf("DerivativeAt(%v) returned unexpected error: %v", typ, err)
+		}
+		for i, pos := range positions {
+			f, _ := InterpolateAt(in, []float64{pos - h, pos + h}, typ)
+			want := (f[1] - f[0]) / (2 * h)
+			if math.Abs(got[i]-want) > 1e-5*math.Max(1, math.Abs(want)) {
+				t.Errorf("DerivativeAt(%v) at %v = %v, want %v", typ, pos, got[i], want)
+			}
+		}
+	}
+}
+
+func TestDerivativeOfRamp(t *testing.T) {
+	in := []float64{0, 2, 4, 6, 8, 10, 12, 14}
+	for _, typ := range []InterpolatorType{Linear, Lagrange4, Lagrange6, Hermite4, Hermite6_5, Osculating4, CubicSpline, MonotonicCubic, Akima} {
+		out, err := Derivative(in, 29, typ)
+		if err != nil {
+			t.Fatalf("Derivative(%v) returned unexpected error: %v", typ, err)
+		}
+		// Interior positions where every tap is a real sample
+		for i := 12; i <= 16; i++ {
+			if math.Abs(out[i]-2) > 1e-9 {
+				t.Errorf("Derivative(%v)[%d] = %v, want 2", typ, i, out[i])
+			}
+		}
+	}
+}
+
+func TestDerivativeOfSine(t *testing.T) {
+	n := 64
+	in := make([]float64, n)
+	for i := range in {
+		in[i] = math.Sin(float64(i) / 4)
+	}
+	positions := []float64{20.25, 30.5, 40.75}
+	for _, typ := range []InterpolatorType{Lagrange6, CubicSpline, Hermite6_5} {
+		got, _ := DerivativeAt(in, positions, typ)
+		for i, pos := range positions {
+			// d/dx sin(x/4) = cos(x/4)/4
+			if want := math.Cos(pos/4) / 4; math.Abs(got[i]-want) > 0.01 {
+				t.Errorf("DerivativeAt(%v) at %v = %v, want %v", typ, pos, got[i], want)
+			}
+		}
+	}
+}
+
+func TestDerivativeEdgeCases(t *testing.T) {
+	out, err := Derivative([]float64{1, 5, 2}, 5, DropSample)
+	if err != nil {
+		t.Fatalf("Derivative() returned unexpected error: %v", err)
+	}
+	for i, v := range out {
+		if v != 0 {
+			t.Errorf("Derivative(DropSample)[%d] = %v, want 0", i, v)
+		}
+	}
+	if out, _ := Derivative([]float64{}, 4, Linear); len(out) != 0 {
+		t.Errorf("Derivative() of empty input length = %d, want 0", len(out))
+	}
+	if _, err := Derivative([]float64{1, 2, 3}, 0, Linear); err == nil {
+		t.Error("Derivative() with zero output samples should return an error")
+	}
+}
+
+func TestSincDerivatives(t *testing.T) {
+	const h = 1e-5
+	sinc := func(u float64) float64 {
+		s, _, _ := sincDerivatives(u)
+		return s
+	}
+	for _, u := range []float64{0, 5e-5, 0.3, -1.2, 2.7} {
+		_, d1, d2 := sincDerivatives(u)
+		fd1 := (sinc(u+h) - sinc(u-h)) / (2 * h)
+		fd2 := (sinc(u+h) - 2*sinc(u) + sinc(u-h)) / (h * h)
+		if math.Abs(d1-fd1) > 1e-6 || math.Abs(d2-fd2) > 1e-3 {
+			t.Errorf("sincDerivatives(%v) = %v, %v, want %v, %v", u, d1, d2, fd1, fd2)
+		}
+	}
+}
+
+func TestDerivativeNonFiniteKernel(t *testing.T) {
+	// A kernel that is not finite cannot be fitted piecewise; the error is returned
+	// rather than panicking
+	k := kernel{impulse: func(x float64) float64 { return math.NaN() }, radius: 2}
+	if _, err := kernelDerivative(Hermite4, k, 1); err == nil {
+		t.Error("kernelDerivative() of a NaN impulse response should return an error")
+	}
+	if _, err := polyPieces(func(x float64) float64 { return math.Inf(1) }, 1, 3); err == nil {
+		t.Error("polyPieces() of an infinite impulse response should return an error")
+	}
+}
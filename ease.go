@@ -0,0 +1,44 @@
+package interpolators
+
+import "math"
+
+// cosineImpulse is the half-cosine ease (1+cos(πx))/2 between neighbours, which
+// blends two samples with zero slope at each of them
+func cosineImpulse(x float64) float64 {
+	absX := math.Abs(x)
+	if absX >= 1 {
+		return 0
+	}
+	return (1 + math.Cos(math.Pi*absX)) / 2
+}
+
+// cosineDerivative is the order-th derivative (1 or 2) of cosineImpulse
+func cosineDerivative(x float64, order int) float64 {
+	if math.Abs(x) >= 1 {
+		return 0
+	}
+	if order == 1 {
+		return -math.Pi / 2 * math.Sin(math.Pi*x)
+	}
+	return -math.Pi * math.Pi / 2 * math.Cos(math.Pi*x)
+}
+
+// smoothstepImpulse weights the neighbours with the smoothstep 3t²-2t³, a cubic
+// that, like the cosine ease, has zero slope at each sample
+func smoothstepImpulse(x float64) float64 {
+	absX := math.Abs(x)
+	if absX >= 1 {
+		return 0
+	}
+	return 1 - absX*absX*(3-2*absX)
+}
+
+// smootherstepImpulse weights the neighbours with Perlin's smootherstep
+// 6t⁵-15t⁴+10t³, which also has zero curvature at each sample
+func smootherstepImpulse(x float64) float64 {
+	absX := math.Abs(x)
+	if absX >= 1 {
+		return 0
+	}
+	return 1 - absX*absX*absX*(absX*(6*absX-15)+10)
+}
@@ -0,0 +1,82 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCosine(t *testing.T) {
+	in := []float64{0, 4, 2}
+	out, err := Interpolate(in, 9, Cosine)
+	if err != nil {
+		t.Fatalf("Interpolate(Cosine) returned unexpected error: %v", err)
+	}
+	for i, v := range out {
+		pos := outputPosition(i, len(in), len(out))
+		j := min(int(pos), len(in)-2)
+		f := pos - float64(j)
+		want := in[j] + (in[j+1]-in[j])*(1-math.Cos(math.Pi*f))/2
+		if math.Abs(v-want) > 1e-12 {
+			t.Errorf("Interpolate(Cosine)[%d] = %v, want %v", i, v, want)
+		}
+	}
+	// The slope vanishes at every sample
+	slopes, _ := DerivativeAt(in, []float64{0, 1, 2}, Cosine)
+	for i, s := range slopes {
+		if math.Abs(s) > 1e-12 {
+			t.Errorf("DerivativeAt(Cosine) at sample %d = %v, want 0", i, s)
+		}
+	}
+	k, _ := kernelFor(Cosine)
+	for _, order := range []int{1, 2} {
+		d := mustKernelDerivative(t, Cosine, k, order)
+		f := k.impulse
+		if order == 2 {
+			f = mustKernelDerivative(t, Cosine, k, 1)
+		}
+		for _, x := range []float64{-0.7, -0.2, 0.4, 0.9} {
+			h := 1e-5
+			if want := (f(x+h) - f(x-h)) / (2 * h); math.Abs(d(x)-want) > 1e-6 {
+				t.Errorf("Cosine derivative %d at %v = %v, want %v", order, x, d(x), want)
+			}
+		}
+	}
+}
+
+func TestSmoothstep(t *testing.T) {
+	tests := []struct {
+		interp InterpolatorType
+		ease   func(t float64) float64
+	}{
+		{Smoothstep, func(t float64) float64 { return t * t * (3 - 2*t) }},
+		{Smootherstep, func(t float64) float64 { return t * t * t * (t*(6*t-15) + 10) }},
+	}
+	in := []float64{1, 5, -3, 2}
+	for _, tt := range tests {
+		out, err := Interpolate(in, 13, tt.interp)
+		if err != nil {
+			t.Fatalf("Interpolate(%v) returned unexpected error: %v", tt.interp, err)
+		}
+		for i, v := range out {
+			pos := outputPosition(i, len(in), len(out))
+			j := min(int(pos), len(in)-2)
+			want := in[j] + (in[j+1]-in[j])*tt.ease(pos-float64(j))
+			if math.Abs(v-want) > 1e-12 {
+				t.Errorf("Interpolate(%v)[%d] = %v, want %v", tt.interp, i, v, want)
+			}
+		}
+		slopes, _ := DerivativeAt(in, []float64{0, 1, 2, 3}, tt.interp)
+		for i, s := range slopes {
+			if math.Abs(s) > 1e-9 {
+				t.Errorf("DerivativeAt(%v) at sample %d = %v, want 0", tt.interp, i, s)
+			}
+		}
+	}
+	// Only smootherstep also has zero curvature at the samples
+	curvature, _ := SecondDerivativeAt(in, []float64{1, 2}, Smootherstep)
+	for i, c := range curvature {
+		if math.Abs(c) > 1e-9 {
+			t.Errorf("SecondDerivativeAt(Smootherstep) at sample %d = %v, want 0", i+1, c)
+		}
+	}
+}
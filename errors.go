@@ -0,0 +1,114 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrInvalidOutSamples is returned when the requested output length is not positive
+	ErrInvalidOutSamples = errors.New("output sample count must be positive")
+	// ErrTooFewPoints is returned when the input is so short that the interpolator's
+	// taps would reach past both ends of it at every position
+	ErrTooFewPoints = errors.New("too few input samples for the interpolator")
+	// ErrUnknownInterpolator is returned when an interpolator name or value is not recognized
+	ErrUnknownInterpolator = errors.New("unknown interpolator type")
+	// ErrUnknownRevision is returned when Options.Revision names no known revision
+	ErrUnknownRevision = errors.New("unknown algorithm revision")
+)
+
+// minPoints returns the number of input samples the interpolator needs to produce
+// meaningful output. A single sample is always accepted as a constant signal.
+func minPoints(interpolatorType InterpolatorType) int {
+	if k, ok := kernelFor(interpolatorType); ok {
+		return k.radius + 1
+	}
+	switch interpolatorType {
+	case CubicSpline, MonotonicCubic, Akima, OMOMS, TensionSpline, Hyman, Schumaker:
+		return 2
+	}
+	return 1
+}
+
+// minPoints returns the number of input samples the interpolator needs with these
+// kernel parameters, which set the radius of the parameterized kernels
+func (p KernelParams) minPoints(interpolatorType InterpolatorType) int {
+	if k, ok := p.kernel(interpolatorType); ok {
+		return k.radius + 1
+	}
+	return minPoints(interpolatorType)
+}
+
+// validate checks the arguments of Interpolate. Empty input is valid and yields
+// empty output for any outSamples that is not negative, and None passes its input
+// through unchecked.
+func validate(in []float64, outSamples int, interpolatorType InterpolatorType) error {
+	return validateLength(len(in), outSamples, interpolatorType)
+}
+
+// validateLength checks the arguments of Interpolate for an input of n samples
+func validateLength(n, outSamples int, interpolatorType InterpolatorType) error {
+	return validateParams(n, outSamples, interpolatorType, KernelParams{})
+}
+
+// validateParams checks the arguments of InterpolateWithOptions for an input of n
+// samples, measuring the parameterized kernels at the radius params gives them
+func validateParams(n, outSamples int, interpolatorType InterpolatorType, params KernelParams) error {
+	if interpolatorType == None {
+		return nil
+	}
+	if err := validateOutSamples(n, outSamples); err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	if need := params.minPoints(interpolatorType); n > 1 && n < need {
+		return fmt.Errorf("%w: %v needs at least %d samples, got %d", ErrTooFewPoints, interpolatorType, need, n)
+	}
+	return nil
+}
+
+// validateOutSamples checks the output length requested from n input samples.
+// Empty input may ask for no output, but never for a negative amount.
+func validateOutSamples(n, outSamples int) error {
+	if outSamples < 0 || outSamples == 0 && n > 0 {
+		return fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	return nil
+}
+
+// checkRates returns an error unless both sample rates are positive and finite.
+// The comparisons are negated so that NaN fails them.
+func checkRates(srIn, srOut float64) error {
+	if !(srIn > 0) || !(srOut > 0) || math.IsInf(srIn, 0) || math.IsInf(srOut, 0) {
+		return fmt.Errorf("sample rates must be positive and finite, got %v and %v", srIn, srOut)
+	}
+	return nil
+}
+
+// maxSteppedSamples bounds the number of positions steppedLength allows, far more
+// than any real signal needs but small enough that allocating them cannot overflow
+const maxSteppedSamples = 1 << 31
+
+// steppedLength returns the number of positions k*step, k = 0, 1, ..., that do
+// not pass last. The count is computed in floating point and rejected when it
+// exceeds maxSteppedSamples, so extreme ratios fail instead of overflowing make or
+// looping for ever.
+func steppedLength(last, step float64) (int, error) {
+	n := math.Floor(last/step) + 1
+	if !(n <= maxSteppedSamples) {
+		return 0, fmt.Errorf("%w: a step of %v over %v samples needs %v outputs, more than %d", ErrInvalidOutSamples, step, last+1, n, maxSteppedSamples)
+	}
+	return int(n), nil
+}
+
+// constant returns outSamples copies of v
+func constant(v float64, outSamples int) []float64 {
+	out := make([]float64, outSamples)
+	for i := range out {
+		out[i] = v
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package interpolators
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInterpolateValidation(t *testing.T) {
+	type testCase struct {
+		name             string
+		input            []float64
+		outSamples       int
+		interpolatorType InterpolatorType
+		want             error
+	}
+	tests := []testCase{
+		{"zero out samples", []float64{1, 2, 3}, 0, Linear, ErrInvalidOutSamples},
+		{"negative out samples", []float64{1, 2, 3}, -4, CubicSpline, ErrInvalidOutSamples},
+		{"6-point kernel with 2 samples", []float64{1, 2}, 5, Lagrange6, ErrTooFewPoints},
+		{"4-point kernel with 2 samples", []float64{1, 2}, 5, Hermite4, ErrTooFewPoints},
+		{"4-point kernel with 3 samples", []float64{1, 2, 3}, 5, Hermite4, nil},
+		{"linear with 2 samples", []float64{1, 2}, 5, Linear, nil},
+		{"single sample", []float64{1}, 5, Lanczos3, nil},
+		{"empty input", []float64{}, 0, Lanczos3, nil},
+		{"none ignores out samples", []float64{1, 2}, 0, None, nil},
+	}
+	for _, info := range All()[1:] {
+		tests = append(tests,
+			testCase{info.Name + " empty input, negative out samples", nil, -1, info.Type, ErrInvalidOutSamples},
+			testCase{info.Name + " empty input, zero out samples", []float64{}, 0, info.Type, nil},
+		)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Interpolate(tt.input, tt.outSamples, tt.interpolatorType)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Interpolate() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateSingleSampleIsConstant(t *testing.T) {
+	for _, info := range All()[1:] {
+		typ := info.Type
+		out, err := Interpolate([]float64{2.5}, 4, typ)
+		if err != nil {
+			t.Fatalf("Interpolate(%d) returned unexpected error: %v", typ, err)
+		}
+		for i, v := range out {
+			if v != 2.5 {
+				t.Errorf("Interpolate(%d)[%d] = %v, want 2.5", typ, i, v)
+			}
+		}
+	}
+}
+
+func TestWrappersRejectNegativeOutSamples(t *testing.T) {
+	for _, info := range All()[1:] {
+		typ := info.Type
+		wrappers := map[string]func() error{
+			"InterpolateWithOptions": func() error {
+				_, err := InterpolateWithOptions(nil, -1, typ, Options{Normalize: true})
+				return err
+			},
+			"InterpolateChannels": func() error {
+				_, err := InterpolateChannels([][]float64{nil, nil}, -1, typ)
+				return err
+			},
+			"InterpolateAngles": func() error {
+				_, err := InterpolateAngles(nil, -1, Degrees, typ)
+				return err
+			},
+			"InterpolateWithMarkers": func() error {
+				_, _, err := InterpolateWithMarkers(nil, -1, nil, typ)
+				return err
+			},
+			"InterpolateQuantiles": func() error {
+				_, err := InterpolateQuantiles([][]float64{nil, nil}, -1, typ)
+				return err
+			},
+			"InterpolateWithFallback": func() error {
+				_, _, err := InterpolateWithFallback(nil, -1, []InterpolatorType{typ})
+				return err
+			},
+			"InterpolateWithReport": func() error {
+				_, _, err := InterpolateWithReport(nil, -1, typ, Options{})
+				return err
+			},
+		}
+		if linearInData(typ) {
+			wrappers["InterpolateComplex"] = func() error {
+				_, err := InterpolateComplex(nil, -1, typ)
+				return err
+			}
+		}
+		for name, f := range wrappers {
+			if err := f(); !errors.Is(err, ErrInvalidOutSamples) {
+				t.Errorf("%s(nil, -1, %v) error = %v, want %v", name, typ, err, ErrInvalidOutSamples)
+			}
+		}
+	}
+}
+
+func TestInterpolateWithOptionsValidation(t *testing.T) {
+	_, err := InterpolateWithOptions([]float64{1, 2}, 5, Lanczos3, Options{Boundary: BoundaryMirror})
+	if !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateWithOptions() error = %v, want %v", err, ErrTooFewPoints)
+	}
+	_, err = InterpolateWithOptions([]float64{1, 2, 3, 4}, 0, Lanczos3, Options{Normalize: true})
+	if !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateWithOptions() error = %v, want %v", err, ErrInvalidOutSamples)
+	}
+	// The length needed follows the kernel radius the parameters select
+	five := []float64{1, 2, 3, 4, 5}
+	for _, typ := range []InterpolatorType{LanczosN, KaiserSinc, HannSinc, HammingSinc, BlackmanSinc, LagrangeN} {
+		if _, err := InterpolateWithOptions(five, 9, typ, Options{Kernel: KernelParams{Radius: 2}}); err != nil {
+			t.Errorf("InterpolateWithOptions(%v, radius 2) on 5 samples returned unexpected error: %v", typ, err)
+		}
+		_, err := InterpolateWithOptions(five, 9, typ, Options{Kernel: KernelParams{Radius: 5}})
+		if !errors.Is(err, ErrTooFewPoints) {
+			t.Errorf("InterpolateWithOptions(%v, radius 5) on 5 samples error = %v, want %v", typ, err, ErrTooFewPoints)
+		}
+		_, err = InterpolateWithOptions(five, 9, typ, Options{Kernel: KernelParams{Radius: 1 << 40}})
+		if err == nil {
+			t.Errorf("InterpolateWithOptions(%v, radius 1<<40) should return an error", typ)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// InterpolateAt evaluates the interpolant of in at arbitrary fractional positions,
+// where position i corresponds to in[i] as in Interpolate. Positions outside
+// [0, len(in)-1] are extrapolated using each interpolator's edge handling.
+// AreaAverage and LTTB only reduce whole signals and have no interpolant to
+// evaluate, so they are rejected with ErrUnknownInterpolator like unknown types.
+func InterpolateAt(in []float64, positions []float64, interpolatorType InterpolatorType) (out []float64, err error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return nil, fmt.Errorf("%w: got no samples", ErrTooFewPoints)
+	}
+	if err := validate(in, 1, interpolatorType); err != nil {
+		return nil, err
+	}
+	f := newEvaluator(in, interpolatorType)
+	out = make([]float64, len(positions))
+	for i, pos := range positions {
+		out[i] = f(pos)
+	}
+	return out, nil
+}
+
+// checkEvaluable returns ErrUnknownInterpolator unless interpolatorType has an
+// interpolant newEvaluator can evaluate at arbitrary positions. Every exported
+// function built on newEvaluator or newXYEvaluator calls it first, since both
+// quietly fall back to DropSample for unknown types, AreaAverage and LTTB.
+func checkEvaluable(interpolatorType InterpolatorType) error {
+	if _, ok := interpolatorNames[interpolatorType]; !ok {
+		return fmt.Errorf("%w: %v", ErrUnknownInterpolator, interpolatorType)
+	}
+	if interpolatorType == AreaAverage || interpolatorType == LTTB {
+		return fmt.Errorf("%w: %v has no interpolant to evaluate at arbitrary positions", ErrUnknownInterpolator, interpolatorType)
+	}
+	return nil
+}
+
+// newEvaluator returns a function evaluating the interpolant of in at a fractional
+// sample position. At the positions used by Interpolate it agrees with Interpolate.
+// None is treated like DropSample since it has no continuous interpolant.
+func newEvaluator(in []float64, interpolatorType InterpolatorType) func(pos float64) float64 {
+	n := len(in)
+	if n == 0 {
+		return func(float64) float64 { return 0 }
+	}
+	if n == 1 {
+		return func(float64) float64 { return in[0] }
+	}
+
+	if interpolatorType == None {
+		interpolatorType = DropSample
+	}
+	if k, ok := kernelFor(interpolatorType); ok {
+		return func(pos float64) float64 { return k.eval(in, pos) }
+	}
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+
+	switch interpolatorType {
+	case CubicSpline:
+		a, b, c, d := cubicSplineCoefficients(x, in)
+		return func(pos float64) float64 {
+			j := segmentIndex(pos, n)
+			dx := pos - float64(j)
+			return a[j] + b[j]*dx + c[j]*dx*dx + d[j]*dx*dx*dx
+		}
+	case MonotonicCubic:
+		m := monotonicCubicSlopes(x, in)
+		return func(pos float64) float64 { return hermiteSegment(in, m, pos) }
+	case Akima:
+		m := akimaSlopes(x, in)
+		return func(pos float64) float64 { return hermiteSegment(in, m, pos) }
+	case Hyman:
+		m := hymanSlopes(x, in)
+		return func(pos float64) float64 { return hermiteSegment(in, m, pos) }
+	case OMOMS:
+		c := omomsCoefficients(in)
+		return func(pos float64) float64 { return omomsKernel.eval(c, pos) }
+	case Sinc:
+		return func(pos float64) float64 { return sincSum(in, pos, sincImpulse) }
+	case Barycentric:
+		return newBarycentric(in).at
+	case FloaterHormann:
+		return newFloaterHormann(x, in, 0).at
+	case TensionSpline:
+		return newTensionSpline(x, in, 0).at
+	case Schumaker:
+		return newSchumaker(x, in).at
+	}
+
+	// Unknown types behave like None in Interpolate
+	k, _ := kernelFor(DropSample)
+	return func(pos float64) float64 { return k.eval(in, pos) }
+}
+
+// segmentIndex returns the index of the spline segment containing pos, clamped so
+// positions outside the input extrapolate from the first or last segment
+func segmentIndex(pos float64, n int) int {
+	j := int(math.Floor(pos))
+	if j > n-2 {
+		j = n - 2
+	}
+	if j < 0 {
+		j = 0
+	}
+	return j
+}
+
+// hermiteSegment evaluates the cubic Hermite spline through in with slopes m at pos
+func hermiteSegment(in, m []float64, pos float64) float64 {
+	j := segmentIndex(pos, len(in))
+	t := pos - float64(j)
+	t2 := t * t
+	t3 := t2 * t
+
+	// Hermite basis functions
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := t3 - 2*t2 + t
+	h01 := -2*t3 + 3*t2
+	h11 := t3 - t2
+
+	return h00*in[j] + h10*m[j] + h01*in[j+1] + h11*m[j+1]
+}
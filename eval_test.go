@@ -0,0 +1,152 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEvaluatorMatchesInterpolate(t *testing.T) {
+	in := []float64{0.5, -1.2, 3.3, 2.0, -0.7, 1.1, 4.2, -2.5, 0.0, 1.9}
+	outSamples := 31
+	ratio := float64(len(in)-1) / float64(outSamples-1)
+
+	for typ := DropSample; typ <= Akima; typ++ {
+		expected, err := Interpolate(in, outSamples, typ)
+		if err != nil {
+			t.Fatalf("Interpolate(%d) returned unexpected error: %v", typ, err)
+		}
+		f := newEvaluator(in, typ)
+		for i := range expected {
+			if got := f(float64(i) * ratio); math.Abs(got-expected[i]) > 1e-10 {
+				t.Errorf("newEvaluator(%d) at %d = %v, want %v", typ, i, got, expected[i])
+			}
+		}
+	}
+}
+
+func TestEvaluatorShortInput(t *testing.T) {
+	if got := newEvaluator(nil, Lanczos3)(1.5); got != 0 {
+		t.Errorf("newEvaluator() on empty input = %v, want 0", got)
+	}
+	if got := newEvaluator([]float64{4.5}, CubicSpline)(0.3); got != 4.5 {
+		t.Errorf("newEvaluator() on single sample = %v, want 4.5", got)
+	}
+}
+
+func TestInterpolateAt(t *testing.T) {
+	in := []float64{0, 10, 20, 30}
+	out, err := InterpolateAt(in, []float64{0, 0.5, 1.25, 3}, Linear)
+	if err != nil {
+		t.Fatalf("InterpolateAt() returned unexpected error: %v", err)
+	}
+	expected := []float64{0, 5, 12.5, 30}
+	for i := range expected {
+		if math.Abs(out[i]-expected[i]) > 1e-10 {
+			t.Errorf("InterpolateAt()[%d] = %v, want %v", i, out[i], expected[i])
+		}
+	}
+}
+
+func TestInterpolateAtErrors(t *testing.T) {
+	in := []float64{0, 10, 20, 30}
+	for _, typ := range []InterpolatorType{InterpolatorType(9999), AreaAverage, LTTB} {
+		if _, err := InterpolateAt(in, []float64{0.5}, typ); !errors.Is(err, ErrUnknownInterpolator) {
+			t.Errorf("InterpolateAt(%v) error = %v, want %v", typ, err, ErrUnknownInterpolator)
+		}
+	}
+	if _, err := InterpolateAt(nil, []float64{0.5}, Linear); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateAt() of no samples error = %v, want %v", err, ErrTooFewPoints)
+	}
+	if _, err := InterpolateAt([]float64{1, 2}, []float64{0.5}, Lagrange6); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateAt(Lagrange6) of 2 samples error = %v, want %v", err, ErrTooFewPoints)
+	}
+	// Every other known type evaluates, and None behaves like DropSample
+	long := make([]float64, 20)
+	for _, info := range All() {
+		if info.Type == AreaAverage || info.Type == LTTB {
+			continue
+		}
+		if _, err := InterpolateAt(long, []float64{0.5}, info.Type); err != nil {
+			t.Errorf("InterpolateAt(%v) returned unexpected error: %v", info.Type, err)
+		}
+	}
+}
+
+func TestEvaluatorEntryPointsRejectUnknown(t *testing.T) {
+	in := []float64{0, 10, 20, 30, 20, 10}
+	x := []float64{0, 1, 2, 3, 4, 5}
+	points := [][]float64{{0, 0}, {1, 2}, {3, 1}, {4, 4}}
+	entryPoints := map[string]func(typ InterpolatorType) error{
+		"Integrate": func(typ InterpolatorType) error {
+			_, err := Integrate(in, 0, 1, typ)
+			return err
+		},
+		"CumulativeIntegral": func(typ InterpolatorType) error {
+			_, err := CumulativeIntegral(in, 10, typ)
+			return err
+		},
+		"Solve": func(typ InterpolatorType) error {
+			_, err := Solve(in, 15, typ)
+			return err
+		},
+		"Resample": func(typ InterpolatorType) error {
+			_, err := Resample(in, 44100, 48000, typ)
+			return err
+		},
+		"InterpolateXY": func(typ InterpolatorType) error {
+			_, err := InterpolateXY(x, in, []float64{0.5}, typ)
+			return err
+		},
+		"InterpolateSegmented": func(typ InterpolatorType) error {
+			_, err := InterpolateSegmented(x, in, []float64{0.5}, 2, 0, typ)
+			return err
+		},
+		"ReconstructDeadband": func(typ InterpolatorType) error {
+			_, _, _, err := ReconstructDeadband(x, in, 1, []float64{0.5}, typ)
+			return err
+		},
+		"RebinPSD": func(typ InterpolatorType) error {
+			_, err := RebinPSD(x, in, []float64{0, 2, 4}, typ)
+			return err
+		},
+		"Varispeed": func(typ InterpolatorType) error {
+			_, err := Varispeed(in, func(float64) float64 { return 1 }, typ)
+			return err
+		},
+		"VarispeedEnvelope": func(typ InterpolatorType) error {
+			_, err := VarispeedEnvelope(in, []float64{1, 2}, typ)
+			return err
+		},
+		"RollingStats": func(typ InterpolatorType) error {
+			_, err := RollingStats(in, 2, 1, typ)
+			return err
+		},
+		"InterpolatePeriodic": func(typ InterpolatorType) error {
+			_, err := InterpolatePeriodic(in, 10, typ)
+			return err
+		},
+		"BuildPyramid": func(typ InterpolatorType) error {
+			_, err := BuildPyramid(in, 2, typ)
+			return err
+		},
+		"NewLiveSeries": func(typ InterpolatorType) error {
+			_, err := NewLiveSeries(1, 10, typ)
+			return err
+		},
+		"InterpolateCurve": func(typ InterpolatorType) error {
+			_, err := InterpolateCurve(points, 10, ParamUniform, typ)
+			return err
+		},
+	}
+	for name, call := range entryPoints {
+		for _, typ := range []InterpolatorType{InterpolatorType(999), AreaAverage, LTTB} {
+			if err := call(typ); !errors.Is(err, ErrUnknownInterpolator) {
+				t.Errorf("%s(%v) error = %v, want %v", name, typ, err, ErrUnknownInterpolator)
+			}
+		}
+		if err := call(Linear); err != nil {
+			t.Errorf("%s(Linear) returned unexpected error: %v", name, err)
+		}
+	}
+}
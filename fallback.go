@@ -0,0 +1,26 @@
+package interpolators
+
+import "fmt"
+
+// InterpolateWithFallback interpolates in with the first interpolator in preferred
+// that the input supports, so short inputs can fall back, e.g. from Lagrange6 to
+// Lagrange4 to Linear, without an if/else ladder at every call site. It returns
+// the interpolator used. When none applies, the error of the last candidate is
+// returned.
+func InterpolateWithFallback(in []float64, outSamples int, preferred []InterpolatorType) (out []float64, used InterpolatorType, err error) {
+	if len(preferred) == 0 {
+		return nil, None, fmt.Errorf("%w: no interpolator given", ErrUnknownInterpolator)
+	}
+	for _, t := range preferred {
+		if _, ok := interpolatorNames[t]; !ok {
+			err = fmt.Errorf("%w: %v", ErrUnknownInterpolator, t)
+			continue
+		}
+		if err = validate(in, outSamples, t); err != nil {
+			continue
+		}
+		out, err = Interpolate(in, outSamples, t)
+		return out, t, err
+	}
+	return nil, None, err
+}
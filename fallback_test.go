@@ -0,0 +1,53 @@
+package interpolators
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInterpolateWithFallback(t *testing.T) {
+	preferred := []InterpolatorType{Lagrange6, Lagrange4, Linear}
+	tests := []struct {
+		name string
+		in   []float64
+		want InterpolatorType
+	}{
+		{"long enough for the first", []float64{0, 1, 2, 3, 4, 5, 6}, Lagrange6},
+		{"falls back one", []float64{0, 1, 2}, Lagrange4},
+		{"falls back to linear", []float64{0, 1}, Linear},
+		{"single sample", []float64{3}, Lagrange6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, used, err := InterpolateWithFallback(tt.in, 5, preferred)
+			if err != nil {
+				t.Fatalf("InterpolateWithFallback() returned unexpected error: %v", err)
+			}
+			if used != tt.want {
+				t.Errorf("InterpolateWithFallback() used %v, want %v", used, tt.want)
+			}
+			want, _ := Interpolate(tt.in, 5, tt.want)
+			for i := range want {
+				if out[i] != want[i] {
+					t.Fatalf("InterpolateWithFallback()[%d] = %v, want %v", i, out[i], want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolateWithFallbackErrors(t *testing.T) {
+	if _, _, err := InterpolateWithFallback([]float64{0, 1}, 5, []InterpolatorType{Lagrange6, Lanczos3}); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateWithFallback() error = %v, want ErrTooFewPoints", err)
+	}
+	if _, _, err := InterpolateWithFallback([]float64{0, 1}, 5, nil); !errors.Is(err, ErrUnknownInterpolator) {
+		t.Errorf("InterpolateWithFallback() with no candidates error = %v, want ErrUnknownInterpolator", err)
+	}
+	// Unknown types are skipped rather than passed through
+	if _, used, err := InterpolateWithFallback([]float64{0, 1}, 5, []InterpolatorType{InterpolatorType(999), Linear}); err != nil || used != Linear {
+		t.Errorf("InterpolateWithFallback() = %v, %v, want linear", used, err)
+	}
+	if _, _, err := InterpolateWithFallback([]float64{0, 1}, 0, []InterpolatorType{Linear}); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateWithFallback() error = %v, want ErrInvalidOutSamples", err)
+	}
+}
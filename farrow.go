@@ -0,0 +1,119 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Farrow evaluates a piecewise-polynomial kernel in Farrow form. The weight of
+// each tap is a polynomial in the fractional delay μ = pos - floor(pos), so the
+// output is
+//
+//	y(μ) = Σ_d μᵈ · Σ_t coeffs[d][t]·x[t]
+//
+// a bank of fixed FIR branches, one per power of μ, combined with Horner's rule.
+// The branches never change, so a delay that varies from sample to sample costs
+// no more than a fixed one and no kernel is evaluated at run time.
+type Farrow struct {
+	k kernel
+	// coeffs[d][t] is the coefficient of μᵈ in the weight of tap
+	// floor(pos)-radius+1+t
+	coeffs [][]float64
+}
+
+// NewFarrow builds the Farrow structure of a convolution-based interpolator whose
+// kernel is a polynomial between integer positions, such as the Lagrange,
+// Hermite, B-spline, osculating and optimal interpolators
+func NewFarrow(interpolatorType InterpolatorType) (*Farrow, error) {
+	k, ok := kernelFor(interpolatorType)
+	info, known := infoOf(interpolatorType)
+	if !ok || !known || info.Order < 0 || k.normalize {
+		return nil, fmt.Errorf("interpolator type %d has no Farrow form", interpolatorType)
+	}
+	pieces, err := polyPieces(k.impulse, k.radius, info.Order)
+	if err != nil {
+		return nil, err
+	}
+	// Kernels whose pieces break between integer positions, like the nearest
+	// neighbour's, cannot be written this way
+	for p, c := range pieces {
+		for _, t := range []float64{0.05, 0.37, 0.71, 0.95} {
+			x := float64(p-k.radius) + t
+			if math.Abs(hornerEval(c, t)-k.impulse(x)) > 1e-9 {
+				return nil, fmt.Errorf("interpolator type %d is not a polynomial between integer positions", interpolatorType)
+			}
+		}
+	}
+
+	taps := 2 * k.radius
+	coeffs := make([][]float64, info.Order+1)
+	for d := range coeffs {
+		coeffs[d] = make([]float64, taps)
+		for t := range coeffs[d] {
+			// Tap t sits at distance μ + radius-1-t, in piece 2·radius-1-t
+			coeffs[d][t] = pieces[taps-1-t][d]
+		}
+	}
+	return &Farrow{k: k, coeffs: coeffs}, nil
+}
+
+// Degree returns the degree of the polynomials in the fractional delay
+func (f *Farrow) Degree() int {
+	return len(f.coeffs) - 1
+}
+
+// Coefficients returns a copy of the branch coefficients: row d holds the FIR
+// branch multiplied by μᵈ, with one entry per tap from floor(pos)-radius+1 up
+func (f *Farrow) Coefficients() [][]float64 {
+	out := make([][]float64, len(f.coeffs))
+	for d, row := range f.coeffs {
+		out[d] = append([]float64(nil), row...)
+	}
+	return out
+}
+
+// At evaluates the interpolant of in at the fractional input position pos, with
+// the kernel's own edge handling
+func (f *Farrow) At(in []float64, pos float64) float64 {
+	if len(in) == 0 {
+		return 0
+	}
+	base := math.Floor(pos)
+	mu := pos - base
+	if mu == 0 {
+		// The polynomials take the limit from above, which differs from the kernel
+		// at whole samples for the kernels that jump there, like the optimal ones
+		return f.k.eval(in, pos)
+	}
+	first := int(base) - f.k.radius + 1
+	y := 0.0
+	for d := len(f.coeffs) - 1; d >= 0; d-- {
+		branch := 0.0
+		for t, c := range f.coeffs[d] {
+			if idx, ok := f.k.boundary.index(first+t, len(in)); ok {
+				branch += c * in[idx]
+			}
+		}
+		y = y*mu + branch
+	}
+	return y
+}
+
+// InterpolateAt evaluates the interpolant of in at each of the fractional input
+// positions
+func (f *Farrow) InterpolateAt(in []float64, positions []float64) []float64 {
+	out := make([]float64, len(positions))
+	for i, pos := range positions {
+		out[i] = f.At(in, pos)
+	}
+	return out
+}
+
+// hornerEval evaluates the polynomial c0 + c1·t + c2·t² + ... at t
+func hornerEval(c []float64, t float64) float64 {
+	sum := 0.0
+	for e := len(c) - 1; e >= 0; e-- {
+		sum = sum*t + c[e]
+	}
+	return sum
+}
@@ -0,0 +1,64 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFarrowMatchesKernel(t *testing.T) {
+	in := []float64{0.3, -1.2, 2.5, 0.8, -0.4, 1.9, 3.1, -2.2, 0.5}
+	positions := []float64{-0.6, 0, 0.25, 1.5, 2.999, 3.7, 5.01, 7.5, 8, 8.4}
+	for _, info := range All() {
+		f, err := NewFarrow(info.Type)
+		if err != nil {
+			continue
+		}
+		k, _ := kernelFor(info.Type)
+		got := f.InterpolateAt(in, positions)
+		for i, pos := range positions {
+			if want := k.eval(in, pos); math.Abs(got[i]-want) > 1e-9 {
+				t.Errorf("%v Farrow at %v = %v, want %v", info.Type, pos, got[i], want)
+			}
+		}
+	}
+}
+
+func TestFarrowLagrange4Coefficients(t *testing.T) {
+	// The textbook Farrow matrix of cubic Lagrange interpolation, one row per
+	// power of the fractional delay and one column per tap
+	want := [][]float64{
+		{0, 1, 0, 0},
+		{-1.0 / 3, -0.5, 1, -1.0 / 6},
+		{0.5, -1, 0.5, 0},
+		{-1.0 / 6, 0.5, -0.5, 1.0 / 6},
+	}
+	f, err := NewFarrow(Lagrange4)
+	if err != nil {
+		t.Fatalf("NewFarrow(Lagrange4) returned unexpected error: %v", err)
+	}
+	if f.Degree() != 3 {
+		t.Errorf("Degree() = %d, want 3", f.Degree())
+	}
+	got := f.Coefficients()
+	for d := range want {
+		for tap := range want[d] {
+			if math.Abs(got[d][tap]-want[d][tap]) > 1e-12 {
+				t.Errorf("coefficient [%d][%d] = %v, want %v", d, tap, got[d][tap], want[d][tap])
+			}
+		}
+	}
+	got[0][0] = 42
+	if f.Coefficients()[0][0] == 42 {
+		t.Error("Coefficients returned the internal table instead of a copy")
+	}
+}
+
+func TestNewFarrowErrors(t *testing.T) {
+	// Pieces breaking between integers, non-polynomial kernels and splines have
+	// no Farrow form
+	for _, typ := range []InterpolatorType{DropSample, Lanczos3, KaiserSinc, CubicSpline, Gaussian, InterpolatorType(-1)} {
+		if _, err := NewFarrow(typ); err == nil {
+			t.Errorf("NewFarrow(%v) returned no error", typ)
+		}
+	}
+}
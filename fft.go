@@ -0,0 +1,107 @@
+package interpolators
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// fft returns the discrete Fourier transform of x, or the inverse transform
+// including the 1/n scaling when inverse is set. Powers of two use an iterative
+// radix-2 transform and other lengths Bluestein's chirp-z algorithm, so every
+// length costs O(n log n).
+func fft(x []complex128, inverse bool) []complex128 {
+	n := len(x)
+	out := append([]complex128(nil), x...)
+	if n <= 1 {
+		return out
+	}
+	if n&(n-1) == 0 {
+		radix2(out, inverse)
+	} else {
+		out = bluestein(out, inverse)
+	}
+	if inverse {
+		scale := complex(1/float64(n), 0)
+		for i := range out {
+			out[i] *= scale
+		}
+	}
+	return out
+}
+
+// radix2 transforms x in place, without scaling; len(x) must be a power of two
+func radix2(x []complex128, inverse bool) {
+	n := len(x)
+	shift := 64 - uint(bits.Len(uint(n-1)))
+	for i := range x {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); j > i {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Rect(1, sign*2*math.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				a, b := x[start+k], w*x[start+k+size/2]
+				x[start+k], x[start+k+size/2] = a+b, a-b
+				w *= step
+			}
+		}
+	}
+}
+
+// bluestein transforms x of any length, without scaling, by expressing the DFT as
+// a convolution with a chirp that is evaluated with power-of-two transforms
+func bluestein(x []complex128, inverse bool) []complex128 {
+	n := len(x)
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	// chirp[k] = exp(sign·iπk²/n); k² is reduced mod 2n to keep the angle accurate
+	chirp := make([]complex128, n)
+	for k := range chirp {
+		k2 := (k * k) % (2 * n)
+		chirp[k] = cmplx.Rect(1, sign*math.Pi*float64(k2)/float64(n))
+	}
+
+	m := 1 << bits.Len(uint(2*n-2))
+	a := make([]complex128, m)
+	b := make([]complex128, m)
+	for k := 0; k < n; k++ {
+		a[k] = x[k] * chirp[k]
+	}
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[m-k] = b[k]
+	}
+	radix2(a, false)
+	radix2(b, false)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	radix2(a, true)
+
+	out := make([]complex128, n)
+	scale := complex(1/float64(m), 0)
+	for k := range out {
+		out[k] = a[k] * scale * chirp[k]
+	}
+	return out
+}
+
+// realFFT transforms a real signal
+func realFFT(x []float64) []complex128 {
+	c := make([]complex128, len(x))
+	for i, v := range x {
+		c[i] = complex(v, 0)
+	}
+	return fft(c, false)
+}
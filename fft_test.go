@@ -0,0 +1,57 @@
+package interpolators
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// naiveDFT is the O(n²) definition of the transform
+func naiveDFT(x []complex128, inverse bool) []complex128 {
+	n := len(x)
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+	out := make([]complex128, n)
+	for k := range out {
+		for j, v := range x {
+			out[k] += v * cmplx.Rect(1, sign*2*math.Pi*float64(j*k%n)/float64(n))
+		}
+		if inverse {
+			out[k] /= complex(float64(n), 0)
+		}
+	}
+	return out
+}
+
+func TestFFTMatchesDFT(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for _, n := range []int{1, 2, 3, 8, 12, 64, 97, 100, 256} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(rng.NormFloat64(), rng.NormFloat64())
+		}
+		for _, inverse := range []bool{false, true} {
+			got := fft(x, inverse)
+			want := naiveDFT(x, inverse)
+			for k := range want {
+				if cmplx.Abs(got[k]-want[k]) > 1e-9*math.Max(1, float64(n)) {
+					t.Errorf("fft(n=%d, inverse=%v)[%d] = %v, want %v", n, inverse, k, got[k], want[k])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestFFTRoundTrip(t *testing.T) {
+	x := []float64{1, -2, 3.5, 0, 4, 2, -1}
+	back := fft(realFFT(x), true)
+	for i := range x {
+		if math.Abs(real(back[i])-x[i]) > 1e-12 || math.Abs(imag(back[i])) > 1e-12 {
+			t.Errorf("round trip[%d] = %v, want %v", i, back[i], x[i])
+		}
+	}
+}
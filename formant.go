@@ -0,0 +1,130 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+const (
+	// defaultFormantFrame is the analysis frame length in samples
+	defaultFormantFrame = 1024
+	// defaultFormantLifter is the number of cepstral coefficients describing the
+	// spectral envelope
+	defaultFormantLifter = 30
+	// formantFloor keeps the log spectrum finite in silent bins, relative to the
+	// frame's strongest bin
+	formantFloor = 1e-6
+)
+
+// FormantOptions configures VarispeedFormant. Zero values select the defaults.
+type FormantOptions struct {
+	// FrameSize is the analysis frame length in samples, 1024 by default. Frames
+	// overlap by three quarters.
+	FrameSize int
+	// Lifter is the number of cepstral coefficients kept for the spectral envelope,
+	// 30 by default. It must stay below the pitch period in samples, or the
+	// envelope starts to follow the harmonics instead of the formants.
+	Lifter int
+}
+
+// VarispeedFormant plays in at a constant speed like Varispeed, which shifts pitch
+// and formants together, then restores the original spectral envelope so voices
+// keep their character instead of sounding chipmunked (speed above 1) or giant
+// (below 1). The envelope of each frame is estimated by cepstral liftering, and
+// every output frame is reshaped from its own envelope to the envelope of the
+// input at the same point in the material, then overlap-added.
+func VarispeedFormant(in []float64, speed float64, interpolatorType InterpolatorType, opts FormantOptions) ([]float64, error) {
+	if !(speed > 0) || math.IsInf(speed, 0) {
+		return nil, fmt.Errorf("speed must be positive, got %v", speed)
+	}
+	if opts.FrameSize == 0 {
+		opts.FrameSize = defaultFormantFrame
+	}
+	if opts.Lifter == 0 {
+		opts.Lifter = defaultFormantLifter
+	}
+	if opts.FrameSize < 16 || opts.Lifter < 1 || opts.Lifter >= opts.FrameSize/2 {
+		return nil, errors.New("frame size must be at least 16 and lifter between 1 and half the frame")
+	}
+
+	shifted, err := Varispeed(in, func(float64) float64 { return speed }, interpolatorType)
+	if err != nil || len(shifted) == 0 {
+		return shifted, err
+	}
+
+	n := opts.FrameSize
+	hop := n / 4
+	window := make([]float64, n)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(n))
+	}
+	out := make([]float64, len(shifted))
+	norm := make([]float64, len(shifted))
+	for start := -n / 2; start < len(shifted); start += hop {
+		frame := windowedFrame(shifted, start, window)
+		// The material under this frame's center came from speed times as far in
+		spectrum := fft(frame, false)
+		source := fft(windowedFrame(in, int(math.Round(float64(start+n/2)*speed))-n/2, window), false)
+		have := spectralEnvelope(spectrum, opts.Lifter)
+		want := spectralEnvelope(source, opts.Lifter)
+		for k := range spectrum {
+			spectrum[k] *= complex(want[k]/have[k], 0)
+		}
+
+		frame = fft(spectrum, true)
+		for i, v := range frame {
+			j := start + i
+			if j < 0 || j >= len(out) {
+				continue
+			}
+			out[j] += real(v) * window[i]
+			norm[j] += window[i] * window[i]
+		}
+	}
+	for i := range out {
+		if norm[i] > 1e-9 {
+			out[i] /= norm[i]
+		}
+	}
+	return out, nil
+}
+
+// windowedFrame returns the window applied to x from index start, with zeros
+// outside x
+func windowedFrame(x []float64, start int, window []float64) []complex128 {
+	frame := make([]complex128, len(window))
+	for i, w := range window {
+		if j := start + i; j >= 0 && j < len(x) {
+			frame[i] = complex(x[j]*w, 0)
+		}
+	}
+	return frame
+}
+
+// spectralEnvelope returns the smooth magnitude envelope of a spectrum, keeping
+// the first lifter coefficients of its real cepstrum
+func spectralEnvelope(spectrum []complex128, lifter int) []float64 {
+	n := len(spectrum)
+	peak := 0.0
+	for _, v := range spectrum {
+		peak = math.Max(peak, cmplx.Abs(v))
+	}
+	floor := math.Max(peak*formantFloor, math.SmallestNonzeroFloat64)
+
+	logMag := make([]complex128, n)
+	for k, v := range spectrum {
+		logMag[k] = complex(math.Log(math.Max(cmplx.Abs(v), floor)), 0)
+	}
+	cepstrum := fft(logMag, true)
+	for q := lifter; q <= n-lifter; q++ {
+		cepstrum[q] = 0
+	}
+	smooth := fft(cepstrum, false)
+	envelope := make([]float64, n)
+	for k, v := range smooth {
+		envelope[k] = math.Exp(real(v))
+	}
+	return envelope
+}
@@ -0,0 +1,109 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+// vowel returns a pulse train at f0 through a resonance at formant, sampled at sr
+func vowel(f0, formant, sr float64, samples int) []float64 {
+	r := math.Exp(-math.Pi * 150 / sr)
+	a1 := 2 * r * math.Cos(2*math.Pi*formant/sr)
+	a2 := -r * r
+	out := make([]float64, samples)
+	var y1, y2 float64
+	period := sr / f0
+	next := 0.0
+	for i := range out {
+		x := 0.0
+		if float64(i) >= next {
+			x = 1
+			next += period
+		}
+		y := x + a1*y1 + a2*y2
+		out[i] = y
+		y1, y2 = y, y1
+	}
+	return out
+}
+
+// envelopePeak returns the frequency of the peak of the average spectral envelope
+// of the middle of x
+func envelopePeak(x []float64, sr float64) float64 {
+	const n = 1024
+	sum := make([]float64, n)
+	for start := len(x) / 4; start+n < 3*len(x)/4; start += n / 2 {
+		frame := make([]complex128, n)
+		for i := range frame {
+			w := 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/n)
+			frame[i] = complex(x[start+i]*w, 0)
+		}
+		for k, v := range spectralEnvelope(fft(frame, false), 20) {
+			sum[k] += v
+		}
+	}
+	best := 1
+	for k := 1; k < n/2; k++ {
+		if sum[k] > sum[best] {
+			best = k
+		}
+	}
+	return float64(best) * sr / n
+}
+
+func TestVarispeedFormantKeepsFormant(t *testing.T) {
+	const sr = 16000
+	in := vowel(110, 1000, sr, 3*sr)
+
+	plain, _ := Varispeed(in, func(float64) float64 { return 1.5 }, Lanczos3)
+	if peak := envelopePeak(plain, sr); math.Abs(peak-1500) > 150 {
+		t.Fatalf("formant after plain varispeed = %v Hz, want about 1500", peak)
+	}
+
+	out, err := VarispeedFormant(in, 1.5, Lanczos3, FormantOptions{})
+	if err != nil {
+		t.Fatalf("VarispeedFormant() returned unexpected error: %v", err)
+	}
+	if len(out) != len(plain) {
+		t.Errorf("VarispeedFormant() length = %d, want %d", len(out), len(plain))
+	}
+	if peak := envelopePeak(out, sr); math.Abs(peak-1000) > 100 {
+		t.Errorf("formant after VarispeedFormant() = %v Hz, want about 1000", peak)
+	}
+
+	// The pitch is still raised: the pitch period shrinks from 145 to 97 samples
+	best, bestLag := math.Inf(-1), 0
+	mid := out[len(out)/4 : 3*len(out)/4]
+	for lag := 60; lag < 130; lag++ {
+		sum := 0.0
+		for i := 0; i+lag < len(mid); i++ {
+			sum += mid[i] * mid[i+lag]
+		}
+		if sum > best {
+			best, bestLag = sum, lag
+		}
+	}
+	if math.Abs(float64(bestLag)-sr/110/1.5) > 2 {
+		t.Errorf("pitch period after VarispeedFormant() = %d samples, want about %v", bestLag, sr/110/1.5)
+	}
+}
+
+func TestVarispeedFormantErrors(t *testing.T) {
+	in := make([]float64, 100)
+	tests := []struct {
+		name  string
+		speed float64
+		opts  FormantOptions
+	}{
+		{"zero speed", 0, FormantOptions{}},
+		{"tiny frame", 1, FormantOptions{FrameSize: 8}},
+		{"lifter too long", 1, FormantOptions{FrameSize: 64, Lifter: 40}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := VarispeedFormant(in, tt.speed, Linear, tt.opts); err == nil {
+				t.Error("VarispeedFormant() should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DefaultGPMaxPoints is the largest number of training points an exact Gaussian
+// process fit accepts unless GPOptions.MaxPoints says otherwise; the exact fit
+// costs O(n³) time and O(n²) memory
+const DefaultGPMaxPoints = 2000
+
+// gpJitter is added to the covariance diagonal, relative to the signal variance, to
+// keep noiseless fits numerically positive definite
+const gpJitter = 1e-10
+
+// GPKernel selects the covariance function of a Gaussian process
+type GPKernel int
+
+const (
+	// GPRBF is the squared-exponential kernel, giving infinitely smooth interpolants
+	GPRBF GPKernel = iota
+	// GPMatern32 is the Matérn kernel with ν = 3/2 (once differentiable)
+	GPMatern32
+	// GPMatern52 is the Matérn kernel with ν = 5/2 (twice differentiable)
+	GPMatern52
+)
+
+// GPOptions configures Gaussian process interpolation. Zero values for the
+// length scale and variance select 1.
+type GPOptions struct {
+	Kernel GPKernel
+	// LengthScale is the distance over which the signal decorrelates, in the units
+	// of the coordinates (samples for InterpolateGP)
+	LengthScale float64
+	// Variance is the prior variance of the signal
+	Variance float64
+	// Noise is the variance of the measurement noise; zero interpolates the data
+	Noise float64
+	// MaxPoints bounds the exact fit; zero selects DefaultGPMaxPoints
+	MaxPoints int
+	// InducingPoints, when positive, approximates the fit with that many evenly
+	// spaced inducing points (the DTC approximation), costing O(n·m²) instead of
+	// O(n³) and lifting the MaxPoints limit
+	InducingPoints int
+}
+
+// covariance evaluates the kernel at distance d
+func (o GPOptions) covariance(d float64) float64 {
+	r := math.Abs(d) / o.LengthScale
+	switch o.Kernel {
+	case GPMatern32:
+		s := math.Sqrt(3) * r
+		return o.Variance * (1 + s) * math.Exp(-s)
+	case GPMatern52:
+		s := math.Sqrt(5) * r
+		return o.Variance * (1 + s + s*s/3) * math.Exp(-s)
+	}
+	return o.Variance * math.Exp(-0.5*r*r)
+}
+
+// GaussianProcess fits a Gaussian process to the samples y at coordinates x and
+// returns the posterior mean and variance at the coordinates xq. The prior mean is
+// the mean of y. The variance is that of the underlying signal, excluding the
+// measurement noise.
+func GaussianProcess(x, y, xq []float64, opts GPOptions) (mean, variance []float64, err error) {
+	if len(x) != len(y) {
+		return nil, nil, fmt.Errorf("coordinate and sample lengths differ: %d and %d", len(x), len(y))
+	}
+	if len(x) == 0 {
+		return nil, nil, errors.New("at least one training point is required")
+	}
+	if opts.LengthScale == 0 {
+		opts.LengthScale = 1
+	}
+	if opts.Variance == 0 {
+		opts.Variance = 1
+	}
+	if opts.LengthScale < 0 || opts.Variance < 0 || opts.Noise < 0 {
+		return nil, nil, fmt.Errorf("length scale, variance and noise must not be negative")
+	}
+	switch opts.Kernel {
+	case GPRBF, GPMatern32, GPMatern52:
+	default:
+		return nil, nil, fmt.Errorf("unknown GP kernel %d", opts.Kernel)
+	}
+	maxPoints := opts.MaxPoints
+	if maxPoints == 0 {
+		maxPoints = DefaultGPMaxPoints
+	}
+	if opts.InducingPoints == 0 && len(x) > maxPoints {
+		return nil, nil, fmt.Errorf("%d training points exceed the exact GP limit of %d; set InducingPoints or raise MaxPoints", len(x), maxPoints)
+	}
+
+	prior := 0.0
+	for _, v := range y {
+		prior += v
+	}
+	prior /= float64(len(y))
+	centered := make([]float64, len(y))
+	for i, v := range y {
+		centered[i] = v - prior
+	}
+
+	if opts.InducingPoints > 0 {
+		mean, variance, err = gpInducing(x, centered, xq, opts)
+	} else {
+		mean, variance, err = gpExact(x, centered, xq, opts)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range mean {
+		mean[i] += prior
+	}
+	return mean, variance, nil
+}
+
+// InterpolateGP fits a Gaussian process to uniformly spaced samples and evaluates
+// it on the same output grid as Interpolate, with the length scale in input samples
+func InterpolateGP(in []float64, outSamples int, opts GPOptions) (mean, variance []float64, err error) {
+	x, xq, err := uniformGrid(len(in), outSamples)
+	if err != nil {
+		return nil, nil, err
+	}
+	return GaussianProcess(x, in, xq, opts)
+}
+
+// uniformGrid returns the positions of n uniformly spaced input samples and of the
+// outSamples output samples of Interpolate, after validating outSamples
+func uniformGrid(n, outSamples int) (x, xq []float64, err error) {
+	if err := validateOutSamples(n, outSamples); err != nil {
+		return nil, nil, err
+	}
+	x = make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	xq = make([]float64, outSamples)
+	for i := range xq {
+		xq[i] = outputPosition(i, n, outSamples)
+	}
+	return x, xq, nil
+}
+
+// gpExact computes the exact posterior with a Cholesky factorization
+func gpExact(x, y, xq []float64, opts GPOptions) (mean, variance []float64, err error) {
+	n := len(x)
+	k := newMatrix(n, n)
+	for i := range k {
+		for j := range k[i] {
+			k[i][j] = opts.covariance(x[i] - x[j])
+		}
+		k[i][i] += opts.Noise + gpJitter*opts.Variance
+	}
+	l, err := cholesky(k)
+	if err != nil {
+		return nil, nil, err
+	}
+	alpha := choleskySolve(l, y)
+
+	mean = make([]float64, len(xq))
+	variance = make([]float64, len(xq))
+	kq := make([]float64, n)
+	for i, q := range xq {
+		for j := range x {
+			kq[j] = opts.covariance(q - x[j])
+			mean[i] += kq[j] * alpha[j]
+		}
+		v := forwardSubstitute(l, kq)
+		reduction := 0.0
+		for _, vj := range v {
+			reduction += vj * vj
+		}
+		variance[i] = math.Max(0, opts.covariance(0)-reduction)
+	}
+	return mean, variance, nil
+}
+
+// gpInducing computes the DTC approximation with evenly spaced inducing points
+func gpInducing(x, y, xq []float64, opts GPOptions) (mean, variance []float64, err error) {
+	lo, hi := x[0], x[0]
+	for _, v := range x {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	m := opts.InducingPoints
+	u := make([]float64, m)
+	for i := range u {
+		if m == 1 {
+			u[i] = (lo + hi) / 2
+		} else {
+			u[i] = lo + (hi-lo)*float64(i)/float64(m-1)
+		}
+	}
+
+	noise := opts.Noise + gpJitter*opts.Variance
+	kmm := newMatrix(m, m)
+	for i := range kmm {
+		for j := range kmm[i] {
+			kmm[i][j] = opts.covariance(u[i] - u[j])
+		}
+		kmm[i][i] += gpJitter * opts.Variance
+	}
+	// sigma = Kmm + Kmn*Knm / noise, and b = Kmn*y / noise
+	sigma := newMatrix(m, m)
+	b := make([]float64, m)
+	kmn := newMatrix(m, len(x))
+	for i := range kmn {
+		for j := range x {
+			kmn[i][j] = opts.covariance(u[i] - x[j])
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j <= i; j++ {
+			sum := 0.0
+			for k := range x {
+				sum += kmn[i][k] * kmn[j][k]
+			}
+			sigma[i][j] = kmm[i][j] + sum/noise
+			sigma[j][i] = sigma[i][j]
+		}
+		for k := range x {
+			b[i] += kmn[i][k] * y[k] / noise
+		}
+	}
+
+	lm, err := cholesky(kmm)
+	if err != nil {
+		return nil, nil, err
+	}
+	ls, err := cholesky(sigma)
+	if err != nil {
+		return nil, nil, err
+	}
+	weights := choleskySolve(ls, b)
+
+	mean = make([]float64, len(xq))
+	variance = make([]float64, len(xq))
+	kq := make([]float64, m)
+	for i, q := range xq {
+		for j := range u {
+			kq[j] = opts.covariance(q - u[j])
+			mean[i] += kq[j] * weights[j]
+		}
+		// k** - k*ᵀ Kmm⁻¹ k* + k*ᵀ Σ⁻¹ k*
+		vm := forwardSubstitute(lm, kq)
+		vs := forwardSubstitute(ls, kq)
+		v := opts.covariance(0)
+		for j := range vm {
+			v += vs[j]*vs[j] - vm[j]*vm[j]
+		}
+		variance[i] = math.Max(0, v)
+	}
+	return mean, variance, nil
+}
@@ -0,0 +1,131 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestGaussianProcessInterpolatesNoiseless(t *testing.T) {
+	x := []float64{0, 1, 2.5, 4, 5}
+	y := []float64{1, 3, 2, -1, 0.5}
+	for _, kernel := range []GPKernel{GPRBF, GPMatern32, GPMatern52} {
+		mean, variance, err := GaussianProcess(x, y, x, GPOptions{Kernel: kernel})
+		if err != nil {
+			t.Fatalf("GaussianProcess(%d) returned unexpected error: %v", kernel, err)
+		}
+		for i := range x {
+			if math.Abs(mean[i]-y[i]) > 1e-6 {
+				t.Errorf("GaussianProcess(%d) mean at %v = %v, want %v", kernel, x[i], mean[i], y[i])
+			}
+			if variance[i] > 1e-6 {
+				t.Errorf("GaussianProcess(%d) variance at %v = %v, want 0", kernel, x[i], variance[i])
+			}
+		}
+	}
+}
+
+func TestGaussianProcessVarianceGrowsAwayFromData(t *testing.T) {
+	x := []float64{0, 1, 2}
+	y := []float64{0, 1, 0}
+	_, variance, err := GaussianProcess(x, y, []float64{1, 1.5, 4, 20}, GPOptions{Variance: 2})
+	if err != nil {
+		t.Fatalf("GaussianProcess() returned unexpected error: %v", err)
+	}
+	for i := 1; i < len(variance); i++ {
+		if variance[i] <= variance[i-1] {
+			t.Errorf("variance = %v, want increasing away from the data", variance)
+			break
+		}
+	}
+	// Far from the data the posterior reverts to the prior
+	if math.Abs(variance[3]-2) > 1e-9 {
+		t.Errorf("variance far from data = %v, want prior variance 2", variance[3])
+	}
+}
+
+func TestGaussianProcessNoisySmoothing(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	n := 300
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i) * 0.05
+		y[i] = math.Sin(x[i]) + rng.NormFloat64()*0.2
+	}
+	grid := []float64{2, 5, 8, 12}
+	opts := GPOptions{Kernel: GPMatern52, LengthScale: 2, Noise: 0.04}
+
+	exact, _, err := GaussianProcess(x, y, grid, opts)
+	if err != nil {
+		t.Fatalf("GaussianProcess() returned unexpected error: %v", err)
+	}
+	opts.InducingPoints = 30
+	approx, _, err := GaussianProcess(x, y, grid, opts)
+	if err != nil {
+		t.Fatalf("GaussianProcess() with inducing points returned unexpected error: %v", err)
+	}
+	for i, g := range grid {
+		want := math.Sin(g)
+		if math.Abs(exact[i]-want) > 0.15 {
+			t.Errorf("exact mean at %v = %v, want %v", g, exact[i], want)
+		}
+		if math.Abs(approx[i]-exact[i]) > 0.02 {
+			t.Errorf("inducing mean at %v = %v, want close to exact %v", g, approx[i], exact[i])
+		}
+	}
+}
+
+func TestGaussianProcessMaxPoints(t *testing.T) {
+	x := []float64{0, 1, 2, 3}
+	y := []float64{0, 1, 2, 3}
+	if _, _, err := GaussianProcess(x, y, x, GPOptions{MaxPoints: 3}); err == nil {
+		t.Error("GaussianProcess() above MaxPoints should return an error")
+	}
+	if _, _, err := GaussianProcess(x, y, x, GPOptions{MaxPoints: 3, InducingPoints: 2, Noise: 0.1}); err != nil {
+		t.Errorf("GaussianProcess() with inducing points returned unexpected error: %v", err)
+	}
+}
+
+func TestGaussianProcessInvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		opts GPOptions
+	}{
+		{"length mismatch", []float64{0, 1}, []float64{0}, GPOptions{}},
+		{"empty", []float64{}, []float64{}, GPOptions{}},
+		{"negative noise", []float64{0}, []float64{0}, GPOptions{Noise: -1}},
+		{"unknown kernel", []float64{0}, []float64{0}, GPOptions{Kernel: GPKernel(99)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := GaussianProcess(tt.x, tt.y, []float64{0}, tt.opts); err == nil {
+				t.Error("GaussianProcess() should return an error")
+			}
+		})
+	}
+}
+
+func TestInterpolateGP(t *testing.T) {
+	in := []float64{0, 1, 0, -1, 0}
+	mean, variance, err := InterpolateGP(in, 9, GPOptions{})
+	if err != nil {
+		t.Fatalf("InterpolateGP() returned unexpected error: %v", err)
+	}
+	if len(mean) != 9 || len(variance) != 9 {
+		t.Fatalf("InterpolateGP() lengths = %d, %d, want 9", len(mean), len(variance))
+	}
+	for i, v := range in {
+		if math.Abs(mean[2*i]-v) > 1e-6 {
+			t.Errorf("mean[%d] = %v, want %v", 2*i, mean[2*i], v)
+		}
+	}
+	if variance[1] <= variance[0] {
+		t.Errorf("variance between samples = %v, want above %v", variance[1], variance[0])
+	}
+	if _, _, err := InterpolateGP(in, -1, GPOptions{}); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateGP(outSamples=-1) error = %v, want %v", err, ErrInvalidOutSamples)
+	}
+}
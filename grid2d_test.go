@@ -0,0 +1,87 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterpolate2DBilinear(t *testing.T) {
+	grid := [][]float64{
+		{0, 2},
+		{4, 6},
+	}
+	got, err := Interpolate2D(grid, 3, 3, Linear)
+	if err != nil {
+		t.Fatalf("Interpolate2D() returned unexpected error: %v", err)
+	}
+	want := [][]float64{
+		{0, 1, 2},
+		{2, 3, 4},
+		{4, 5, 6},
+	}
+	for r := range want {
+		for c := range want[r] {
+			if math.Abs(got[r][c]-want[r][c]) > 1e-12 {
+				t.Errorf("Interpolate2D()[%d][%d] = %v, want %v", r, c, got[r][c], want[r][c])
+			}
+		}
+	}
+}
+
+func TestInterpolate2DPlane(t *testing.T) {
+	// Interpolators reproducing lines up to the edges reproduce a plane
+	grid := make([][]float64, 6)
+	for r := range grid {
+		grid[r] = make([]float64, 8)
+		for c := range grid[r] {
+			grid[r][c] = 2*float64(r) - 0.5*float64(c) + 1
+		}
+	}
+	for _, typ := range []InterpolatorType{Linear, CubicSpline, MonotonicCubic, Akima} {
+		t.Run(typ.String(), func(t *testing.T) {
+			got, err := Interpolate2D(grid, 11, 15, typ)
+			if err != nil {
+				t.Fatalf("Interpolate2D() returned unexpected error: %v", err)
+			}
+			if len(got) != 11 || len(got[0]) != 15 {
+				t.Fatalf("Interpolate2D() shape = %d x %d, want 11 x 15", len(got), len(got[0]))
+			}
+			for r := range got {
+				for c := range got[r] {
+					want := 2*float64(r)/2 - 0.5*float64(c)/2 + 1
+					if math.Abs(got[r][c]-want) > 1e-9 {
+						t.Fatalf("Interpolate2D()[%d][%d] = %v, want %v", r, c, got[r][c], want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolate2DErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		grid       [][]float64
+		rows, cols int
+		typ        InterpolatorType
+		want       error
+	}{
+		{"empty", nil, 2, 2, Linear, nil},
+		{"ragged", [][]float64{{1, 2}, {3}}, 2, 2, Linear, nil},
+		{"zero size", [][]float64{{1, 2}}, 0, 2, Linear, ErrInvalidOutSamples},
+		{"too few", [][]float64{{1, 2}, {3, 4}}, 4, 4, Lanczos3, ErrTooFewPoints},
+		{"none", [][]float64{{1, 2}}, 2, 2, None, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Interpolate2D(tt.grid, tt.rows, tt.cols, tt.typ)
+			if err == nil {
+				t.Fatal("Interpolate2D() should return an error")
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("Interpolate2D() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
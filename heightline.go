@@ -0,0 +1,65 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// UpsampleHeightline upsamples a terrain height profile by factor, producing
+// (len(in)-1)*factor+1 samples. The input is first interpolated with a natural
+// cubic spline, then fractal detail is added by midpoint displacement within each
+// input interval, so the input samples are kept exactly. roughness in [0, 1] sets
+// both the size of the first displacement, relative to the mean step between input
+// samples, and how much of it survives each further halving: 0 gives the smooth
+// spline and values near 1 give jagged terrain. The same seed always produces the
+// same detail.
+func UpsampleHeightline(in []float64, factor int, roughness float64, seed int64) ([]float64, error) {
+	if factor < 1 {
+		return nil, fmt.Errorf("factor must be at least 1, got %d", factor)
+	}
+	if roughness < 0 || roughness > 1 {
+		return nil, fmt.Errorf("roughness must be in [0, 1], got %v", roughness)
+	}
+	if len(in) < 2 {
+		out := make([]float64, len(in))
+		copy(out, in)
+		return out, nil
+	}
+
+	out, err := Interpolate(in, (len(in)-1)*factor+1, CubicSpline)
+	if err != nil {
+		return nil, err
+	}
+	if roughness == 0 || factor == 1 {
+		return out, nil
+	}
+
+	step := 0.0
+	for j := 1; j < len(in); j++ {
+		step += math.Abs(in[j] - in[j-1])
+	}
+	step /= float64(len(in) - 1)
+
+	// Detail is zero at the input samples and displaced recursively in between
+	rng := rand.New(rand.NewSource(seed))
+	detail := make([]float64, len(out))
+	var displace func(a, b int, amplitude float64)
+	displace = func(a, b int, amplitude float64) {
+		if b-a < 2 {
+			return
+		}
+		mid := (a + b) / 2
+		detail[mid] = (detail[a]+detail[b])/2 + amplitude*(2*rng.Float64()-1)
+		displace(a, mid, amplitude*roughness)
+		displace(mid, b, amplitude*roughness)
+	}
+	for j := 0; j < len(in)-1; j++ {
+		displace(j*factor, (j+1)*factor, roughness*step)
+	}
+
+	for i := range out {
+		out[i] += detail[i]
+	}
+	return out, nil
+}
@@ -0,0 +1,69 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUpsampleHeightline(t *testing.T) {
+	in := []float64{0, 10, 4, 8, 2}
+	out, err := UpsampleHeightline(in, 16, 0.6, 42)
+	if err != nil {
+		t.Fatalf("UpsampleHeightline() returned unexpected error: %v", err)
+	}
+	if len(out) != 65 {
+		t.Fatalf("UpsampleHeightline() length = %d, want 65", len(out))
+	}
+	for j, v := range in {
+		if out[j*16] != v {
+			t.Errorf("UpsampleHeightline()[%d] = %v, want input sample %v", j*16, out[j*16], v)
+		}
+	}
+
+	smooth, _ := Interpolate(in, 65, CubicSpline)
+	diff := 0.0
+	for i := range out {
+		diff = math.Max(diff, math.Abs(out[i]-smooth[i]))
+	}
+	if diff == 0 {
+		t.Error("UpsampleHeightline() added no detail")
+	}
+	// The displacements form a geometric series bounded by step*r/(1-r)
+	if bound := 7.0 * 0.6 / 0.4; diff > bound {
+		t.Errorf("UpsampleHeightline() detail = %v, want at most %v", diff, bound)
+	}
+
+	again, _ := UpsampleHeightline(in, 16, 0.6, 42)
+	other, _ := UpsampleHeightline(in, 16, 0.6, 43)
+	same, differs := true, false
+	for i := range out {
+		same = same && again[i] == out[i]
+		differs = differs || other[i] != out[i]
+	}
+	if !same {
+		t.Error("UpsampleHeightline() is not deterministic for a fixed seed")
+	}
+	if !differs {
+		t.Error("UpsampleHeightline() ignores the seed")
+	}
+}
+
+func TestUpsampleHeightlineSmooth(t *testing.T) {
+	in := []float64{1, 3, 2}
+	out, _ := UpsampleHeightline(in, 4, 0, 1)
+	smooth, _ := Interpolate(in, 9, CubicSpline)
+	for i := range out {
+		if out[i] != smooth[i] {
+			t.Errorf("UpsampleHeightline()[%d] = %v, want %v", i, out[i], smooth[i])
+		}
+	}
+}
+
+func TestUpsampleHeightlineInvalidInput(t *testing.T) {
+	if _, err := UpsampleHeightline([]float64{1, 2}, 0, 0.5, 1); err == nil {
+		t.Error("UpsampleHeightline() with zero factor should return an error")
+	}
+	if _, err := UpsampleHeightline([]float64{1, 2}, 2, 1.5, 1); err == nil {
+		t.Error("UpsampleHeightline() with roughness above 1 should return an error")
+	}
+}
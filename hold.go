@@ -0,0 +1,69 @@
+package interpolators
+
+import (
+	"math"
+	"sort"
+)
+
+// holdTolerance absorbs rounding in computed positions, so a position meant to
+// land on a sample is not pushed to the neighboring one
+const holdTolerance = 1e-9
+
+// previousHoldImpulse selects the sample at or before the position
+func previousHoldImpulse(x float64) float64 {
+	if x >= -holdTolerance && x < 1-holdTolerance {
+		return 1.0
+	}
+	return 0.0
+}
+
+// nextHoldImpulse selects the sample at or after the position
+func nextHoldImpulse(x float64) float64 {
+	if x > -1+holdTolerance && x <= holdTolerance {
+		return 1.0
+	}
+	return 0.0
+}
+
+// holdInterpolate repeats the previous (forward fill) or next (backward fill)
+// input sample at each output position
+func holdInterpolate(in []float64, outSamples int, interpolatorType InterpolatorType) []float64 {
+	if len(in) == 0 {
+		return []float64{}
+	}
+
+	out := make([]float64, outSamples)
+	for i := range out {
+		pos := outputPosition(i, len(in), outSamples)
+		var idx int
+		if interpolatorType == PreviousHold {
+			idx = int(math.Floor(pos + holdTolerance))
+		} else {
+			idx = int(math.Ceil(pos - holdTolerance))
+		}
+		if idx > len(in)-1 {
+			idx = len(in) - 1
+		}
+		out[i] = in[idx]
+	}
+	return out
+}
+
+// holdSegmentXY returns the sample held at coordinate q, clamping to the first and
+// last samples outside the coordinate range
+func holdSegmentXY(x, y []float64, q float64, interpolatorType InterpolatorType) float64 {
+	if interpolatorType == PreviousHold {
+		// Last coordinate at or before q
+		j := sort.SearchFloat64s(x, q+holdTolerance*math.Max(1, math.Abs(q))) - 1
+		if j < 0 {
+			j = 0
+		}
+		return y[j]
+	}
+	// First coordinate at or after q
+	j := sort.SearchFloat64s(x, q-holdTolerance*math.Max(1, math.Abs(q)))
+	if j > len(y)-1 {
+		j = len(y) - 1
+	}
+	return y[j]
+}
@@ -0,0 +1,65 @@
+package interpolators
+
+import "testing"
+
+func TestInterpolateHold(t *testing.T) {
+	in := []float64{1, 2, 3, 4}
+	tests := []struct {
+		name             string
+		outSamples       int
+		interpolatorType InterpolatorType
+		expected         []float64
+	}{
+		{"previous upsample", 7, PreviousHold, []float64{1, 1, 2, 2, 3, 3, 4}},
+		{"next upsample", 7, NextHold, []float64{1, 2, 2, 3, 3, 4, 4}},
+		// Positions 0, 1.5, 3
+		{"previous downsample", 3, PreviousHold, []float64{1, 2, 4}},
+		{"next downsample", 3, NextHold, []float64{1, 3, 4}},
+		// Positions k/3 land exactly on samples despite rounding in k*(1/3)
+		{"previous thirds", 10, PreviousHold, []float64{1, 1, 1, 2, 2, 2, 3, 3, 3, 4}},
+		{"next thirds", 10, NextHold, []float64{1, 2, 2, 2, 3, 3, 3, 4, 4, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Interpolate(in, tt.outSamples, tt.interpolatorType)
+			if err != nil {
+				t.Fatalf("Interpolate() returned unexpected error: %v", err)
+			}
+			for i := range tt.expected {
+				if out[i] != tt.expected[i] {
+					t.Fatalf("Interpolate() = %v, want %v", out, tt.expected)
+				}
+			}
+			// The kernel form used by the other APIs agrees
+			positions := make([]float64, tt.outSamples)
+			for i := range positions {
+				positions[i] = outputPosition(i, len(in), tt.outSamples)
+			}
+			at, _ := InterpolateAt(in, positions, tt.interpolatorType)
+			for i := range tt.expected {
+				if at[i] != tt.expected[i] {
+					t.Fatalf("InterpolateAt() = %v, want %v", at, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolateXYHold(t *testing.T) {
+	x := []float64{0, 10, 25}
+	y := []float64{1, 2, 3}
+	xq := []float64{-5, 0, 5, 10, 24.9, 25, 30}
+
+	prev, _ := InterpolateXY(x, y, xq, PreviousHold)
+	next, _ := InterpolateXY(x, y, xq, NextHold)
+	wantPrev := []float64{1, 1, 1, 2, 2, 3, 3}
+	wantNext := []float64{1, 1, 2, 2, 3, 3, 3}
+	for i := range xq {
+		if prev[i] != wantPrev[i] {
+			t.Errorf("InterpolateXY(PreviousHold) at %v = %v, want %v", xq[i], prev[i], wantPrev[i])
+		}
+		if next[i] != wantNext[i] {
+			t.Errorf("InterpolateXY(NextHold) at %v = %v, want %v", xq[i], next[i], wantNext[i])
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHymanMonotone(t *testing.T) {
+	// The natural spline overshoots the steps of monotone data; Hyman does not
+	in := []float64{0, 0, 0.1, 1, 1, 1.05, 3, 3}
+	spline, _ := Interpolate(in, 141, CubicSpline)
+	out, err := Interpolate(in, 141, Hyman)
+	if err != nil {
+		t.Fatalf("Interpolate(Hyman) returned unexpected error: %v", err)
+	}
+	overshoots := false
+	for i := 1; i < len(spline); i++ {
+		if spline[i] < spline[i-1]-1e-12 {
+			overshoots = true
+		}
+		if out[i] < out[i-1]-1e-12 {
+			t.Errorf("Hyman [%d] = %v after %v, want non-decreasing", i, out[i], out[i-1])
+		}
+	}
+	if !overshoots {
+		t.Error("CubicSpline does not overshoot the test data, the test checks nothing")
+	}
+	for j, v := range in {
+		if got := out[j*20]; math.Abs(got-v) > 1e-12 {
+			t.Errorf("Hyman at sample %d = %v, want %v", j, got, v)
+		}
+	}
+}
+
+func TestHymanKeepsSpline(t *testing.T) {
+	// Where the spline's slopes already pass the filter the curves are identical
+	x := []float64{0, 1, 2.5, 3, 4.5}
+	y := []float64{0, 1, 2.5, 3, 4.5}
+	xq := []float64{0.3, 1.7, 2.8, 4}
+	got, err := InterpolateXY(x, y, xq, Hyman)
+	if err != nil {
+		t.Fatalf("InterpolateXY(Hyman) returned unexpected error: %v", err)
+	}
+	want, _ := InterpolateXY(x, y, xq, CubicSpline)
+	for i, q := range xq {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("Hyman at %v = %v, want the spline's %v", q, got[i], want[i])
+		}
+	}
+	in := []float64{0, 1, 4, 9, 16, 25}
+	m := hymanSlopes(samplePositions(len(in)), in)
+	_, b, _, _ := cubicSplineCoefficients(samplePositions(len(in)), in)
+	for i := 1; i < len(in)-1; i++ {
+		if m[i] != b[i] {
+			t.Errorf("slope %d = %v, want the spline's %v", i, m[i], b[i])
+		}
+	}
+}
+
+func TestHymanSlopes(t *testing.T) {
+	x := samplePositions(6)
+	y := []float64{0, 2, 1, 1, 3, 10}
+	m := hymanSlopes(x, y)
+	// Local extrema and flat neighbours get zero slope
+	for _, i := range []int{1, 2, 3} {
+		if m[i] != 0 {
+			t.Errorf("slope %d = %v, want 0", i, m[i])
+		}
+	}
+	// Every slope respects three times the smaller neighbouring secant
+	for i := 1; i < len(y)-1; i++ {
+		limit := 3 * math.Min(math.Abs(y[i]-y[i-1]), math.Abs(y[i+1]-y[i]))
+		if math.Abs(m[i]) > limit+1e-12 {
+			t.Errorf("slope %d = %v, want within ±%v", i, m[i], limit)
+		}
+	}
+}
+
+func TestHymanDerivative(t *testing.T) {
+	in := []float64{0, 2, 1, 1, 3, 10, 9}
+	for _, pos := range []float64{0.4, 1, 2.5, 4, 5.7} {
+		h := 1e-6
+		f, _ := InterpolateAt(in, []float64{pos - h, pos + h}, Hyman)
+		want := (f[1] - f[0]) / (2 * h)
+		got, err := DerivativeAt(in, []float64{pos}, Hyman)
+		if err != nil {
+			t.Fatalf("DerivativeAt(Hyman) returned unexpected error: %v", err)
+		}
+		if math.Abs(got[0]-want) > 1e-5 {
+			t.Errorf("Hyman derivative at %v = %v, want %v", pos, got[0], want)
+		}
+	}
+}
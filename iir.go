@@ -0,0 +1,131 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// IIRKind selects the filter used by the IIR smoothing interpolators
+type IIRKind int
+
+const (
+	// IIROnePole is exponential smoothing: each output moves a fixed fraction of the
+	// way toward the current input sample
+	IIROnePole IIRKind = iota
+	// IIROnePoleOneZero adds a zero at the Nyquist frequency to the one-pole filter,
+	// averaging consecutive inputs for extra suppression of the hold steps
+	IIROnePoleOneZero
+	// IIRBiquad is a critically damped second-order low-pass with a steeper roll-off
+	// and no overshoot
+	IIRBiquad
+)
+
+// newIIRFilter designs a unity-gain smoothing filter with the given time constant
+// in output samples
+func newIIRFilter(kind IIRKind, timeConstant float64) (biquad, error) {
+	if !(timeConstant > 0) {
+		return biquad{}, fmt.Errorf("time constant must be positive, got %v", timeConstant)
+	}
+	a := math.Exp(-1 / timeConstant)
+	switch kind {
+	case IIROnePole:
+		return biquad{b0: 1 - a, a1: -a}, nil
+	case IIROnePoleOneZero:
+		return biquad{b0: (1 - a) / 2, b1: (1 - a) / 2, a1: -a}, nil
+	case IIRBiquad:
+		// RBJ low-pass at the cutoff matching the time constant, Q = 1/2
+		w0 := 1 / timeConstant
+		if w0 > math.Pi*0.99 {
+			w0 = math.Pi * 0.99
+		}
+		alpha := math.Sin(w0)
+		cosW0 := math.Cos(w0)
+		a0 := 1 + alpha
+		return biquad{
+			b0: (1 - cosW0) / 2 / a0,
+			b1: (1 - cosW0) / a0,
+			b2: (1 - cosW0) / 2 / a0,
+			a1: -2 * cosW0 / a0,
+			a2: (1 - alpha) / a0,
+		}, nil
+	}
+	return biquad{}, fmt.Errorf("unknown IIR kind %d", kind)
+}
+
+// IIRSmoother resamples a stream causally: each output sample holds the most recent
+// input sample at or before its position and a low-pass filter running at the
+// output rate smooths toward it. Unlike the symmetric kernels it needs no look-ahead,
+// so every input chunk produces output immediately.
+type IIRSmoother struct {
+	filter   biquad
+	step     float64 // input samples advanced per output sample
+	consumed int     // input samples received so far
+	next     int64   // index of the next output sample
+}
+
+// NewIIRSmoother creates a streaming IIR smoother converting from sample rate srIn
+// to srOut. timeConstant is in output samples; larger values smooth more.
+func NewIIRSmoother(kind IIRKind, timeConstant, srIn, srOut float64) (*IIRSmoother, error) {
+	if err := checkRates(srIn, srOut); err != nil {
+		return nil, err
+	}
+	filter, err := newIIRFilter(kind, timeConstant)
+	if err != nil {
+		return nil, err
+	}
+	return &IIRSmoother{filter: filter, step: srIn / srOut}, nil
+}
+
+// Process appends chunk to the stream and returns the output samples it completes
+func (s *IIRSmoother) Process(chunk []float64) []float64 {
+	start := s.consumed
+	s.consumed += len(chunk)
+	out := []float64{}
+	for {
+		idx := int(math.Floor(float64(s.next) * s.step))
+		if idx >= s.consumed {
+			break
+		}
+		x := chunk[idx-start]
+		if s.next == 0 {
+			s.filter.prime(x)
+		}
+		out = append(out, s.filter.process(x))
+		s.next++
+	}
+	return out
+}
+
+// Reset clears the filter state and restarts the stream
+func (s *IIRSmoother) Reset() {
+	s.filter.reset()
+	s.consumed = 0
+	s.next = 0
+}
+
+// InterpolateIIR resamples in to outSamples on the same grid as Interpolate, holding
+// each input sample and smoothing toward it with a causal IIR filter. timeConstant
+// is in output samples. The filter starts settled on the first sample.
+func InterpolateIIR(in []float64, outSamples int, kind IIRKind, timeConstant float64) ([]float64, error) {
+	filter, err := newIIRFilter(kind, timeConstant)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateOutSamples(len(in), outSamples); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+
+	filter.prime(in[0])
+	out := make([]float64, outSamples)
+	for i := range out {
+		idx := int(outputPosition(i, len(in), outSamples))
+		if idx > len(in)-1 {
+			idx = len(in) - 1
+		}
+		out[i] = filter.process(in[idx])
+	}
+	return out, nil
+}
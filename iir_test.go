@@ -0,0 +1,101 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterpolateIIRStepResponse(t *testing.T) {
+	// A step smoothed at 4x upsampling rises monotonically toward the new level
+	in := []float64{0, 0, 1, 1, 1, 1, 1, 1}
+	for _, kind := range []IIRKind{IIROnePole, IIROnePoleOneZero, IIRBiquad} {
+		out, err := InterpolateIIR(in, 29, kind, 3)
+		if err != nil {
+			t.Fatalf("InterpolateIIR(%d) returned unexpected error: %v", kind, err)
+		}
+		if out[0] != 0 {
+			t.Errorf("InterpolateIIR(%d)[0] = %v, want 0", kind, out[0])
+		}
+		for i := 1; i < len(out); i++ {
+			if out[i] < out[i-1]-1e-12 {
+				t.Errorf("InterpolateIIR(%d)[%d] = %v decreased from %v", kind, i, out[i], out[i-1])
+			}
+		}
+		if last := out[len(out)-1]; last < 0.95 || last > 1.0+1e-9 {
+			t.Errorf("InterpolateIIR(%d) final value = %v, want close to 1", kind, last)
+		}
+	}
+}
+
+func TestInterpolateIIRConstant(t *testing.T) {
+	in := []float64{2.5, 2.5, 2.5}
+	for _, kind := range []IIRKind{IIROnePole, IIROnePoleOneZero, IIRBiquad} {
+		out, _ := InterpolateIIR(in, 9, kind, 2)
+		for i, v := range out {
+			if math.Abs(v-2.5) > 1e-12 {
+				t.Errorf("InterpolateIIR(%d)[%d] = %v, want 2.5", kind, i, v)
+			}
+		}
+	}
+}
+
+func TestIIRSmootherStreaming(t *testing.T) {
+	in := make([]float64, 300)
+	for i := range in {
+		in[i] = math.Sin(float64(i) * 0.2)
+	}
+	whole, err := NewIIRSmoother(IIRBiquad, 4, 1000, 3000)
+	if err != nil {
+		t.Fatalf("NewIIRSmoother() returned unexpected error: %v", err)
+	}
+	expected := whole.Process(in)
+	if len(expected) != 900 {
+		t.Fatalf("Process() returned %d samples, want 900", len(expected))
+	}
+
+	chunked, _ := NewIIRSmoother(IIRBiquad, 4, 1000, 3000)
+	var out []float64
+	for start := 0; start < len(in); start += 13 {
+		end := start + 13
+		if end > len(in) {
+			end = len(in)
+		}
+		out = append(out, chunked.Process(in[start:end])...)
+	}
+	if len(out) != len(expected) {
+		t.Fatalf("chunked Process() returned %d samples, want %d", len(out), len(expected))
+	}
+	for i := range out {
+		if out[i] != expected[i] {
+			t.Errorf("chunked Process()[%d] = %v, want %v", i, out[i], expected[i])
+			break
+		}
+	}
+
+	chunked.Reset()
+	again := chunked.Process(in)
+	if again[10] != expected[10] {
+		t.Errorf("Process() after Reset()[10] = %v, want %v", again[10], expected[10])
+	}
+}
+
+func TestIIRErrors(t *testing.T) {
+	if _, err := InterpolateIIR([]float64{1}, 4, IIROnePole, 0); err == nil {
+		t.Error("InterpolateIIR() with zero time constant should return an error")
+	}
+	if _, err := InterpolateIIR([]float64{1}, 4, IIRKind(99), 1); err == nil {
+		t.Error("InterpolateIIR() with unknown kind should return an error")
+	}
+	for _, in := range [][]float64{nil, {1, 2}} {
+		if _, err := InterpolateIIR(in, -1, IIROnePole, 1); !errors.Is(err, ErrInvalidOutSamples) {
+			t.Errorf("InterpolateIIR(%d samples, -1) error = %v, want %v", len(in), err, ErrInvalidOutSamples)
+		}
+	}
+	if _, err := NewIIRSmoother(IIROnePole, 1, 0, 1); err == nil {
+		t.Error("NewIIRSmoother() with zero rate should return an error")
+	}
+	if _, err := NewIIRSmoother(IIROnePole, 1, math.NaN(), 1); err == nil {
+		t.Error("NewIIRSmoother() with NaN rate should return an error")
+	}
+}
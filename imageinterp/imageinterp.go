@@ -0,0 +1,124 @@
+// Package imageinterp resizes images with the interpolation kernels of the parent
+// package, e.g. BSpline3, Hermite4 (Catmull-Rom) or Lanczos3.
+package imageinterp
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"math"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+// lineOptions aligns pixel centers, replicates the edge pixels, keeps the kernel
+// weights summing to one and low-pass filters when shrinking
+var lineOptions = interpolators.Options{
+	Boundary:     interpolators.BoundaryClamp,
+	Normalize:    true,
+	PixelCenters: true,
+	AntiAlias:    true,
+}
+
+// Resize returns img scaled to width x height pixels with the given interpolator,
+// applied separably along the rows and then the columns. The channels are
+// resampled with premultiplied alpha, so transparent pixels do not bleed their
+// color into the result, and the output is clamped to [0, 255].
+func Resize(img image.Image, width, height int, interpolatorType interpolators.InterpolatorType) (*image.RGBA, error) {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return nil, errors.New("image is empty")
+	}
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("size must be positive, got %d x %d", width, height)
+	}
+	if interpolatorType == interpolators.None {
+		return nil, errors.New("none cannot resize an image")
+	}
+
+	// Premultiplied channels in [0, 255], indexed [channel][y][x]
+	w, h := bounds.Dx(), bounds.Dy()
+	var planes [4][][]float64
+	for c := range planes {
+		planes[c] = make([][]float64, h)
+		for y := range planes[c] {
+			planes[c][y] = make([]float64, w)
+		}
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, a := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			for c, v := range [4]uint32{r, g, b, a} {
+				planes[c][y][x] = float64(v) / 257
+			}
+		}
+	}
+
+	for c := range planes {
+		resized, err := resizePlane(planes[c], width, height, interpolatorType)
+		if err != nil {
+			return nil, err
+		}
+		planes[c] = resized
+	}
+
+	out := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			a := clamp(planes[3][y][x], 255)
+			i := out.PixOffset(x, y)
+			// Premultiplied color cannot exceed alpha
+			out.Pix[i+0] = uint8(math.Round(clamp(planes[0][y][x], a)))
+			out.Pix[i+1] = uint8(math.Round(clamp(planes[1][y][x], a)))
+			out.Pix[i+2] = uint8(math.Round(clamp(planes[2][y][x], a)))
+			out.Pix[i+3] = uint8(math.Round(a))
+		}
+	}
+	return out, nil
+}
+
+// resizePlane resizes one channel, indexed [y][x], to width x height
+func resizePlane(plane [][]float64, width, height int, interpolatorType interpolators.InterpolatorType) ([][]float64, error) {
+	wide := make([][]float64, len(plane))
+	for y, row := range plane {
+		out, err := resizeLine(row, width, interpolatorType)
+		if err != nil {
+			return nil, err
+		}
+		wide[y] = out
+	}
+
+	out := make([][]float64, height)
+	for y := range out {
+		out[y] = make([]float64, width)
+	}
+	column := make([]float64, len(plane))
+	for x := 0; x < width; x++ {
+		for y := range wide {
+			column[y] = wide[y][x]
+		}
+		resized, err := resizeLine(column, height, interpolatorType)
+		if err != nil {
+			return nil, err
+		}
+		for y, v := range resized {
+			out[y][x] = v
+		}
+	}
+	return out, nil
+}
+
+// resizeLine resizes one row or column. Lines too short for the interpolator's
+// kernel, as in images only a pixel or two across, fall back to Linear.
+func resizeLine(line []float64, outSamples int, interpolatorType interpolators.InterpolatorType) ([]float64, error) {
+	out, err := interpolators.InterpolateWithOptions(line, outSamples, interpolatorType, lineOptions)
+	if errors.Is(err, interpolators.ErrTooFewPoints) {
+		return interpolators.InterpolateWithOptions(line, outSamples, interpolators.Linear, lineOptions)
+	}
+	return out, err
+}
+
+// clamp limits v to [0, hi]
+func clamp(v, hi float64) float64 {
+	return math.Max(0, math.Min(hi, v))
+}
@@ -0,0 +1,96 @@
+package imageinterp
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+func TestResizeUniform(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(10, 20, 17, 25))
+	fill := color.NRGBA{R: 200, G: 100, B: 50, A: 255}
+	for y := src.Rect.Min.Y; y < src.Rect.Max.Y; y++ {
+		for x := src.Rect.Min.X; x < src.Rect.Max.X; x++ {
+			src.Set(x, y, fill)
+		}
+	}
+	for _, typ := range []interpolators.InterpolatorType{interpolators.Linear, interpolators.BSpline3, interpolators.Hermite4, interpolators.Lanczos3, interpolators.CubicSpline} {
+		for _, size := range [][2]int{{20, 13}, {3, 2}, {7, 5}} {
+			out, err := Resize(src, size[0], size[1], typ)
+			if err != nil {
+				t.Fatalf("Resize(%v) returned unexpected error: %v", typ, err)
+			}
+			if b := out.Bounds(); b.Dx() != size[0] || b.Dy() != size[1] {
+				t.Fatalf("Resize(%v) size = %v, want %v", typ, b.Size(), size)
+			}
+			for y := 0; y < size[1]; y++ {
+				for x := 0; x < size[0]; x++ {
+					if got := out.RGBAAt(x, y); got != (color.RGBA{200, 100, 50, 255}) {
+						t.Fatalf("Resize(%v) pixel (%d, %d) = %v, want %v", typ, x, y, got, fill)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestResizeClampsOvershoot(t *testing.T) {
+	// A hard black-white edge rings with Lanczos; the result must stay in range
+	src := image.NewGray(image.Rect(0, 0, 8, 1))
+	for x := 4; x < 8; x++ {
+		src.SetGray(x, 0, color.Gray{255})
+	}
+	out, err := Resize(src, 32, 1, interpolators.Lanczos3)
+	if err != nil {
+		t.Fatalf("Resize() returned unexpected error: %v", err)
+	}
+	if first, last := out.RGBAAt(0, 0), out.RGBAAt(31, 0); first.R != 0 || last.R != 255 {
+		t.Errorf("Resize() ends = %v, %v, want black and white", first, last)
+	}
+}
+
+func TestResizePremultipliedAlpha(t *testing.T) {
+	// Fully transparent red next to opaque blue must not tint the blend red
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 0})
+	src.SetNRGBA(1, 0, color.NRGBA{B: 255, A: 255})
+	out, _ := Resize(src, 1, 1, interpolators.Linear)
+	if px := out.RGBAAt(0, 0); px.R != 0 || px.B != px.A || px.A < 120 || px.A > 135 {
+		t.Errorf("Resize() blend = %v, want half-transparent pure blue", px)
+	}
+}
+
+func TestResizeTinyImage(t *testing.T) {
+	src := image.NewGray(image.Rect(0, 0, 2, 2))
+	src.SetGray(1, 1, color.Gray{255})
+	out, err := Resize(src, 5, 5, interpolators.Lanczos3)
+	if err != nil {
+		t.Fatalf("Resize() of a 2x2 image returned unexpected error: %v", err)
+	}
+	if px := out.RGBAAt(4, 4); px.R != 255 {
+		t.Errorf("Resize() corner = %v, want white", px)
+	}
+}
+
+func TestResizeErrors(t *testing.T) {
+	src := image.NewGray(image.Rect(0, 0, 4, 4))
+	tests := []struct {
+		name          string
+		img           image.Image
+		width, height int
+		typ           interpolators.InterpolatorType
+	}{
+		{"empty", image.NewGray(image.Rect(0, 0, 0, 0)), 2, 2, interpolators.Linear},
+		{"zero width", src, 0, 2, interpolators.Linear},
+		{"none", src, 2, 2, interpolators.None},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Resize(tt.img, tt.width, tt.height, tt.typ); err == nil {
+				t.Error("Resize() should return an error")
+			}
+		})
+	}
+}
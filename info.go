@@ -0,0 +1,104 @@
+package interpolators
+
+import "math"
+
+// InterpolatorInfo describes the properties of an interpolator type
+type InterpolatorInfo struct {
+	Type InterpolatorType
+	Name string
+	// Points is the number of input samples contributing to each output sample, or
+	// 0 when every sample can contribute or the count depends on the ratio
+	Points int
+	// Order is the polynomial degree of each piece, or -1 for interpolators that are
+	// not piecewise polynomials or whose degree depends on the input
+	Order int
+	// Continuity is the highest derivative of the interpolant that is continuous:
+	// -1 for a discontinuous interpolant, 0 for C⁰, 1 for C¹ and so on, or
+	// math.MaxInt for an infinitely differentiable one
+	Continuity int
+	// Interpolating reports whether the output passes through the input samples
+	Interpolating bool
+}
+
+// interpolatorInfo lists the properties of every interpolator type in enum order
+var interpolatorInfo = []InterpolatorInfo{
+	{Type: None, Points: 0, Order: 0, Continuity: -1, Interpolating: true},
+	{Type: DropSample, Points: 1, Order: 0, Continuity: -1, Interpolating: true},
+	{Type: Linear, Points: 2, Order: 1, Continuity: 0, Interpolating: true},
+	{Type: BSpline3, Points: 4, Order: 3, Continuity: 2, Interpolating: false},
+	{Type: BSpline5, Points: 6, Order: 5, Continuity: 4, Interpolating: false},
+	{Type: Lagrange4, Points: 4, Order: 3, Continuity: 0, Interpolating: true},
+	{Type: Lagrange6, Points: 6, Order: 5, Continuity: 0, Interpolating: true},
+	{Type: Watte, Points: 4, Order: 2, Continuity: 0, Interpolating: true},
+	{Type: Parabolic2x, Points: 4, Order: 2, Continuity: 1, Interpolating: false},
+	{Type: Osculating4, Points: 4, Order: 5, Continuity: 2, Interpolating: true},
+	{Type: Osculating6, Points: 6, Order: 5, Continuity: 2, Interpolating: true},
+	{Type: Hermite4, Points: 4, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: Hermite6_3, Points: 6, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: Hermite6_5, Points: 6, Order: 5, Continuity: 1, Interpolating: true},
+	{Type: CubicSpline, Points: 0, Order: 3, Continuity: 2, Interpolating: true},
+	{Type: MonotonicCubic, Points: 4, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: Lanczos2, Points: 4, Order: -1, Continuity: 1, Interpolating: true},
+	{Type: Lanczos3, Points: 6, Order: -1, Continuity: 1, Interpolating: true},
+	{Type: Bezier, Points: 4, Order: 3, Continuity: -1, Interpolating: false},
+	{Type: Akima, Points: 6, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: AreaAverage, Points: 0, Order: 0, Continuity: -1, Interpolating: false},
+	{Type: LTTB, Points: 0, Order: 0, Continuity: -1, Interpolating: true},
+	{Type: PreviousHold, Points: 1, Order: 0, Continuity: -1, Interpolating: true},
+	{Type: NextHold, Points: 1, Order: 0, Continuity: -1, Interpolating: true},
+	{Type: Keys, Points: 4, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: MitchellNetravali, Points: 4, Order: 3, Continuity: 1, Interpolating: false},
+	{Type: OMOMS, Points: 4, Order: 3, Continuity: 0, Interpolating: true},
+	{Type: Gaussian, Points: 6, Order: -1, Continuity: 0, Interpolating: false},
+	{Type: LanczosN, Points: 8, Order: -1, Continuity: 1, Interpolating: true},
+	{Type: KaiserSinc, Points: 16, Order: -1, Continuity: 0, Interpolating: true},
+	{Type: HannSinc, Points: 8, Order: -1, Continuity: 1, Interpolating: true},
+	{Type: HammingSinc, Points: 8, Order: -1, Continuity: 0, Interpolating: true},
+	{Type: BlackmanSinc, Points: 8, Order: -1, Continuity: 1, Interpolating: true},
+	{Type: Sinc, Points: 0, Order: -1, Continuity: math.MaxInt, Interpolating: true},
+	{Type: BSplineN, Points: 4, Order: 3, Continuity: 2, Interpolating: false},
+	{Type: LagrangeN, Points: 8, Order: 7, Continuity: 0, Interpolating: true},
+	{Type: Barycentric, Points: 0, Order: -1, Continuity: math.MaxInt, Interpolating: true},
+	{Type: FloaterHormann, Points: 0, Order: -1, Continuity: math.MaxInt, Interpolating: true},
+	{Type: Cosine, Points: 2, Order: -1, Continuity: 1, Interpolating: true},
+	{Type: Smoothstep, Points: 2, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: Smootherstep, Points: 2, Order: 5, Continuity: 2, Interpolating: true},
+	{Type: TensionSpline, Points: 0, Order: -1, Continuity: 2, Interpolating: true},
+	{Type: Hyman, Points: 0, Order: 3, Continuity: 1, Interpolating: true},
+	{Type: Schumaker, Points: 0, Order: 2, Continuity: 1, Interpolating: true},
+	{Type: Optimal2x4, Points: 4, Order: 4, Continuity: -1, Interpolating: false},
+	{Type: Optimal2x6, Points: 6, Order: 5, Continuity: -1, Interpolating: false},
+	{Type: Optimal4x4, Points: 4, Order: 4, Continuity: -1, Interpolating: false},
+	{Type: Optimal8x4, Points: 4, Order: 4, Continuity: -1, Interpolating: false},
+	{Type: Optimal16x4, Points: 4, Order: 4, Continuity: -1, Interpolating: false},
+	{Type: Optimal32x4, Points: 4, Order: 4, Continuity: -1, Interpolating: false},
+	{Type: Optimal4x2, Points: 2, Order: 3, Continuity: -1, Interpolating: false},
+	{Type: Optimal8x2, Points: 2, Order: 3, Continuity: -1, Interpolating: false},
+	{Type: Optimal16x2, Points: 2, Order: 3, Continuity: -1, Interpolating: false},
+	{Type: Optimal32x2, Points: 2, Order: 3, Continuity: -1, Interpolating: false},
+	{Type: Optimal4x6, Points: 6, Order: 5, Continuity: -1, Interpolating: false},
+	{Type: Optimal8x6, Points: 6, Order: 5, Continuity: -1, Interpolating: false},
+	{Type: Optimal16x6, Points: 6, Order: 5, Continuity: -1, Interpolating: false},
+	{Type: Optimal32x6, Points: 6, Order: 5, Continuity: -1, Interpolating: false},
+}
+
+// All returns the properties of every interpolator type in enum order, for
+// building selection menus and documentation
+func All() []InterpolatorInfo {
+	out := make([]InterpolatorInfo, len(interpolatorInfo))
+	for i, info := range interpolatorInfo {
+		info.Name = info.Type.String()
+		out[i] = info
+	}
+	return out
+}
+
+// infoOf returns the properties of the interpolator type
+func infoOf(t InterpolatorType) (InterpolatorInfo, bool) {
+	for _, info := range interpolatorInfo {
+		if info.Type == t {
+			return info, true
+		}
+	}
+	return InterpolatorInfo{}, false
+}
@@ -0,0 +1,41 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	all := All()
+	if len(all) != len(interpolatorNames) {
+		t.Fatalf("len(All()) = %d, want %d", len(all), len(interpolatorNames))
+	}
+	for i, info := range all {
+		if info.Type != InterpolatorType(i) {
+			t.Errorf("All()[%d].Type = %v, want %v", i, info.Type, InterpolatorType(i))
+		}
+		if info.Name != info.Type.String() {
+			t.Errorf("All()[%d].Name = %q, want %q", i, info.Name, info.Type.String())
+		}
+	}
+}
+
+func TestAllMatchesKernels(t *testing.T) {
+	for _, info := range All() {
+		k, ok := kernelFor(info.Type)
+		if !ok {
+			continue
+		}
+		if info.Points != 1 && info.Points != 2*k.radius {
+			t.Errorf("%v: Points = %d, want %d", info.Type, info.Points, 2*k.radius)
+		}
+		// A kernel interpolates when it is one at zero and zero at the other integers
+		interpolating := math.Abs(k.impulse(0)-1) < 1e-12
+		for j := 1; j <= k.radius; j++ {
+			interpolating = interpolating && math.Abs(k.impulse(float64(j))) < 1e-12
+		}
+		if info.Interpolating != interpolating {
+			t.Errorf("%v: Interpolating = %v, want %v", info.Type, info.Interpolating, interpolating)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Integrate returns the integral of the interpolant of in between the fractional
+// positions a and b, which must lie within the input. Each polynomial piece, split
+// at the samples and at the extra knot Schumaker places between them, is
+// integrated with a Gauss-Legendre rule that is exact for the piecewise-polynomial
+// interpolators, so the result is far more accurate than a trapezoid sum over the
+// samples. The integral is negative when b < a.
+func Integrate(in []float64, a, b float64, interpolatorType InterpolatorType) (float64, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return 0, err
+	}
+	if len(in) == 0 {
+		return 0, fmt.Errorf("%w: got no samples", ErrTooFewPoints)
+	}
+	if err := validate(in, 1, interpolatorType); err != nil {
+		return 0, err
+	}
+	last := float64(len(in) - 1)
+	if !(a >= 0 && a <= last && b >= 0 && b <= last) {
+		return 0, fmt.Errorf("integration bounds must lie within [0, %v], got %v and %v", last, a, b)
+	}
+	return newIntegrator(in, interpolatorType)(a, b), nil
+}
+
+// CumulativeIntegral returns the running integral of the interpolant from the first
+// sample to each output position of Interpolate, the antiderivative resampled onto
+// the output grid. The first value is always zero.
+func CumulativeIntegral(in []float64, outSamples int, interpolatorType InterpolatorType) ([]float64, error) {
+	// validate lets None through unchecked, but None still allocates the output here
+	if err := validateOutSamples(len(in), outSamples); err != nil {
+		return nil, err
+	}
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if err := validate(in, outSamples, interpolatorType); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	integral := newIntegrator(in, interpolatorType)
+	out := make([]float64, outSamples)
+	prev := 0.0
+	for i := 1; i < outSamples; i++ {
+		pos := math.Min(outputPosition(i, len(in), outSamples), float64(len(in)-1))
+		out[i] = out[i-1] + integral(prev, pos)
+		prev = pos
+	}
+	return out, nil
+}
+
+// newIntegrator returns a function integrating the interpolant of in between two
+// positions, with the quadrature applied on each polynomial piece. Schumaker
+// changes polynomial at an extra knot inside every interval as well as at the
+// samples, so its pieces are split there too.
+func newIntegrator(in []float64, interpolatorType InterpolatorType) func(a, b float64) float64 {
+	if interpolatorType == Schumaker && len(in) > 1 {
+		sp := newSchumaker(samplePositions(len(in)), in)
+		breaks := make([]float64, 0, 2*len(in)-1)
+		for i, k := range sp.knot {
+			breaks = append(breaks, float64(i), k)
+		}
+		breaks = append(breaks, float64(len(in)-1))
+		return func(a, b float64) float64 { return integrateXY(sp.at, breaks, a, b) }
+	}
+	f := newEvaluator(in, interpolatorType)
+	return func(a, b float64) float64 { return integrate(f, a, b) }
+}
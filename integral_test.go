@@ -0,0 +1,109 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntegrateCubic(t *testing.T) {
+	// Cubic data is reproduced exactly by the cubic interpolants away from the edges
+	in := make([]float64, 12)
+	for i := range in {
+		x := float64(i)
+		in[i] = x*x*x - 4*x*x + 2
+	}
+	antiderivative := func(x float64) float64 { return x*x*x*x/4 - 4*x*x*x/3 + 2*x }
+
+	tests := []struct {
+		typ  InterpolatorType
+		a, b float64
+	}{
+		{Lagrange4, 3.25, 7.5},
+		{Lagrange6, 2.5, 8.75},
+		{Hermite6_5, 4, 6.3},
+		{Lagrange4, 7.5, 3.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			got, err := Integrate(in, tt.a, tt.b, tt.typ)
+			if err != nil {
+				t.Fatalf("Integrate() returned unexpected error: %v", err)
+			}
+			want := antiderivative(tt.b) - antiderivative(tt.a)
+			if math.Abs(got-want) > 1e-9 {
+				t.Errorf("Integrate(%v, %v) = %v, want %v", tt.a, tt.b, got, want)
+			}
+		})
+	}
+
+	// Linear interpolation integrates to the trapezoid sum
+	got, _ := Integrate([]float64{1, 3, 2, 0}, 0, 3, Linear)
+	if math.Abs(got-5.5) > 1e-12 {
+		t.Errorf("Integrate(Linear) = %v, want 5.5", got)
+	}
+}
+
+func TestIntegrateSchumaker(t *testing.T) {
+	// Schumaker's quadratic pieces also meet at a knot between the samples, which
+	// the quadrature must split at to stay exact. Slopes on both sides of a secant
+	// move the knots off the midpoints.
+	in := []float64{0, 1, 0.5, 3, 2.9, 4}
+	f := newEvaluator(in, Schumaker)
+	const steps = 200000
+	a, b := 1.2, 3.7
+	want := 0.0
+	for i := 0; i < steps; i++ {
+		want += f(a + (b-a)*(float64(i)+0.5)/steps)
+	}
+	want *= (b - a) / steps
+	got, err := Integrate(in, a, b, Schumaker)
+	if err != nil {
+		t.Fatalf("Integrate() returned unexpected error: %v", err)
+	}
+	if math.Abs(got-want) > 1e-8 {
+		t.Errorf("Integrate(Schumaker) = %v, want %v", got, want)
+	}
+	cumulative, _ := CumulativeIntegral(in, 11, Schumaker)
+	whole, _ := Integrate(in, 0, 5, Schumaker)
+	if math.Abs(cumulative[10]-whole) > 1e-12 {
+		t.Errorf("CumulativeIntegral(Schumaker) ends at %v, want %v", cumulative[10], whole)
+	}
+}
+
+func TestIntegrateErrors(t *testing.T) {
+	if _, err := Integrate(nil, 0, 0, Linear); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("Integrate() of no samples error = %v, want ErrTooFewPoints", err)
+	}
+	for _, bounds := range [][2]float64{{-1, 2}, {0, 4}, {math.NaN(), 1}} {
+		if _, err := Integrate([]float64{1, 2, 3, 4}, bounds[0], bounds[1], Linear); err == nil {
+			t.Errorf("Integrate(%v, %v) should return an error", bounds[0], bounds[1])
+		}
+	}
+}
+
+func TestCumulativeIntegral(t *testing.T) {
+	in := []float64{0, 1, 2, 3, 4}
+	got, err := CumulativeIntegral(in, 9, Linear)
+	if err != nil {
+		t.Fatalf("CumulativeIntegral() returned unexpected error: %v", err)
+	}
+	for i, v := range got {
+		x := float64(i) / 2
+		if want := x * x / 2; math.Abs(v-want) > 1e-12 {
+			t.Errorf("CumulativeIntegral()[%d] = %v, want %v", i, v, want)
+		}
+	}
+
+	in = []float64{2, -1, 3, 0.5, 4, 1, 2}
+	cum, _ := CumulativeIntegral(in, 25, CubicSpline)
+	total, _ := Integrate(in, 0, 6, CubicSpline)
+	if math.Abs(cum[24]-total) > 1e-12 {
+		t.Errorf("CumulativeIntegral() final value = %v, want %v", cum[24], total)
+	}
+	for _, info := range All() {
+		if _, err := CumulativeIntegral(in, -1, info.Type); !errors.Is(err, ErrInvalidOutSamples) {
+			t.Errorf("CumulativeIntegral(%v, outSamples=-1) error = %v, want %v", info.Type, err, ErrInvalidOutSamples)
+		}
+	}
+}
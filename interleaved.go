@@ -0,0 +1,70 @@
+package interpolators
+
+import "fmt"
+
+// InterpolateInterleaved resamples interleaved multi-channel data, such as stereo
+// PCM stored as left, right, left, right, to outFrames frames of the same layout.
+// Each channel is resampled as Interpolate would resample it on its own; the
+// convolution-based interpolators read the channels in place without
+// deinterleaving copies. A trailing partial frame is ignored, as in Buffer.
+func InterpolateInterleaved(in []float64, channels, outFrames int, interpolatorType InterpolatorType) ([]float64, error) {
+	if channels <= 0 {
+		return nil, fmt.Errorf("channel count must be positive, got %d", channels)
+	}
+	return interpolateStrided(in, len(in)/channels, 0, channels, channels, outFrames, interpolatorType)
+}
+
+// InterpolateStrided resamples the samples in[offset], in[offset+stride],
+// in[offset+2*stride] and so on to outSamples like Interpolate, without copying
+// them out first for the convolution-based interpolators. It suits one field of
+// an array of structures, or one channel of an interleaved buffer.
+func InterpolateStrided(in []float64, offset, stride, outSamples int, interpolatorType InterpolatorType) ([]float64, error) {
+	if stride <= 0 || offset < 0 {
+		return nil, fmt.Errorf("stride must be positive and offset non-negative, got stride %d and offset %d", stride, offset)
+	}
+	n := 0
+	if offset < len(in) {
+		n = (len(in)-offset-1)/stride + 1
+	}
+	return interpolateStrided(in, n, offset, stride, 1, outSamples, interpolatorType)
+}
+
+// interpolateStrided resamples n frames of channels signals, where sample j of
+// channel c is in[offset+c+j*stride], into interleaved output
+func interpolateStrided(in []float64, n, offset, stride, channels, outSamples int, interpolatorType InterpolatorType) ([]float64, error) {
+	if err := validateLength(n, outSamples, interpolatorType); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	if k, ok := kernelFor(interpolatorType); ok && n > 1 {
+		table := newTapTable(k, n, outSamples)
+		out := make([]float64, outSamples*channels)
+		for c := 0; c < channels; c++ {
+			table.applyStrided(in, offset+c, stride, out, c, channels)
+		}
+		return out, nil
+	}
+
+	// The other interpolators fit the whole signal, so gather each channel
+	var out []float64
+	column := make([]float64, n)
+	for c := 0; c < channels; c++ {
+		for j := range column {
+			column[j] = in[offset+c+j*stride]
+		}
+		resampled, err := Interpolate(column, outSamples, interpolatorType)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			out = make([]float64, len(resampled)*channels)
+		}
+		for i, v := range resampled {
+			out[i*channels+c] = v
+		}
+	}
+	return out, nil
+}
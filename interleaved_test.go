@@ -0,0 +1,86 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterpolateInterleaved(t *testing.T) {
+	left, right := make([]float64, 40), make([]float64, 40)
+	for i := range left {
+		left[i] = float64(i * i % 17)
+		right[i] = math.Sin(float64(i) * 0.7)
+	}
+	// A trailing partial frame is ignored
+	in := make([]float64, 0, 2*len(left)+1)
+	for i := range left {
+		in = append(in, left[i], right[i])
+	}
+	in = append(in, 99)
+	for _, info := range All() {
+		typ := info.Type
+		got, err := InterpolateInterleaved(in, 2, 57, typ)
+		if err != nil {
+			t.Fatalf("InterpolateInterleaved(%v) returned unexpected error: %v", typ, err)
+		}
+		for c, ch := range [][]float64{left, right} {
+			want, _ := Interpolate(ch, 57, typ)
+			if len(got) != 2*len(want) {
+				t.Fatalf("InterpolateInterleaved(%v) has %d samples, want %d", typ, len(got), 2*len(want))
+			}
+			for i := range want {
+				if math.Abs(got[2*i+c]-want[i]) > 1e-9 {
+					t.Errorf("%v channel %d [%d] = %v, want %v", typ, c, i, got[2*i+c], want[i])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestInterpolateStrided(t *testing.T) {
+	// The y field of {x, y, z} records
+	records := []float64{0, 1, 7, 1, 3, 7, 2, 2, 7, 3, 8, 7, 4, 5, 7}
+	y := []float64{1, 3, 2, 8, 5}
+	for _, typ := range []InterpolatorType{Linear, Lanczos3, CubicSpline, Akima, None} {
+		got, err := InterpolateStrided(records, 1, 3, 9, typ)
+		if err != nil {
+			t.Fatalf("InterpolateStrided(%v) returned unexpected error: %v", typ, err)
+		}
+		want, _ := Interpolate(y, 9, typ)
+		if len(got) != len(want) {
+			t.Fatalf("InterpolateStrided(%v) has %d samples, want %d", typ, len(got), len(want))
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-9 {
+				t.Errorf("InterpolateStrided(%v)[%d] = %v, want %v", typ, i, got[i], want[i])
+			}
+		}
+	}
+	// The last record may be cut short after the field
+	if got, _ := InterpolateStrided(records[:14], 1, 3, 5, Linear); len(got) != 5 || got[4] != 5 {
+		t.Errorf("InterpolateStrided of a short last record = %v, want 5 samples ending at 5", got)
+	}
+}
+
+func TestInterpolateInterleavedErrors(t *testing.T) {
+	if _, err := InterpolateInterleaved([]float64{1, 2}, 0, 4, Linear); err == nil {
+		t.Error("InterpolateInterleaved with 0 channels returned nil error")
+	}
+	if _, err := InterpolateStrided([]float64{1, 2}, 0, 0, 4, Linear); err == nil {
+		t.Error("InterpolateStrided with stride 0 returned nil error")
+	}
+	if _, err := InterpolateStrided([]float64{1, 2}, -1, 1, 4, Linear); err == nil {
+		t.Error("InterpolateStrided with a negative offset returned nil error")
+	}
+	if _, err := InterpolateInterleaved([]float64{1, 2, 3, 4}, 2, 0, Linear); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateInterleaved(outFrames=0) returned %v, want ErrInvalidOutSamples", err)
+	}
+	if _, err := InterpolateInterleaved([]float64{1, 2, 3, 4}, 2, 5, Lanczos3); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateInterleaved(Lanczos3, 2 frames) returned %v, want ErrTooFewPoints", err)
+	}
+	if got, err := InterpolateStrided([]float64{1, 2}, 5, 1, 4, Linear); err != nil || len(got) != 0 {
+		t.Errorf("InterpolateStrided past the end = %v, %v, want empty output", got, err)
+	}
+}
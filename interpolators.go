@@ -46,6 +46,82 @@ const (
 	Bezier
 	// Akima is the Akima spline interpolator (robust to outliers)
 	Akima
+	// AreaAverage averages the input samples falling into each output bin (box downsampling)
+	AreaAverage
+	// LTTB downsamples with Largest-Triangle-Three-Buckets, keeping the samples that preserve visual shape
+	LTTB
+	// PreviousHold repeats the sample at or before each position (forward fill)
+	PreviousHold
+	// NextHold repeats the sample at or after each position (backward fill)
+	NextHold
+	// Keys is the Keys cubic convolution kernel with parameter a (KernelParams.A, default -0.5 for Catmull-Rom)
+	Keys
+	// MitchellNetravali is the Mitchell-Netravali BC-spline kernel (KernelParams.B and C, default 1/3 each)
+	MitchellNetravali
+	// OMOMS is the prefiltered cubic O-MOMS interpolator (4-point, fourth-order approximation)
+	OMOMS
+	// Gaussian is a truncated Gaussian smoothing kernel (KernelParams.Sigma, default 1, and Radius, default 3σ)
+	Gaussian
+	// LanczosN is the Lanczos windowed sinc with support ±a for any a (KernelParams.Radius, default 4)
+	LanczosN
+	// KaiserSinc is the Kaiser-windowed sinc (KernelParams.Radius, default 8 for 16 taps, and Beta, default 8.6)
+	KaiserSinc
+	// HannSinc is the Hann-windowed sinc with support ±a (KernelParams.Radius, default 4)
+	HannSinc
+	// HammingSinc is the Hamming-windowed sinc with support ±a (KernelParams.Radius, default 4)
+	HammingSinc
+	// BlackmanSinc is the Blackman-windowed sinc with support ±a (KernelParams.Radius, default 4)
+	BlackmanSinc
+	// Sinc is the exact Whittaker-Shannon sinc interpolation using every input sample (O(N·M) reference)
+	Sinc
+	// BSplineN is the B-spline of any degree by the Cox-de Boor recursion (KernelParams.Degree, default 3)
+	BSplineN
+	// LagrangeN is the Lagrange kernel with any even number of points (2*KernelParams.Radius, default 8)
+	LagrangeN
+	// Barycentric is the single global polynomial through all samples, in the stable barycentric form
+	Barycentric
+	// FloaterHormann is the Floater-Hormann barycentric rational interpolant (KernelParams.Degree d, default 3)
+	FloaterHormann
+	// Cosine is the 2-point half-cosine ease between neighbouring samples
+	Cosine
+	// Smoothstep is the 2-point smoothstep ease 3t²-2t³ between neighbouring samples
+	Smoothstep
+	// Smootherstep is the 2-point smootherstep ease 6t⁵-15t⁴+10t³ between neighbouring samples
+	Smootherstep
+	// TensionSpline is the exponential spline under tension (KernelParams.Tension, default 0 for the natural cubic spline)
+	TensionSpline
+	// Hyman is the natural cubic spline with Hyman's monotonicity filter on its slopes (C¹)
+	Hyman
+	// Schumaker is Schumaker's shape-preserving quadratic spline, which keeps the monotonicity and convexity of the data (C¹)
+	Schumaker
+	// Optimal2x4 is Niemitalo's 4-point, 4th-order optimal interpolator for 2x oversampled input
+	Optimal2x4
+	// Optimal2x6 is Niemitalo's 6-point, 5th-order optimal interpolator for 2x oversampled input
+	Optimal2x6
+	// Optimal4x4 is Niemitalo's 4-point, 4th-order optimal interpolator for 4x oversampled input
+	Optimal4x4
+	// Optimal8x4 is Niemitalo's 4-point, 4th-order optimal interpolator for 8x oversampled input
+	Optimal8x4
+	// Optimal16x4 is Niemitalo's 4-point, 4th-order optimal interpolator for 16x oversampled input
+	Optimal16x4
+	// Optimal32x4 is Niemitalo's 4-point, 4th-order optimal interpolator for 32x oversampled input
+	Optimal32x4
+	// Optimal4x2 is Niemitalo's 2-point, 3rd-order optimal interpolator for 4x oversampled input
+	Optimal4x2
+	// Optimal8x2 is Niemitalo's 2-point, 3rd-order optimal interpolator for 8x oversampled input
+	Optimal8x2
+	// Optimal16x2 is Niemitalo's 2-point, 3rd-order optimal interpolator for 16x oversampled input
+	Optimal16x2
+	// Optimal32x2 is Niemitalo's 2-point, 3rd-order optimal interpolator for 32x oversampled input
+	Optimal32x2
+	// Optimal4x6 is Niemitalo's 6-point, 5th-order optimal interpolator for 4x oversampled input
+	Optimal4x6
+	// Optimal8x6 is Niemitalo's 6-point, 5th-order optimal interpolator for 8x oversampled input
+	Optimal8x6
+	// Optimal16x6 is Niemitalo's 6-point, 5th-order optimal interpolator for 16x oversampled input
+	Optimal16x6
+	// Optimal32x6 is Niemitalo's 6-point, 5th-order optimal interpolator for 32x oversampled input
+	Optimal32x6
 )
 
 // dropSampleImpulse implements the drop-sample (0th-order B-spline) impulse response
@@ -624,7 +700,7 @@ func bspline3Interpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 4 nearby samples (support is ±2)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 4 samples: centerIdx-1, centerIdx, centerIdx+1, centerIdx+2
@@ -691,7 +767,7 @@ func bspline5Interpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 6 nearby samples (support is ±3)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 6 samples: centerIdx-2 to centerIdx+3
@@ -767,7 +843,7 @@ func lagrange4Interpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 4 nearby samples (support is ±2)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 4 samples: centerIdx-1, centerIdx, centerIdx+1, centerIdx+2
@@ -834,7 +910,7 @@ func lagrange6Interpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 6 nearby samples (support is ±3)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 6 samples: centerIdx-2 to centerIdx+3
@@ -911,7 +987,7 @@ func watteInterpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 4 nearby samples (support is ±2)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 4 samples: centerIdx-1, centerIdx, centerIdx+1, centerIdx+2
@@ -975,7 +1051,7 @@ func parabolic2xInterpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 4 nearby samples (support is ±2)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 4 samples: centerIdx-1, centerIdx, centerIdx+1, centerIdx+2
@@ -1039,7 +1115,7 @@ func osculating4Interpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 4 nearby samples (support is ±2)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 4 samples: centerIdx-1, centerIdx, centerIdx+1, centerIdx+2
@@ -1109,7 +1185,7 @@ func osculating6Interpolate(in []float64, outSamples int) []float64 {
 		pos := float64(i) * ratio
 
 		// Get the 6 nearby samples (support is ±3)
-		centerIdx := int(pos + 0.5) // Round to nearest
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 		sum := 0.0
 
 		// Check 6 samples: centerIdx-2 to centerIdx+3
@@ -1174,7 +1250,7 @@ func hermite4Interpolate(in []float64, outSamples int) []float64 {
 
 	for i := range out {
 		pos := float64(i) * ratio
-		centerIdx := int(math.Round(pos))
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 
 		var sum float64
 		// Check 4 samples: centerIdx-1 to centerIdx+2 (support ±2)
@@ -1230,7 +1306,7 @@ func hermite6_3Interpolate(in []float64, outSamples int) []float64 {
 
 	for i := range out {
 		pos := float64(i) * ratio
-		centerIdx := int(math.Round(pos))
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 
 		var sum float64
 		// Check 6 samples: centerIdx-2 to centerIdx+3 (support ±3)
@@ -1290,7 +1366,7 @@ func hermite6_5Interpolate(in []float64, outSamples int) []float64 {
 
 	for i := range out {
 		pos := float64(i) * ratio
-		centerIdx := int(math.Round(pos))
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 
 		var sum float64
 		// Check 6 samples: centerIdx-2 to centerIdx+3 (support ±3)
@@ -1356,7 +1432,7 @@ func lanczos2Interpolate(in []float64, outSamples int) []float64 {
 
 	for i := range out {
 		pos := float64(i) * ratio
-		centerIdx := int(math.Round(pos))
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 
 		var sum float64
 		// Check 4 samples: centerIdx-1 to centerIdx+2 (support ±2)
@@ -1410,7 +1486,7 @@ func lanczos3Interpolate(in []float64, outSamples int) []float64 {
 
 	for i := range out {
 		pos := float64(i) * ratio
-		centerIdx := int(math.Round(pos))
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 
 		var sum float64
 		// Check 6 samples: centerIdx-2 to centerIdx+3 (support ±3)
@@ -1464,7 +1540,7 @@ func bezierInterpolate(in []float64, outSamples int) []float64 {
 
 	for i := range out {
 		pos := float64(i) * ratio
-		centerIdx := int(math.Round(pos))
+		centerIdx := int(pos) // Sample at or before pos, so the window covers the full support
 
 		var sum float64
 		// Check 4 samples: centerIdx-1 to centerIdx+2 (support ±2)
@@ -1503,8 +1579,19 @@ func bezierInterpolate(in []float64, outSamples int) []float64 {
 	return out
 }
 
-// Interpolate performs interpolation on the input data based on the specified type
+// Interpolate performs interpolation on the input data based on the specified type.
+// It returns ErrInvalidOutSamples if outSamples is not positive and ErrTooFewPoints
+// if the input is too short for the interpolator; a single sample is a constant.
 func Interpolate(in []float64, outSamples int, interpolatorType InterpolatorType) (out []float64, err error) {
+	if err := validate(in, outSamples, interpolatorType); err != nil {
+		return nil, err
+	}
+	if len(in) == 1 && interpolatorType != None {
+		// A single sample is a constant signal, which the kernels would otherwise
+		// attenuate through their edge handling
+		return constant(in[0], outSamples), nil
+	}
+
 	switch interpolatorType {
 	case None:
 		// None type returns input exactly as it was
@@ -1549,6 +1636,45 @@ func Interpolate(in []float64, outSamples int, interpolatorType InterpolatorType
 		return bezierInterpolate(in, outSamples), nil
 	case Akima:
 		return applyAkimaSpline(in, outSamples), nil
+	case AreaAverage:
+		return areaAverageInterpolate(in, outSamples), nil
+	case LTTB:
+		return lttbInterpolate(in, outSamples), nil
+	case PreviousHold, NextHold:
+		return holdInterpolate(in, outSamples, interpolatorType), nil
+	case Keys, MitchellNetravali, Gaussian, LanczosN, KaiserSinc, HannSinc, HammingSinc, BlackmanSinc, BSplineN, LagrangeN, Cosine, Smoothstep, Smootherstep, Optimal2x4, Optimal2x6, Optimal4x4, Optimal8x4, Optimal16x4, Optimal32x4, Optimal4x2, Optimal8x2, Optimal16x2, Optimal32x2, Optimal4x6, Optimal8x6, Optimal16x6, Optimal32x6:
+		k, _ := kernelFor(interpolatorType)
+		return kernelInterpolate(in, outSamples, k), nil
+	case OMOMS:
+		return kernelInterpolate(omomsCoefficients(in), outSamples, omomsKernel), nil
+	case Sinc:
+		return applyInterpolation(in, outSamples, sincImpulse), nil
+	case Barycentric:
+		return barycentricInterpolate(newBarycentric(in), outSamples), nil
+	case FloaterHormann:
+		return barycentricInterpolate(newFloaterHormann(samplePositions(len(in)), in, 0), outSamples), nil
+	case Hyman:
+		return hymanInterpolate(in, outSamples), nil
+	case Schumaker:
+		if len(in) == 0 {
+			return []float64{}, nil
+		}
+		s := newSchumaker(samplePositions(len(in)), in)
+		out = make([]float64, outSamples)
+		for i := range out {
+			out[i] = s.at(outputPosition(i, len(in), outSamples))
+		}
+		return out, nil
+	case TensionSpline:
+		if len(in) == 0 {
+			return []float64{}, nil
+		}
+		s := newTensionSpline(samplePositions(len(in)), in, 0)
+		out = make([]float64, outSamples)
+		for i := range out {
+			out[i] = s.at(outputPosition(i, len(in), outSamples))
+		}
+		return out, nil
 	default:
 		out = make([]float64, len(in))
 		copy(out, in)
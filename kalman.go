@@ -0,0 +1,193 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// KalmanModel selects the state-space motion model of the Kalman smoother
+type KalmanModel int
+
+const (
+	// ConstantVelocity models the signal as moving with a randomly drifting slope
+	ConstantVelocity KalmanModel = iota
+	// ConstantAcceleration models the signal as moving with a randomly drifting
+	// second derivative
+	ConstantAcceleration
+)
+
+// kalmanPriorVariance is the variance of the diffuse prior on the initial state
+const kalmanPriorVariance = 1e6
+
+// KalmanOptions configures KalmanSmooth. Zero noise values select 1.
+type KalmanOptions struct {
+	Model KalmanModel
+	// ProcessNoise is the spectral density of the white noise driving the highest
+	// derivative of the model; larger values let the estimate follow the data
+	// more closely
+	ProcessNoise float64
+	// MeasurementNoise is the variance of the noise on each measurement
+	MeasurementNoise float64
+}
+
+// KalmanSmooth estimates a noisy series y measured at the strictly increasing
+// times t on an arbitrary grid of times, using a Kalman filter followed by a
+// Rauch-Tung-Striebel smoother. Unlike the interpolators it does not pass through
+// the measurements; it returns the smoothed estimate and its variance at each grid
+// time, in grid order.
+func KalmanSmooth(t, y, grid []float64, opts KalmanOptions) (mean, variance []float64, err error) {
+	if err := checkXY(t, y); err != nil {
+		return nil, nil, err
+	}
+	if len(y) == 0 {
+		return nil, nil, errors.New("at least one measurement is required")
+	}
+	q := opts.ProcessNoise
+	if q == 0 {
+		q = 1
+	}
+	r := opts.MeasurementNoise
+	if r == 0 {
+		r = 1
+	}
+	if q < 0 || r < 0 {
+		return nil, nil, fmt.Errorf("noise parameters must be positive, got %v and %v", q, r)
+	}
+	dim := 2
+	switch opts.Model {
+	case ConstantVelocity:
+	case ConstantAcceleration:
+		dim = 3
+	default:
+		return nil, nil, fmt.Errorf("unknown Kalman model %d", opts.Model)
+	}
+
+	// Merge measurements and grid points into one time-ordered event list;
+	// measurements come first at equal times so grid points see their update
+	type event struct {
+		time  float64
+		meas  int // index into y, or -1
+		query int // index into grid, or -1
+	}
+	events := make([]event, 0, len(t)+len(grid))
+	for i := range t {
+		events = append(events, event{time: t[i], meas: i, query: -1})
+	}
+	for i := range grid {
+		events = append(events, event{time: grid[i], meas: -1, query: i})
+	}
+	sort.SliceStable(events, func(a, b int) bool {
+		if events[a].time != events[b].time {
+			return events[a].time < events[b].time
+		}
+		return events[a].meas >= 0 && events[b].meas < 0
+	})
+
+	// Forward filter, keeping everything the backward pass needs
+	n := len(events)
+	xPred := make([][]float64, n)
+	pPred := make([][][]float64, n)
+	xFilt := make([][]float64, n)
+	pFilt := make([][][]float64, n)
+	trans := make([][][]float64, n)
+
+	x := make([]float64, dim)
+	x[0] = y[0]
+	p := identity(dim)
+	for i := range p {
+		p[i][i] = kalmanPriorVariance
+	}
+	prevTime := events[0].time
+	for k, ev := range events {
+		f, qm := kalmanTransition(opts.Model, ev.time-prevTime, q)
+		prevTime = ev.time
+		x = matVec(f, x)
+		p = matAdd(matMul(matMul(f, p), transpose(f)), qm, 1)
+		trans[k], xPred[k], pPred[k] = f, x, p
+
+		if ev.meas >= 0 {
+			// Scalar measurement of the first state component
+			s := p[0][0] + r
+			gain := make([]float64, dim)
+			for i := range gain {
+				gain[i] = p[i][0] / s
+			}
+			innovation := y[ev.meas] - x[0]
+			updated := make([]float64, dim)
+			for i := range updated {
+				updated[i] = x[i] + gain[i]*innovation
+			}
+			pu := newMatrix(dim, dim)
+			for i := range pu {
+				for j := range pu[i] {
+					pu[i][j] = p[i][j] - gain[i]*p[0][j]
+				}
+			}
+			x, p = updated, pu
+		}
+		xFilt[k], pFilt[k] = x, p
+	}
+
+	// Rauch-Tung-Striebel backward pass
+	xs, ps := xFilt[n-1], pFilt[n-1]
+	mean = make([]float64, len(grid))
+	variance = make([]float64, len(grid))
+	for k := n - 1; k >= 0; k-- {
+		if k < n-1 {
+			inv, err := invert(pPred[k+1])
+			if err != nil {
+				return nil, nil, err
+			}
+			c := matMul(matMul(pFilt[k], transpose(trans[k+1])), inv)
+			dx := make([]float64, dim)
+			for i := range dx {
+				dx[i] = xs[i] - xPred[k+1][i]
+			}
+			corr := matVec(c, dx)
+			next := make([]float64, dim)
+			for i := range next {
+				next[i] = xFilt[k][i] + corr[i]
+			}
+			xs = next
+			ps = matAdd(pFilt[k], matMul(matMul(c, matAdd(ps, pPred[k+1], -1)), transpose(c)), 1)
+		}
+		if gi := events[k].query; gi >= 0 {
+			mean[gi] = xs[0]
+			variance[gi] = math.Max(0, ps[0][0])
+		}
+	}
+	return mean, variance, nil
+}
+
+// kalmanTransition returns the state transition and process noise covariance of the
+// model over a time step dt for white noise of spectral density q
+func kalmanTransition(model KalmanModel, dt, q float64) (f, qm [][]float64) {
+	dt2 := dt * dt
+	dt3 := dt2 * dt
+	if model == ConstantAcceleration {
+		dt4 := dt3 * dt
+		dt5 := dt4 * dt
+		f = [][]float64{
+			{1, dt, dt2 / 2},
+			{0, 1, dt},
+			{0, 0, 1},
+		}
+		qm = [][]float64{
+			{q * dt5 / 20, q * dt4 / 8, q * dt3 / 6},
+			{q * dt4 / 8, q * dt3 / 3, q * dt2 / 2},
+			{q * dt3 / 6, q * dt2 / 2, q * dt},
+		}
+		return f, qm
+	}
+	f = [][]float64{
+		{1, dt},
+		{0, 1},
+	}
+	qm = [][]float64{
+		{q * dt3 / 3, q * dt2 / 2},
+		{q * dt2 / 2, q * dt},
+	}
+	return f, qm
+}
@@ -0,0 +1,79 @@
+package interpolators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestKalmanSmoothNoisyLine(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	n := 200
+	times := make([]float64, n)
+	values := make([]float64, n)
+	for i := range times {
+		times[i] = float64(i) * 0.1
+		values[i] = 2*times[i] + 1 + rng.NormFloat64()*0.5
+	}
+	grid := []float64{1.05, 5.55, 10.0, 15.25}
+
+	for _, model := range []KalmanModel{ConstantVelocity, ConstantAcceleration} {
+		mean, variance, err := KalmanSmooth(times, values, grid, KalmanOptions{
+			Model:            model,
+			ProcessNoise:     0.01,
+			MeasurementNoise: 0.25,
+		})
+		if err != nil {
+			t.Fatalf("KalmanSmooth() returned unexpected error: %v", err)
+		}
+		for i, g := range grid {
+			want := 2*g + 1
+			if math.Abs(mean[i]-want) > 0.3 {
+				t.Errorf("KalmanSmooth(%d) mean at %v = %v, want %v", model, g, mean[i], want)
+			}
+			// Averaging many measurements must beat the single-measurement variance
+			if variance[i] <= 0 || variance[i] >= 0.25 {
+				t.Errorf("KalmanSmooth(%d) variance at %v = %v, want in (0, 0.25)", model, g, variance[i])
+			}
+		}
+	}
+}
+
+func TestKalmanSmoothUncertaintyGrowsInGaps(t *testing.T) {
+	times := []float64{0, 1, 2, 3, 10, 11, 12}
+	values := []float64{0, 1, 2, 3, 10, 11, 12}
+	_, variance, err := KalmanSmooth(times, values, []float64{2, 6.5}, KalmanOptions{MeasurementNoise: 0.1})
+	if err != nil {
+		t.Fatalf("KalmanSmooth() returned unexpected error: %v", err)
+	}
+	if variance[1] <= variance[0] {
+		t.Errorf("KalmanSmooth() variance in gap = %v, want above %v at a measurement", variance[1], variance[0])
+	}
+}
+
+func TestKalmanSmoothGridOrder(t *testing.T) {
+	times := []float64{0, 1, 2, 3}
+	values := []float64{0, 10, 20, 30}
+	mean, _, err := KalmanSmooth(times, values, []float64{3, 0}, KalmanOptions{MeasurementNoise: 1e-6})
+	if err != nil {
+		t.Fatalf("KalmanSmooth() returned unexpected error: %v", err)
+	}
+	if math.Abs(mean[0]-30) > 0.01 || math.Abs(mean[1]) > 0.01 {
+		t.Errorf("KalmanSmooth() = %v, want [30 0] in grid order", mean)
+	}
+}
+
+func TestKalmanSmoothErrors(t *testing.T) {
+	if _, _, err := KalmanSmooth(nil, nil, []float64{1}, KalmanOptions{}); err == nil {
+		t.Error("KalmanSmooth() without measurements should return an error")
+	}
+	if _, _, err := KalmanSmooth([]float64{1, 0}, []float64{1, 2}, nil, KalmanOptions{}); err == nil {
+		t.Error("KalmanSmooth() with unsorted times should return an error")
+	}
+	if _, _, err := KalmanSmooth([]float64{0}, []float64{1}, nil, KalmanOptions{ProcessNoise: -1}); err == nil {
+		t.Error("KalmanSmooth() with negative noise should return an error")
+	}
+	if _, _, err := KalmanSmooth([]float64{0}, []float64{1}, nil, KalmanOptions{Model: KalmanModel(7)}); err == nil {
+		t.Error("KalmanSmooth() with unknown model should return an error")
+	}
+}
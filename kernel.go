@@ -0,0 +1,228 @@
+package interpolators
+
+import "math"
+
+// kernel is a finite-support impulse response together with the edge handling
+// used by its optimized implementation in Interpolate
+type kernel struct {
+	impulse func(float64) float64
+	// radius is the half-width of the support; the taps for position pos are
+	// floor(pos)-radius+1 through floor(pos)+radius
+	radius int
+	// boundary is the handling of out-of-range taps used by the optimized implementation
+	boundary Boundary
+	// normalize divides by the sum of the weights of the taps actually used
+	normalize bool
+	// roundWindow centers the taps on the nearest sample instead of floor(pos), as
+	// Revision1 did
+	roundWindow bool
+	// derivative, if set, returns the first or second derivative of impulse for
+	// kernels that are not polynomials between the integers
+	derivative func(x float64, order int) float64
+}
+
+// nearestImpulse selects the nearest sample, rounding halfway positions up,
+// which matches the drop-sample interpolator
+func nearestImpulse(x float64) float64 {
+	if x >= -0.5 && x < 0.5 {
+		return 1.0
+	}
+	return 0.0
+}
+
+// lanczosImpulse returns the Lanczos windowed sinc impulse response with support ±a
+func lanczosImpulse(a int) func(float64) float64 {
+	fa := float64(a)
+	return func(x float64) float64 {
+		absX := math.Abs(x)
+		if absX < 1e-10 {
+			return 1.0
+		}
+		if absX >= fa {
+			return 0.0
+		}
+		// sinc(x) * sinc(x/a)
+		piX := math.Pi * absX
+		return (math.Sin(piX) / piX) * (math.Sin(piX/fa) / (piX / fa))
+	}
+}
+
+// kaiserSincImpulse returns a sinc with cutoff at the fraction cutoff of the
+// Nyquist frequency, scaled to unit DC gain and tapered to support ±a by a Kaiser
+// window with shape beta
+func kaiserSincImpulse(a int, cutoff, beta float64) func(float64) float64 {
+	fa := float64(a)
+	norm := besselI0(beta)
+	return func(x float64) float64 {
+		absX := math.Abs(x)
+		if absX >= fa {
+			return 0.0
+		}
+		r := absX / fa
+		window := besselI0(beta*math.Sqrt(1-r*r)) / norm
+		if absX < 1e-10 {
+			return cutoff * window
+		}
+		piX := math.Pi * cutoff * absX
+		return cutoff * math.Sin(piX) / piX * window
+	}
+}
+
+// besselI0 is the zeroth-order modified Bessel function of the first kind, summed
+// from its power series
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	half := x / 2
+	for k := 1; k < 500; k++ {
+		term *= half / float64(k)
+		t2 := term * term
+		sum += t2
+		if t2 < sum*1e-17 {
+			break
+		}
+	}
+	return sum
+}
+
+// kernelFor returns the kernel for the convolution-based interpolator types
+func kernelFor(t InterpolatorType) (kernel, bool) {
+	switch t {
+	case DropSample:
+		return kernel{impulse: nearestImpulse, radius: 1, boundary: BoundaryClamp}, true
+	case Linear:
+		return kernel{impulse: linearImpulse, radius: 1, boundary: BoundaryClamp}, true
+	case BSpline3:
+		return kernel{impulse: bspline3Impulse, radius: 2, boundary: BoundaryZero}, true
+	case BSpline5:
+		return kernel{impulse: bspline5Impulse, radius: 3, boundary: BoundaryZero}, true
+	case Lagrange4:
+		return kernel{impulse: lagrange4Impulse, radius: 2, boundary: BoundaryZero}, true
+	case Lagrange6:
+		return kernel{impulse: lagrange6Impulse, radius: 3, boundary: BoundaryZero}, true
+	case Watte:
+		return kernel{impulse: watteImpulse, radius: 2, boundary: BoundaryZero}, true
+	case Parabolic2x:
+		return kernel{impulse: parabolic2xImpulse, radius: 2, boundary: BoundaryZero}, true
+	case Osculating4:
+		return kernel{impulse: osculating4Impulse, radius: 2, boundary: BoundaryZero}, true
+	case Osculating6:
+		return kernel{impulse: osculating6Impulse, radius: 3, boundary: BoundaryZero}, true
+	case Hermite4:
+		return kernel{impulse: hermite4Impulse, radius: 2, boundary: BoundaryClamp}, true
+	case Hermite6_3:
+		return kernel{impulse: hermite6_3Impulse, radius: 3, boundary: BoundaryClamp}, true
+	case Hermite6_5:
+		return kernel{impulse: hermite6_5Impulse, radius: 3, boundary: BoundaryClamp}, true
+	case Lanczos2:
+		return kernel{impulse: lanczos2Impulse, radius: 2, boundary: BoundaryClamp}, true
+	case Lanczos3:
+		return kernel{impulse: lanczos3Impulse, radius: 3, boundary: BoundaryClamp}, true
+	case Bezier:
+		return kernel{impulse: bezierImpulse, radius: 2, boundary: BoundaryClamp}, true
+	case PreviousHold:
+		return kernel{impulse: previousHoldImpulse, radius: 1, boundary: BoundaryClamp}, true
+	case NextHold:
+		return kernel{impulse: nextHoldImpulse, radius: 1, boundary: BoundaryClamp}, true
+	case Keys:
+		return keysKernel(0), true
+	case MitchellNetravali:
+		return mitchellNetravaliKernel(0, 0), true
+	case Gaussian:
+		return gaussianKernel(0, 0), true
+	case LanczosN:
+		return lanczosNKernel(0), true
+	case KaiserSinc:
+		return kaiserSincKernel(0, 0), true
+	case HannSinc, HammingSinc, BlackmanSinc:
+		return cosineSincKernel(t, 0), true
+	case BSplineN:
+		return bsplineNKernel(0), true
+	case LagrangeN:
+		return lagrangeNKernel(0), true
+	case Cosine:
+		return kernel{impulse: cosineImpulse, radius: 1, boundary: BoundaryClamp, derivative: cosineDerivative}, true
+	case Smoothstep:
+		return kernel{impulse: smoothstepImpulse, radius: 1, boundary: BoundaryClamp}, true
+	case Smootherstep:
+		return kernel{impulse: smootherstepImpulse, radius: 1, boundary: BoundaryClamp}, true
+	case Optimal2x4:
+		return optimalKernel(optimal2x4Coefficients), true
+	case Optimal2x6:
+		return optimalKernel(optimal2x6Coefficients), true
+	case Optimal4x4:
+		return optimalKernel(optimal4x4Coefficients), true
+	case Optimal8x4:
+		return optimalKernel(optimal8x4Coefficients), true
+	case Optimal16x4:
+		return optimalKernel(optimal16x4Coefficients), true
+	case Optimal32x4:
+		return optimalKernel(optimal32x4Coefficients), true
+	case Optimal4x2:
+		return optimalKernel(optimal4x2Coefficients), true
+	case Optimal8x2:
+		return optimalKernel(optimal8x2Coefficients), true
+	case Optimal16x2:
+		return optimalKernel(optimal16x2Coefficients), true
+	case Optimal32x2:
+		return optimalKernel(optimal32x2Coefficients), true
+	case Optimal4x6:
+		return optimalKernel(optimal4x6Coefficients), true
+	case Optimal8x6:
+		return optimalKernel(optimal8x6Coefficients), true
+	case Optimal16x6:
+		return optimalKernel(optimal16x6Coefficients), true
+	case Optimal32x6:
+		return optimalKernel(optimal32x6Coefficients), true
+	}
+	return kernel{}, false
+}
+
+// kernelInterpolate evaluates the kernel at the output positions of Interpolate
+func kernelInterpolate(in []float64, outSamples int, k kernel) []float64 {
+	if len(in) == 0 {
+		return []float64{}
+	}
+	out := make([]float64, outSamples)
+	for i := range out {
+		out[i] = k.eval(in, outputPosition(i, len(in), outSamples))
+	}
+	return out
+}
+
+// withBoundary returns the kernel using boundary b, or its built-in handling for BoundaryDefault
+func (k kernel) withBoundary(b Boundary) kernel {
+	if b != BoundaryDefault {
+		k.boundary = b
+	}
+	return k
+}
+
+// stretched widens the kernel by factor f (f > 1) and scales it to keep unit gain,
+// turning an interpolation kernel into an anti-aliasing low-pass for downsampling
+// by f
+func (k kernel) stretched(f float64) kernel {
+	impulse := k.impulse
+	k.impulse = func(x float64) float64 { return impulse(x/f) / f }
+	k.radius = int(math.Ceil(float64(k.radius) * f))
+	return k
+}
+
+// eval convolves the kernel with in at the fractional position pos
+func (k kernel) eval(in []float64, pos float64) float64 {
+	base := k.windowBase(pos)
+	sum := 0.0
+	weights := 0.0
+	for j := base - k.radius + 1; j <= base+k.radius; j++ {
+		idx, ok := k.boundary.index(j, len(in))
+		if !ok {
+			continue
+		}
+		w := k.impulse(pos - float64(j))
+		sum += in[idx] * w
+		weights += w
+	}
+	if k.normalize && weights != 0 {
+		return sum / weights
+	}
+	return sum
+}
@@ -0,0 +1,50 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKernelMatchesInterpolate(t *testing.T) {
+	in := []float64{0.5, -1.2, 3.3, 2.0, -0.7, 1.1, 4.2, -2.5, 0.0, 1.9}
+	outSamples := 23
+	ratio := float64(len(in)-1) / float64(outSamples-1)
+
+	for typ := DropSample; typ <= Akima; typ++ {
+		k, ok := kernelFor(typ)
+		if !ok {
+			continue
+		}
+		expected, err := Interpolate(in, outSamples, typ)
+		if err != nil {
+			t.Fatalf("Interpolate(%d) returned unexpected error: %v", typ, err)
+		}
+		for i := range expected {
+			got := k.eval(in, float64(i)*ratio)
+			if math.Abs(got-expected[i]) > 1e-10 {
+				t.Errorf("kernel(%d).eval() at %d = %v, want %v", typ, i, got, expected[i])
+			}
+		}
+	}
+}
+
+func TestKernelConstantInterior(t *testing.T) {
+	// Every interpolating kernel must reproduce a constant away from the edges,
+	// including at positions with a fractional part of one half or more
+	in := []float64{2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	for _, typ := range []InterpolatorType{Linear, Lagrange4, Lagrange6, Hermite4, Hermite6_3, Hermite6_5, Osculating4, Osculating6} {
+		k, _ := kernelFor(typ)
+		for _, pos := range []float64{3.0, 3.25, 3.5, 3.75, 4.9} {
+			if got := k.eval(in, pos); math.Abs(got-2) > 1e-10 {
+				t.Errorf("kernel(%d).eval(%v) = %v, want 2", typ, pos, got)
+			}
+		}
+	}
+}
+
+func TestKernelInterpolateEmpty(t *testing.T) {
+	out, err := Interpolate(nil, 5, Keys)
+	if err != nil || len(out) != 0 {
+		t.Errorf("Interpolate(nil, Keys) = %v, %v, want empty output", out, err)
+	}
+}
@@ -0,0 +1,84 @@
+package interpolators
+
+import "fmt"
+
+// Key is a keyframe: a value at a point in time, with the Kochanek-Bartels
+// parameters shaping the curve as it passes through. All three range over
+// [-1, 1] and are 0 for a Catmull-Rom curve.
+type Key struct {
+	Time  float64
+	Value float64
+	// Tension tightens the curve around the key at 1 and loosens it at -1
+	Tension float64
+	// Continuity breaks the tangent at the key into a corner at -1 or 1
+	Continuity float64
+	// Bias leans the curve towards the previous key at 1 (overshoot) or the next
+	// key at -1 (undershoot)
+	Bias float64
+}
+
+// KochanekBartels evaluates the Kochanek-Bartels (TCB) spline through keys, whose
+// times must be strictly increasing, at the given times. Each segment is a cubic
+// Hermite curve whose tangents follow from the tension, continuity and bias of its
+// keys. The tangents are scaled by the lengths of the neighbouring segments, so
+// unevenly spaced keys keep a continuous rate of change when Continuity is 0. The
+// end keys behave as if repeated, and times outside the keys hold the first or
+// last value.
+func KochanekBartels(keys []Key, times []float64) ([]float64, error) {
+	n := len(keys)
+	if n == 0 {
+		return nil, fmt.Errorf("%w: a keyframe curve needs at least 1 key", ErrTooFewPoints)
+	}
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i, k := range keys {
+		x[i], y[i] = k.Time, k.Value
+	}
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+
+	// in[k] and out[k] are the tangents arriving at and leaving key k, in units of
+	// value per segment
+	in := make([]float64, n)
+	out := make([]float64, n)
+	for k, key := range keys {
+		var before, after float64
+		dtBefore, dtAfter := 1.0, 1.0
+		if k > 0 {
+			before = y[k] - y[k-1]
+			dtBefore = x[k] - x[k-1]
+		}
+		if k < n-1 {
+			after = y[k+1] - y[k]
+			dtAfter = x[k+1] - x[k]
+		}
+		if k == 0 {
+			dtBefore = dtAfter
+		}
+		if k == n-1 {
+			dtAfter = dtBefore
+		}
+		t, c, b := key.Tension, key.Continuity, key.Bias
+		in[k] = (1-t)*(1-c)*(1+b)/2*before + (1-t)*(1+c)*(1-b)/2*after
+		out[k] = (1-t)*(1+c)*(1+b)/2*before + (1-t)*(1-c)*(1-b)/2*after
+		in[k] *= 2 * dtBefore / (dtBefore + dtAfter)
+		out[k] *= 2 * dtAfter / (dtBefore + dtAfter)
+	}
+
+	values := make([]float64, len(times))
+	for i, q := range times {
+		switch {
+		case n == 1 || q <= x[0]:
+			values[i] = y[0]
+		case q >= x[n-1]:
+			values[i] = y[n-1]
+		default:
+			j := searchSegment(x, q)
+			s := (q - x[j]) / (x[j+1] - x[j])
+			s2, s3 := s*s, s*s*s
+			values[i] = (2*s3-3*s2+1)*y[j] + (s3-2*s2+s)*out[j] + (-2*s3+3*s2)*y[j+1] + (s3-s2)*in[j+1]
+		}
+	}
+	return values, nil
+}
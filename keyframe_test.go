@@ -0,0 +1,104 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestKochanekBartelsCatmullRom(t *testing.T) {
+	// With all parameters zero on evenly spaced keys it is Catmull-Rom
+	in := []float64{0, 3, -1, 4, 2, -2}
+	keys := make([]Key, len(in))
+	for i, v := range in {
+		keys[i] = Key{Time: 10 + 2*float64(i), Value: v}
+	}
+	positions := []float64{0, 0.3, 1.5, 2.9, 4.25, 5}
+	want, _ := InterpolateAt(in, positions, Hermite4)
+	times := make([]float64, len(positions))
+	for i, p := range positions {
+		times[i] = 10 + 2*p
+	}
+	got, err := KochanekBartels(keys, times)
+	if err != nil {
+		t.Fatalf("KochanekBartels() returned unexpected error: %v", err)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("KochanekBartels() at %v = %v, want %v", times[i], got[i], want[i])
+		}
+	}
+}
+
+func TestKochanekBartelsParameters(t *testing.T) {
+	at := func(keys []Key, q float64) float64 {
+		v, err := KochanekBartels(keys, []float64{q})
+		if err != nil {
+			t.Fatalf("KochanekBartels() returned unexpected error: %v", err)
+		}
+		return v[0]
+	}
+	slope := func(keys []Key, q float64) float64 {
+		h := 1e-6
+		return (at(keys, q+h) - at(keys, q-h)) / (2 * h)
+	}
+	keys := []Key{{Time: 0, Value: 0}, {Time: 1, Value: 2}, {Time: 3, Value: 1}, {Time: 4, Value: 5}}
+
+	// Uneven spacing keeps the rate of change continuous at the keys
+	for _, k := range keys[1:3] {
+		h := 1e-7
+		left := (at(keys, k.Time-h) - at(keys, k.Time-2*h)) / h
+		right := (at(keys, k.Time+2*h) - at(keys, k.Time+h)) / h
+		if math.Abs(left-right) > 1e-4 {
+			t.Errorf("slopes around key at %v = %v and %v, want equal", k.Time, left, right)
+		}
+	}
+
+	// Full tension flattens the curve at the key
+	tense := append([]Key(nil), keys...)
+	tense[2].Tension = 1
+	if s := slope(tense, 3); math.Abs(s) > 1e-4 {
+		t.Errorf("slope at a key with tension 1 = %v, want 0", s)
+	}
+
+	// Continuity breaks the tangent into a corner
+	corner := append([]Key(nil), keys...)
+	corner[1].Continuity = -1
+	h := 1e-7
+	left := (at(corner, 1-h) - at(corner, 1-2*h)) / h
+	right := (at(corner, 1+2*h) - at(corner, 1+h)) / h
+	if math.Abs(left-right) < 0.1 {
+		t.Errorf("slopes around a key with continuity -1 = %v and %v, want a corner", left, right)
+	}
+
+	// Bias 1 takes the tangent from the previous segment alone: its change of -1,
+	// spread over the mean length (2+1)/2 of the two segments
+	biased := append([]Key(nil), keys...)
+	biased[2].Bias = 1
+	if s, want := slope(biased, 3), -1/1.5; math.Abs(s-want) > 1e-4 {
+		t.Errorf("slope at a key with bias 1 = %v, want %v", s, want)
+	}
+
+	// Outside the keys the end values hold
+	if got := at(keys, -5); got != 0 {
+		t.Errorf("KochanekBartels() before the first key = %v, want 0", got)
+	}
+	if got := at(keys, 9); got != 5 {
+		t.Errorf("KochanekBartels() after the last key = %v, want 5", got)
+	}
+}
+
+func TestKochanekBartelsErrors(t *testing.T) {
+	if _, err := KochanekBartels(nil, []float64{1}); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("KochanekBartels(nil) error = %v, want %v", err, ErrTooFewPoints)
+	}
+	if _, err := KochanekBartels([]Key{{Time: 1}, {Time: 1}}, []float64{1}); err == nil {
+		t.Error("KochanekBartels() with repeated times should return an error")
+	}
+	got, _ := KochanekBartels([]Key{{Time: 2, Value: 7}}, []float64{0, 2, 5})
+	for i, v := range got {
+		if v != 7 {
+			t.Errorf("KochanekBartels() of a single key [%d] = %v, want 7", i, v)
+		}
+	}
+}
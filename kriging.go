@@ -0,0 +1,162 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// VariogramModel selects the shape of a kriging variogram
+type VariogramModel int
+
+const (
+	// VariogramSpherical rises like a cubic and reaches the sill exactly at the range
+	VariogramSpherical VariogramModel = iota
+	// VariogramExponential rises steeply and approaches the sill, reaching 95% of it
+	// at the range, for rough signals
+	VariogramExponential
+	// VariogramGaussian rises quadratically near zero, reaching 95% of the sill at
+	// the range, for smooth signals
+	VariogramGaussian
+	// VariogramLinear grows without bound as Sill/Range per unit of distance, for
+	// signals without a characteristic scale such as random walks
+	VariogramLinear
+)
+
+// Variogram describes how the dissimilarity of two samples grows with their
+// distance. Zero values for the sill and range select the variance of the samples
+// and a third of the extent of their coordinates.
+type Variogram struct {
+	Model VariogramModel
+	// Nugget is the jump of the variogram just above zero distance, covering
+	// measurement noise and variation finer than the sampling
+	Nugget float64
+	// Sill is the variogram level, nugget excluded, at which samples are uncorrelated
+	Sill float64
+	// Range is the distance over which the variogram reaches the sill
+	Range float64
+}
+
+// gamma evaluates the variogram at distance d
+func (v Variogram) gamma(d float64) float64 {
+	h := math.Abs(d) / v.Range
+	if h == 0 {
+		return 0
+	}
+	var g float64
+	switch v.Model {
+	case VariogramSpherical:
+		if h >= 1 {
+			g = 1
+		} else {
+			g = 1.5*h - 0.5*h*h*h
+		}
+	case VariogramExponential:
+		g = 1 - math.Exp(-3*h)
+	case VariogramGaussian:
+		g = 1 - math.Exp(-3*h*h)
+	case VariogramLinear:
+		g = h
+	}
+	return v.Nugget + v.Sill*g
+}
+
+// Kriging interpolates the samples y at coordinates x by ordinary kriging: each
+// estimate is the weighted sum of the samples with the smallest expected squared
+// error under the variogram, with the weights summing to one so the unknown mean of
+// the signal is estimated along the way. It returns the estimates at xq with their
+// kriging variances, which are zero at the samples and grow away from them.
+func Kriging(x, y, xq []float64, variogram Variogram) (mean, variance []float64, err error) {
+	if len(x) != len(y) {
+		return nil, nil, fmt.Errorf("coordinate and sample lengths differ: %d and %d", len(x), len(y))
+	}
+	if len(x) == 0 {
+		return nil, nil, errors.New("at least one sample is required")
+	}
+	if variogram.Nugget < 0 || variogram.Sill < 0 || variogram.Range < 0 {
+		return nil, nil, errors.New("nugget, sill and range must not be negative")
+	}
+	switch variogram.Model {
+	case VariogramSpherical, VariogramExponential, VariogramGaussian, VariogramLinear:
+	default:
+		return nil, nil, fmt.Errorf("unknown variogram model %d", variogram.Model)
+	}
+	if variogram.Sill == 0 {
+		variogram.Sill = sampleVariance(y)
+	}
+	if variogram.Range == 0 {
+		lo, hi := x[0], x[0]
+		for _, v := range x {
+			lo = math.Min(lo, v)
+			hi = math.Max(hi, v)
+		}
+		variogram.Range = (hi - lo) / 3
+	}
+	if variogram.Sill == 0 || variogram.Range == 0 {
+		// Constant samples or a single location carry no spatial structure, so the
+		// best estimate everywhere is their mean
+		avg := 0.0
+		for _, v := range y {
+			avg += v / float64(len(y))
+		}
+		return constant(avg, len(xq)), make([]float64, len(xq)), nil
+	}
+
+	// The kriging system [Γ 1; 1ᵀ 0] [λ; μ] = [γ; 1], with μ the Lagrange multiplier
+	// enforcing that the weights sum to one
+	n := len(x)
+	system := newMatrix(n+1, n+1)
+	for i := range x {
+		for j := range x {
+			system[i][j] = variogram.gamma(x[i] - x[j])
+		}
+		system[i][n] = 1
+		system[n][i] = 1
+	}
+	inverse, err := invert(system)
+	if err != nil {
+		return nil, nil, fmt.Errorf("kriging system: %w", err)
+	}
+
+	mean = make([]float64, len(xq))
+	variance = make([]float64, len(xq))
+	rhs := make([]float64, n+1)
+	rhs[n] = 1
+	for i, q := range xq {
+		for j := range x {
+			rhs[j] = variogram.gamma(q - x[j])
+		}
+		weights := matVec(inverse, rhs)
+		v := weights[n]
+		for j := range x {
+			mean[i] += weights[j] * y[j]
+			v += weights[j] * rhs[j]
+		}
+		variance[i] = math.Max(0, v)
+	}
+	return mean, variance, nil
+}
+
+// InterpolateKriging krigs uniformly spaced samples onto the same output grid as
+// Interpolate, with the variogram range in input samples
+func InterpolateKriging(in []float64, outSamples int, variogram Variogram) (mean, variance []float64, err error) {
+	x, xq, err := uniformGrid(len(in), outSamples)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Kriging(x, in, xq, variogram)
+}
+
+// sampleVariance returns the population variance of y
+func sampleVariance(y []float64) float64 {
+	mean := 0.0
+	for _, v := range y {
+		mean += v
+	}
+	mean /= float64(len(y))
+	sum := 0.0
+	for _, v := range y {
+		sum += (v - mean) * (v - mean)
+	}
+	return sum / float64(len(y))
+}
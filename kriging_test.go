@@ -0,0 +1,98 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestKrigingInterpolatesSamples(t *testing.T) {
+	x := []float64{0, 1, 2.5, 4, 5}
+	y := []float64{1, 3, 2, -1, 0.5}
+	for _, model := range []VariogramModel{VariogramSpherical, VariogramExponential, VariogramGaussian, VariogramLinear} {
+		mean, variance, err := Kriging(x, y, x, Variogram{Model: model})
+		if err != nil {
+			t.Fatalf("Kriging(%d) returned unexpected error: %v", model, err)
+		}
+		for i := range x {
+			if math.Abs(mean[i]-y[i]) > 1e-6 {
+				t.Errorf("Kriging(%d) mean at %v = %v, want %v", model, x[i], mean[i], y[i])
+			}
+			if variance[i] > 1e-6 {
+				t.Errorf("Kriging(%d) variance at %v = %v, want 0", model, x[i], variance[i])
+			}
+		}
+	}
+}
+
+func TestKrigingVarianceGrowsAwayFromData(t *testing.T) {
+	x := []float64{0, 1, 2}
+	y := []float64{0, 1, 0}
+	_, variance, err := Kriging(x, y, []float64{1, 1.5, 3, 6}, Variogram{Model: VariogramExponential, Sill: 1, Range: 2})
+	if err != nil {
+		t.Fatalf("Kriging() returned unexpected error: %v", err)
+	}
+	for i := 1; i < len(variance); i++ {
+		if variance[i] <= variance[i-1] {
+			t.Errorf("Kriging() variance = %v, want increasing away from the data", variance)
+		}
+	}
+}
+
+func TestKrigingEstimatesMean(t *testing.T) {
+	// Far beyond the range the samples are uncorrelated with the estimate, which
+	// falls back to the mean ordinary kriging estimates from them
+	x := []float64{0, 1, 2, 3}
+	y := []float64{4, 6, 5, 7}
+	mean, _, _ := Kriging(x, y, []float64{100}, Variogram{Model: VariogramSpherical, Sill: 1, Range: 0.5})
+	if math.Abs(mean[0]-5.5) > 1e-9 {
+		t.Errorf("Kriging() far from the data = %v, want the sample mean 5.5", mean[0])
+	}
+}
+
+func TestKrigingLinearVariogram(t *testing.T) {
+	// In one dimension a linear variogram kriges like piecewise linear interpolation
+	in := []float64{0, 2, 1, 4}
+	mean, variance, err := InterpolateKriging(in, 7, Variogram{Model: VariogramLinear, Sill: 1, Range: 1})
+	if err != nil {
+		t.Fatalf("InterpolateKriging() returned unexpected error: %v", err)
+	}
+	want, _ := Interpolate(in, 7, Linear)
+	for i := range want {
+		if math.Abs(mean[i]-want[i]) > 1e-9 {
+			t.Errorf("InterpolateKriging()[%d] = %v, want %v", i, mean[i], want[i])
+		}
+	}
+	// Increments have variance 2γ(h) = 2h, so a Brownian bridge has variance
+	// 2·½·½ halfway between samples
+	if math.Abs(variance[1]-0.5) > 1e-9 {
+		t.Errorf("InterpolateKriging() variance midway = %v, want 0.5", variance[1])
+	}
+	if _, _, err := InterpolateKriging(in, -1, Variogram{}); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateKriging(outSamples=-1) error = %v, want %v", err, ErrInvalidOutSamples)
+	}
+}
+
+func TestKrigingInvalidInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		x, y      []float64
+		variogram Variogram
+	}{
+		{"length mismatch", []float64{0, 1}, []float64{0}, Variogram{}},
+		{"empty", nil, nil, Variogram{}},
+		{"negative nugget", []float64{0, 1}, []float64{0, 1}, Variogram{Nugget: -1}},
+		{"unknown model", []float64{0, 1}, []float64{0, 1}, Variogram{Model: 42}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Kriging(tt.x, tt.y, []float64{0.5}, tt.variogram); err == nil {
+				t.Error("Kriging() should return an error")
+			}
+		})
+	}
+	mean, _, err := Kriging([]float64{3}, []float64{2}, []float64{0, 9}, Variogram{})
+	if err != nil || mean[0] != 2 || mean[1] != 2 {
+		t.Errorf("Kriging() of a single sample = %v, %v, want constant 2", mean, err)
+	}
+}
@@ -0,0 +1,79 @@
+package interpolators
+
+import "math"
+
+// lagrangeNKernel returns the Lagrange kernel with 2*radius points, or 8 points
+// for radius 0. It reproduces Lagrange4 and Lagrange6 for radius 2 and 3.
+func lagrangeNKernel(radius int) kernel {
+	if radius == 0 {
+		radius = 4
+	}
+	impulse := func(x float64) float64 {
+		w, _, _ := lagrangeWeight(radius, math.Abs(x))
+		return w
+	}
+	switch radius {
+	case 2:
+		impulse = lagrange4Impulse
+	case 3:
+		impulse = lagrange6Impulse
+	}
+	derivative := func(x float64, order int) float64 {
+		_, d1, d2 := lagrangeWeight(radius, math.Abs(x))
+		if order == 1 {
+			if x < 0 {
+				return -d1
+			}
+			return d1
+		}
+		return d2
+	}
+	return kernel{impulse: impulse, radius: radius, boundary: BoundaryZero, derivative: derivative}
+}
+
+// lagrangeWeight returns the weight of the sample at distance t >= 0 in
+// 2*radius-point Lagrange interpolation, and its first two derivatives in t. The
+// nodes are the 2*radius samples around t, floor(t)-radius+1 ... floor(t)+radius,
+// and the weight is the Lagrange basis polynomial of node 0.
+func lagrangeWeight(radius int, t float64) (w, d1, d2 float64) {
+	if t >= float64(radius) {
+		return 0, 0, 0
+	}
+	s := int(math.Floor(t))
+	factors := make([]float64, 0, 2*radius-1)
+	scale := 1.0
+	for k := s - radius + 1; k <= s+radius; k++ {
+		if k != 0 {
+			factors = append(factors, t-float64(k))
+			scale /= -float64(k)
+		}
+	}
+	// w = Π f, w' = Σᵢ Π_{k≠i} f and w'' = Σ_{i≠j} Π_{k≠i,j} f, since each factor
+	// is linear in t
+	w = scale
+	for _, f := range factors {
+		w *= f
+	}
+	for i := range factors {
+		p := scale
+		for k, f := range factors {
+			if k != i {
+				p *= f
+			}
+		}
+		d1 += p
+		for j := range factors {
+			if j == i {
+				continue
+			}
+			q := scale
+			for k, f := range factors {
+				if k != i && k != j {
+					q *= f
+				}
+			}
+			d2 += q
+		}
+	}
+	return w, d1, d2
+}
@@ -0,0 +1,69 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLagrangeN(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2, 6, -3}
+	for radius, fixed := range map[int]InterpolatorType{2: Lagrange4, 3: Lagrange6} {
+		want, _ := Interpolate(in, 37, fixed)
+		got, err := InterpolateWithOptions(in, 37, LagrangeN, Options{Kernel: KernelParams{Radius: radius}})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(LagrangeN, radius %d) returned unexpected error: %v", radius, err)
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Errorf("LagrangeN radius %d [%d] = %v, want %v", radius, i, got[i], want[i])
+			}
+		}
+		// The generic weights agree with the hand-expanded kernels
+		for x := 0.05; x < float64(radius); x += 0.1 {
+			w, _, _ := lagrangeWeight(radius, x)
+			if k, _ := kernelFor(fixed); math.Abs(w-k.impulse(x)) > 1e-12 {
+				t.Errorf("lagrangeWeight(%d, %v) = %v, want %v", radius, x, w, k.impulse(x))
+			}
+		}
+	}
+}
+
+func TestLagrangeNPolynomials(t *testing.T) {
+	// 2r-point Lagrange interpolation reproduces polynomials of degree 2r-1
+	for _, radius := range []int{4, 5} {
+		poly := func(x float64) float64 {
+			y := 0.0
+			for d := 2*radius - 1; d >= 0; d-- {
+				y = y*(x-10)/10 + float64(d%3) - 1
+			}
+			return y
+		}
+		in := make([]float64, 20)
+		for i := range in {
+			in[i] = poly(float64(i))
+		}
+		k := lagrangeNKernel(radius)
+		for pos := float64(radius); pos < float64(len(in)-radius); pos += 0.37 {
+			if got, want := k.eval(in, pos), poly(pos); math.Abs(got-want) > 1e-9 {
+				t.Errorf("%d-point Lagrange at %v = %v, want %v", 2*radius, pos, got, want)
+			}
+		}
+	}
+}
+
+func TestLagrangeNDerivative(t *testing.T) {
+	k := lagrangeNKernel(5)
+	for _, order := range []int{1, 2} {
+		d := mustKernelDerivative(t, LagrangeN, k, order)
+		f := k.impulse
+		if order == 2 {
+			f = mustKernelDerivative(t, LagrangeN, k, 1)
+		}
+		for _, x := range []float64{-4.3, -0.7, 0.2, 2.6} {
+			h := 1e-5
+			if want := (f(x+h) - f(x-h)) / (2 * h); math.Abs(d(x)-want) > 1e-5 {
+				t.Errorf("LagrangeN derivative %d at %v = %v, want %v", order, x, d(x), want)
+			}
+		}
+	}
+}
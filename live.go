@@ -0,0 +1,165 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// splineReach is the number of points after which a new point's influence on a
+// cubic spline has decayed below 1e-9 of its size, since each segment damps it by
+// 2-√3. The monotonic cubic's slope limiting can also chain from segment to
+// segment, and uses the same reach.
+const splineReach = 16
+
+// LiveSeries maintains a fixed-length display of a streaming series resampled onto
+// a regular grid. Grid points sit at multiples of the step, so they stay put as the
+// display scrolls. Add only records which part of the display a new point affects
+// and Display recomputes just that tail, so feeding points at tick rate and calling
+// Display at the refresh rate keeps the work proportional to what changed.
+type LiveSeries struct {
+	interpolatorType InterpolatorType
+	step             float64
+	reach            int
+
+	t, v []float64 // retained points
+
+	values []float64 // display buffer, oldest first
+	end    int64     // grid index of the newest display value
+	dirty  int64     // first grid index that needs recomputing
+}
+
+// NewLiveSeries creates a display of length grid points covering the trailing
+// window of time, with points step = window/(length-1) apart
+func NewLiveSeries(window float64, length int, interpolatorType InterpolatorType) (*LiveSeries, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if !(window > 0) || math.IsInf(window, 0) || length < 2 {
+		return nil, fmt.Errorf("window must be positive and length at least 2, got %v and %d", window, length)
+	}
+	reach := 1
+	if k, ok := kernelFor(interpolatorType); ok {
+		reach = k.radius
+	}
+	switch interpolatorType {
+	case CubicSpline, MonotonicCubic, TensionSpline, Hyman:
+		reach = splineReach
+	case OMOMS:
+		// The prefilter damps a new point's influence by |omomsPole| ≈ 0.34 per
+		// sample, below 1e-9 after 20
+		reach = 20
+	case Akima, Schumaker:
+		reach = 3
+	case Sinc:
+		// Every point contributes, but the tails decay as 1/distance; the display
+		// is only recomputed this far back to keep the cost per point bounded
+		reach = 64
+	}
+
+	values := make([]float64, length)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	return &LiveSeries{
+		interpolatorType: interpolatorType,
+		step:             window / float64(length-1),
+		reach:            reach,
+		values:           values,
+		end:              math.MinInt64,
+		dirty:            math.MaxInt64,
+	}, nil
+}
+
+// Add appends the point (t, v). Times must be finite and strictly increasing.
+func (s *LiveSeries) Add(t, v float64) error {
+	if math.IsNaN(t) || math.IsInf(t, 0) {
+		return errors.New("time must be finite")
+	}
+	if n := len(s.t); n > 0 && !(t > s.t[n-1]) {
+		return fmt.Errorf("times must be strictly increasing, %v follows %v", t, s.t[n-1])
+	}
+	s.t = append(s.t, t)
+	s.v = append(s.v, v)
+
+	// The interpolant changes from reach points back onward
+	affected := s.t[max(0, len(s.t)-1-s.reach)]
+	if i := int64(math.Ceil(affected / s.step)); i < s.dirty {
+		s.dirty = i
+	}
+	return nil
+}
+
+// Display returns the grid times and values of the display, oldest first. Grid
+// points before the first point are NaN; the display is empty until a point has
+// been added.
+func (s *LiveSeries) Display() (times, values []float64) {
+	if len(s.t) == 0 {
+		return []float64{}, []float64{}
+	}
+	s.scroll(int64(math.Floor(s.t[len(s.t)-1] / s.step)))
+
+	length := int64(len(s.values))
+	first := s.end - length + 1
+	if s.dirty <= s.end {
+		from := max(s.dirty, first)
+		s.recompute(from)
+		s.dirty = math.MaxInt64
+		s.trim(first)
+	}
+
+	times = make([]float64, length)
+	for i := range times {
+		times[i] = float64(first+int64(i)) * s.step
+	}
+	return times, append([]float64(nil), s.values...)
+}
+
+// scroll moves the display so that its newest value is at grid index end
+func (s *LiveSeries) scroll(end int64) {
+	if end == s.end {
+		return
+	}
+	length := int64(len(s.values))
+	shift := end - s.end
+	if s.end == math.MinInt64 || shift >= length {
+		shift = length
+	}
+	copy(s.values, s.values[shift:])
+	for i := length - shift; i < length; i++ {
+		s.values[i] = math.NaN()
+	}
+	if first := end - shift + 1; first < s.dirty {
+		s.dirty = first
+	}
+	s.end = end
+}
+
+// recompute evaluates the display from grid index from onward, fitting only the
+// points that can influence it
+func (s *LiveSeries) recompute(from int64) {
+	start := float64(from) * s.step
+	j := sort.SearchFloat64s(s.t, start)
+	lo := max(0, j-2*s.reach-1)
+	f := newXYEvaluator(s.t[lo:], s.v[lo:], s.interpolatorType)
+
+	first := s.end - int64(len(s.values)) + 1
+	for i := from; i <= s.end; i++ {
+		q := float64(i) * s.step
+		if q < s.t[0] {
+			s.values[i-first] = math.NaN()
+			continue
+		}
+		s.values[i-first] = f(q)
+	}
+}
+
+// trim drops points that can no longer influence any grid index from first onward
+func (s *LiveSeries) trim(first int64) {
+	j := sort.SearchFloat64s(s.t, float64(first)*s.step)
+	if drop := j - 2*s.reach - 1; drop > len(s.t)/2 {
+		s.t = append(s.t[:0], s.t[drop:]...)
+		s.v = append(s.v[:0], s.v[drop:]...)
+	}
+}
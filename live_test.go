@@ -0,0 +1,80 @@
+package interpolators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLiveSeriesMatchesFullInterpolation(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	var ts, vs []float64
+	now := 0.0
+	for i := 0; i < 400; i++ {
+		now += 0.05 + rng.Float64()*0.3
+		ts = append(ts, now)
+		vs = append(vs, math.Sin(now)+rng.NormFloat64()*0.1)
+	}
+
+	for _, typ := range []InterpolatorType{Linear, Hermite4, Lanczos3, CubicSpline, MonotonicCubic, Akima, PreviousHold} {
+		t.Run(typ.String(), func(t *testing.T) {
+			live, err := NewLiveSeries(10, 101, typ)
+			if err != nil {
+				t.Fatalf("NewLiveSeries() returned unexpected error: %v", err)
+			}
+			for i := range ts {
+				if err := live.Add(ts[i], vs[i]); err != nil {
+					t.Fatalf("Add() returned unexpected error: %v", err)
+				}
+				if i%7 == 0 || i == len(ts)-1 {
+					times, got := live.Display()
+					want, _ := InterpolateXY(ts[:i+1], vs[:i+1], times, typ)
+					for k := range got {
+						if times[k] < ts[0] {
+							if !math.IsNaN(got[k]) {
+								t.Fatalf("Display() before the first point = %v, want NaN", got[k])
+							}
+							continue
+						}
+						if math.Abs(got[k]-want[k]) > 1e-8 {
+							t.Fatalf("after %d points Display()[%d] = %v, want %v", i+1, k, got[k], want[k])
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLiveSeriesGrid(t *testing.T) {
+	live, _ := NewLiveSeries(4, 5, Linear)
+	if times, values := live.Display(); len(times) != 0 || len(values) != 0 {
+		t.Errorf("Display() before any point = %v, %v, want empty", times, values)
+	}
+	live.Add(0, 0)
+	live.Add(10.5, 21)
+	times, values := live.Display()
+	wantTimes := []float64{6, 7, 8, 9, 10}
+	for i := range wantTimes {
+		if times[i] != wantTimes[i] || math.Abs(values[i]-2*wantTimes[i]) > 1e-12 {
+			t.Errorf("Display()[%d] = (%v, %v), want (%v, %v)", i, times[i], values[i], wantTimes[i], 2*wantTimes[i])
+		}
+	}
+}
+
+func TestLiveSeriesErrors(t *testing.T) {
+	if _, err := NewLiveSeries(0, 10, Linear); err == nil {
+		t.Error("NewLiveSeries() with zero window should return an error")
+	}
+	if _, err := NewLiveSeries(1, 1, Linear); err == nil {
+		t.Error("NewLiveSeries() with length 1 should return an error")
+	}
+	live, _ := NewLiveSeries(1, 10, Linear)
+	live.Add(1, 0)
+	if err := live.Add(1, 1); err == nil {
+		t.Error("Add() with a repeated time should return an error")
+	}
+	if err := live.Add(math.NaN(), 1); err == nil {
+		t.Error("Add() with a NaN time should return an error")
+	}
+}
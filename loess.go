@@ -0,0 +1,163 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// LOESSOptions configures locally weighted regression. A zero Span selects 0.75
+// and a zero Degree a local line.
+type LOESSOptions struct {
+	// Span is the fraction of the samples each local fit uses, nearest first;
+	// smaller spans follow the data more closely
+	Span float64
+	// Degree is the degree of the local polynomials: 1 for lines or 2 for parabolas,
+	// which follow peaks better
+	Degree int
+	// Robust is the number of LOWESS robustness iterations, each of which refits
+	// with outliers downweighted by their residuals; zero trusts every sample
+	Robust int
+}
+
+// LOESS smooths the samples y at the strictly increasing coordinates x by locally
+// weighted regression and returns the smooth curve at xq. At each query the nearest
+// Span·len(x) samples are weighted by a tricube of their distance and fitted with a
+// polynomial of the given degree, whose value at the query is the estimate.
+func LOESS(x, y, xq []float64, opts LOESSOptions) ([]float64, error) {
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+	if len(x) == 0 {
+		return nil, errors.New("at least one sample is required")
+	}
+	if opts.Span == 0 {
+		opts.Span = 0.75
+	}
+	if opts.Degree == 0 {
+		opts.Degree = 1
+	}
+	if opts.Span < 0 || opts.Degree < 0 || opts.Degree > 2 || opts.Robust < 0 {
+		return nil, fmt.Errorf("invalid LOESS options: span %v, degree %d, robust %d", opts.Span, opts.Degree, opts.Robust)
+	}
+
+	robustness := make([]float64, len(x))
+	for i := range robustness {
+		robustness[i] = 1
+	}
+	for iter := 0; iter < opts.Robust; iter++ {
+		residuals := make([]float64, len(x))
+		for i := range x {
+			residuals[i] = math.Abs(y[i] - loessAt(x, y, robustness, x[i], opts))
+		}
+		sorted := append([]float64(nil), residuals...)
+		sort.Float64s(sorted)
+		scale := 6 * sorted[len(sorted)/2]
+		if scale == 0 {
+			break
+		}
+		for i, r := range residuals {
+			// Bisquare weights reject residuals beyond six median absolute residuals
+			u := r / scale
+			robustness[i] = 0
+			if u < 1 {
+				robustness[i] = (1 - u*u) * (1 - u*u)
+			}
+		}
+	}
+
+	out := make([]float64, len(xq))
+	for i, q := range xq {
+		out[i] = loessAt(x, y, robustness, q, opts)
+	}
+	return out, nil
+}
+
+// InterpolateLOESS smooths uniformly spaced samples by locally weighted regression
+// and evaluates the curve on the same output grid as Interpolate
+func InterpolateLOESS(in []float64, outSamples int, opts LOESSOptions) ([]float64, error) {
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	x := make([]float64, len(in))
+	for i := range x {
+		x[i] = float64(i)
+	}
+	xq := make([]float64, outSamples)
+	for i := range xq {
+		xq[i] = outputPosition(i, len(in), outSamples)
+	}
+	return LOESS(x, in, xq, opts)
+}
+
+// loessAt fits the local polynomial around q, with each sample's tricube weight
+// scaled by its robustness weight, and returns its value at q
+func loessAt(x, y, robustness []float64, q float64, opts LOESSOptions) float64 {
+	n := len(x)
+	k := min(n, max(int(math.Ceil(opts.Span*float64(n))), opts.Degree+2))
+
+	// Grow the window [lo, hi) of the k samples nearest to q
+	hi := sort.SearchFloat64s(x, q)
+	lo := hi
+	for hi-lo < k {
+		if lo == 0 || (hi < n && x[hi]-q < q-x[lo-1]) {
+			hi++
+		} else {
+			lo--
+		}
+	}
+	radius := math.Max(q-x[lo], x[hi-1]-q)
+	if opts.Span > 1 {
+		// Spans beyond the data widen the weights further
+		radius *= opts.Span
+	}
+	// Keep the farthest sample of the window from getting a weight of exactly zero
+	radius *= 1 + 1e-9
+	if radius == 0 {
+		radius = 1
+	}
+
+	// Weighted least squares in the scaled coordinate u = (x - q) / radius, so the
+	// intercept is the estimate at q
+	terms := opts.Degree + 1
+	normal := newMatrix(terms, terms)
+	rhs := make([]float64, terms)
+	powers := make([]float64, terms)
+	for j := lo; j < hi; j++ {
+		u := (x[j] - q) / radius
+		d := math.Abs(u)
+		if d >= 1 {
+			continue
+		}
+		t := 1 - d*d*d
+		w := t * t * t * robustness[j]
+		powers[0] = 1
+		for p := 1; p < terms; p++ {
+			powers[p] = powers[p-1] * u
+		}
+		for r := 0; r < terms; r++ {
+			for c := 0; c < terms; c++ {
+				normal[r][c] += w * powers[r] * powers[c]
+			}
+			rhs[r] += w * powers[r] * y[j]
+		}
+	}
+	if normal[0][0] == 0 {
+		// Every nearby sample was rejected as an outlier, so fall back to their mean
+		sum := 0.0
+		for j := lo; j < hi; j++ {
+			sum += y[j]
+		}
+		return sum / float64(hi-lo)
+	}
+	coeffs, err := solveLinear(normal, rhs)
+	if err != nil {
+		// Too few distinct weighted samples for the degree: use the weighted mean
+		return rhs[0] / normal[0][0]
+	}
+	return coeffs[0]
+}
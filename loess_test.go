@@ -0,0 +1,90 @@
+package interpolators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLOESSReproducesPolynomials(t *testing.T) {
+	x := []float64{0, 0.5, 1.5, 2, 3, 4.5, 5, 6, 7.5, 8}
+	tests := []struct {
+		name   string
+		degree int
+		f      func(float64) float64
+	}{
+		{"line", 1, func(v float64) float64 { return 2*v - 1 }},
+		{"parabola", 2, func(v float64) float64 { return v*v - 3*v + 2 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y := make([]float64, len(x))
+			for i, v := range x {
+				y[i] = tt.f(v)
+			}
+			xq := []float64{0, 1, 2.7, 5.5, 8}
+			got, err := LOESS(x, y, xq, LOESSOptions{Span: 0.5, Degree: tt.degree})
+			if err != nil {
+				t.Fatalf("LOESS() returned unexpected error: %v", err)
+			}
+			for i, q := range xq {
+				if math.Abs(got[i]-tt.f(q)) > 1e-9 {
+					t.Errorf("LOESS() at %v = %v, want %v", q, got[i], tt.f(q))
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolateLOESSReducesNoise(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	n := 300
+	in := make([]float64, n)
+	for i := range in {
+		in[i] = math.Sin(float64(i)/30) + 0.2*rng.NormFloat64()
+	}
+	out, err := InterpolateLOESS(in, 2*n-1, LOESSOptions{Span: 0.1, Degree: 2})
+	if err != nil {
+		t.Fatalf("InterpolateLOESS() returned unexpected error: %v", err)
+	}
+	if len(out) != 2*n-1 {
+		t.Fatalf("InterpolateLOESS() returned %d samples, want %d", len(out), 2*n-1)
+	}
+	sum := 0.0
+	for i, v := range out {
+		sum += (v - math.Sin(float64(i)/60)) * (v - math.Sin(float64(i)/60))
+	}
+	if rms := math.Sqrt(sum / float64(len(out))); rms > 0.1 {
+		t.Errorf("InterpolateLOESS() RMS error = %v, want below half the noise 0.2", rms)
+	}
+}
+
+func TestLOESSRobust(t *testing.T) {
+	x := make([]float64, 30)
+	y := make([]float64, 30)
+	for i := range x {
+		x[i] = float64(i)
+		y[i] = 0.5 * x[i]
+	}
+	y[15] = 100
+	plain, _ := LOESS(x, y, []float64{15}, LOESSOptions{Span: 0.3})
+	robust, _ := LOESS(x, y, []float64{15}, LOESSOptions{Span: 0.3, Robust: 3})
+	if math.Abs(plain[0]-7.5) < 1 {
+		t.Fatalf("LOESS() at the outlier = %v, expected it to be pulled away from 7.5", plain[0])
+	}
+	if math.Abs(robust[0]-7.5) > 1e-6 {
+		t.Errorf("LOESS() with robustness iterations at the outlier = %v, want 7.5", robust[0])
+	}
+}
+
+func TestLOESSInvalidOptions(t *testing.T) {
+	x := []float64{0, 1, 2}
+	for _, opts := range []LOESSOptions{{Span: -1}, {Degree: 3}, {Robust: -1}} {
+		if _, err := LOESS(x, x, x, opts); err == nil {
+			t.Errorf("LOESS(%+v) should return an error", opts)
+		}
+	}
+	if _, err := LOESS([]float64{0, 0}, []float64{1, 2}, nil, LOESSOptions{}); err == nil {
+		t.Error("LOESS() with repeated coordinates should return an error")
+	}
+}
@@ -0,0 +1,110 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func sine(freq, amplitude float64, sampleRate, samples int) []float64 {
+	out := make([]float64, samples)
+	for i := range out {
+		out[i] = amplitude * math.Sin(2*math.Pi*freq*float64(i)/float64(sampleRate))
+	}
+	return out
+}
+
+func TestResampleTo48kAccuracy(t *testing.T) {
+	for _, srIn := range []int{44100, 32000, 96000} {
+		in := sine(1000, 1, srIn, srIn/10)
+		out, err := ResampleTo48k(in, srIn)
+		if err != nil {
+			t.Fatalf("ResampleTo48k(%d) returned unexpected error: %v", srIn, err)
+		}
+		expected := sine(1000, 1, LoudnessSampleRate, len(out))
+		// Skip the filter length at both ends where the input is zero padded
+		margin := 200
+		stats := Compare(out[margin:len(out)-margin], expected[margin:len(out)-margin])
+		if stats.MaxError > 1e-3 {
+			t.Errorf("ResampleTo48k(%d) max error = %v, want below 1e-3", srIn, stats.MaxError)
+		}
+	}
+}
+
+func TestResampleTo48kIdentity(t *testing.T) {
+	in := []float64{1, 2, 3}
+	out, err := ResampleTo48k(in, LoudnessSampleRate)
+	if err != nil {
+		t.Fatalf("ResampleTo48k() returned unexpected error: %v", err)
+	}
+	out[0] = 100
+	if in[0] != 1 {
+		t.Error("ResampleTo48k() at 48 kHz should return a copy")
+	}
+	if _, err := ResampleTo48k(in, 0); err == nil {
+		t.Error("ResampleTo48k() with zero sample rate should return an error")
+	}
+}
+
+func TestIntegratedLoudnessReferenceTone(t *testing.T) {
+	// BS.1770: a full-scale 1 kHz sine in one channel measures -3.01 LKFS
+	for _, sr := range []int{48000, 44100} {
+		in := sine(1000, 1, sr, 3*sr)
+		loudness, err := IntegratedLoudness([][]float64{in}, sr)
+		if err != nil {
+			t.Fatalf("IntegratedLoudness() returned unexpected error: %v", err)
+		}
+		if math.Abs(loudness+3.01) > 0.05 {
+			t.Errorf("IntegratedLoudness() at %d Hz = %v, want -3.01", sr, loudness)
+		}
+	}
+}
+
+func TestIntegratedLoudnessGating(t *testing.T) {
+	// Silence is gated out entirely, and appending as much silence as tone barely
+	// changes the loudness (only blocks straddling the transition count), where an
+	// ungated measurement would drop by 3 dB
+	silence := make([]float64, 3*LoudnessSampleRate)
+	loudness, err := IntegratedLoudness([][]float64{silence}, LoudnessSampleRate)
+	if err != nil {
+		t.Fatalf("IntegratedLoudness() returned unexpected error: %v", err)
+	}
+	if !math.IsInf(loudness, -1) {
+		t.Errorf("IntegratedLoudness() of silence = %v, want -Inf", loudness)
+	}
+
+	tone := sine(1000, 0.5, LoudnessSampleRate, 3*LoudnessSampleRate)
+	base, _ := IntegratedLoudness([][]float64{tone}, LoudnessSampleRate)
+	padded, _ := IntegratedLoudness([][]float64{append(tone, silence...)}, LoudnessSampleRate)
+	if math.Abs(base-padded) > 0.5 {
+		t.Errorf("IntegratedLoudness() with silence = %v, want %v", padded, base)
+	}
+}
+
+func TestLoudnessChainWeightingHook(t *testing.T) {
+	called := false
+	chain := LoudnessChain{Weighting: func(in []float64) []float64 {
+		called = true
+		return in
+	}}
+	in := sine(1000, 1, LoudnessSampleRate, LoudnessSampleRate)
+	loudness, err := chain.IntegratedLoudness([][]float64{in}, LoudnessSampleRate)
+	if err != nil {
+		t.Fatalf("IntegratedLoudness() returned unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("IntegratedLoudness() did not call the weighting hook")
+	}
+	// Unweighted mean square of a unit sine is 0.5
+	if want := -0.691 + 10*math.Log10(0.5); math.Abs(loudness-want) > 0.01 {
+		t.Errorf("IntegratedLoudness() unweighted = %v, want %v", loudness, want)
+	}
+}
+
+func TestIntegratedLoudnessErrors(t *testing.T) {
+	if _, err := IntegratedLoudness(nil, 48000); err == nil {
+		t.Error("IntegratedLoudness() without channels should return an error")
+	}
+	if _, err := IntegratedLoudness([][]float64{{1, 2}, {1}}, 48000); err == nil {
+		t.Error("IntegratedLoudness() with mismatched channels should return an error")
+	}
+}
@@ -0,0 +1,85 @@
+package interpolators
+
+// LTTBIndices selects outSamples indices of in with the Largest-Triangle-Three-
+// Buckets algorithm, treating the index as the x coordinate. The first and last
+// samples are always kept; the rest are split into equal buckets and from each the
+// sample forming the largest triangle with the previously selected sample and the
+// mean of the next bucket is chosen. The indices are increasing. If outSamples is
+// at least len(in), every index is returned.
+func LTTBIndices(in []float64, outSamples int) []int {
+	n := len(in)
+	if outSamples >= n {
+		idx := make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx
+	}
+	if outSamples <= 0 {
+		return []int{}
+	}
+	if outSamples == 1 {
+		return []int{0}
+	}
+	if outSamples == 2 {
+		return []int{0, n - 1}
+	}
+
+	idx := make([]int, 0, outSamples)
+	idx = append(idx, 0)
+	buckets := outSamples - 2
+	// Bucket b covers the interior samples [bucketStart(b), bucketStart(b+1))
+	bucketStart := func(b int) int { return 1 + b*(n-2)/buckets }
+	prev := 0
+	for b := 0; b < buckets; b++ {
+		start, end := bucketStart(b), bucketStart(b+1)
+
+		// Mean of the next bucket, or the last sample for the final bucket
+		nextStart, nextEnd := end, bucketStart(b+2)
+		if b == buckets-1 {
+			nextStart, nextEnd = n-1, n
+		}
+		avgX, avgY := 0.0, 0.0
+		for j := nextStart; j < nextEnd; j++ {
+			avgX += float64(j)
+			avgY += in[j]
+		}
+		count := float64(nextEnd - nextStart)
+		avgX /= count
+		avgY /= count
+
+		best, bestArea := start, -1.0
+		px, py := float64(prev), in[prev]
+		for j := start; j < end; j++ {
+			// Twice the triangle area; the factor does not affect the maximum
+			area := (px-avgX)*(in[j]-py) - (px-float64(j))*(avgY-py)
+			if area < 0 {
+				area = -area
+			}
+			if area > bestArea {
+				best, bestArea = j, area
+			}
+		}
+		idx = append(idx, best)
+		prev = best
+	}
+	return append(idx, n-1)
+}
+
+// lttbInterpolate returns the values at the LTTB indices. Upsampling has no shape
+// to preserve, so it falls back to linear interpolation.
+func lttbInterpolate(in []float64, outSamples int) []float64 {
+	if len(in) == 0 {
+		return []float64{}
+	}
+	if outSamples > len(in) {
+		return linearInterpolate(in, outSamples)
+	}
+
+	idx := LTTBIndices(in, outSamples)
+	out := make([]float64, len(idx))
+	for i, j := range idx {
+		out[i] = in[j]
+	}
+	return out
+}
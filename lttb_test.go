@@ -0,0 +1,67 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLTTBIndices(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      []float64
+		outSamples int
+		expected   []int
+	}{
+		{"empty", []float64{}, 3, []int{}},
+		{"no reduction", []float64{1, 2, 3}, 5, []int{0, 1, 2}},
+		{"single", []float64{1, 2, 3}, 1, []int{0}},
+		{"endpoints", []float64{1, 2, 3}, 2, []int{0, 2}},
+		// One bucket per interior sample pair; the spikes win their buckets
+		{"keeps spikes", []float64{0, 0, 9, 0, 0, -9, 0, 0}, 4, []int{0, 2, 5, 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LTTBIndices(tt.input, tt.outSamples)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("LTTBIndices() = %v, want %v", got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Fatalf("LTTBIndices() = %v, want %v", got, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolateLTTB(t *testing.T) {
+	// A single narrow spike survives a 100x reduction
+	in := make([]float64, 100000)
+	for i := range in {
+		in[i] = math.Sin(float64(i) / 5000)
+	}
+	in[54321] = 10
+	out, err := Interpolate(in, 1000, LTTB)
+	if err != nil {
+		t.Fatalf("Interpolate() returned unexpected error: %v", err)
+	}
+	if len(out) != 1000 {
+		t.Fatalf("Interpolate() length = %d, want 1000", len(out))
+	}
+	peak := math.Inf(-1)
+	for _, v := range out {
+		peak = math.Max(peak, v)
+	}
+	if peak != 10 {
+		t.Errorf("Interpolate() peak = %v, want 10", peak)
+	}
+	if out[0] != in[0] || out[999] != in[len(in)-1] {
+		t.Errorf("Interpolate() endpoints = %v, %v, want %v, %v", out[0], out[999], in[0], in[len(in)-1])
+	}
+
+	// Upsampling falls back to linear interpolation
+	up, _ := Interpolate([]float64{0, 2}, 3, LTTB)
+	if up[1] != 1 {
+		t.Errorf("Interpolate() upsampled midpoint = %v, want 1", up[1])
+	}
+}
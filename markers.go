@@ -0,0 +1,63 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// ResampleWithMarkers converts in from sample rate srIn to srOut like Resample and
+// remaps markers such as loop and cue points, given as input sample indices, onto
+// the output. Resample places output sample k at input position k*srIn/srOut, so a
+// marker at index m lands at output position m*srOut/srIn, which is rounded to the
+// nearest output index. Markers may range from 0 to len(in), allowing exclusive
+// loop ends, and len(in) maps to len(out).
+func ResampleWithMarkers(in []float64, srIn, srOut float64, markers []int, interpolatorType InterpolatorType) (out []float64, remapped []int, err error) {
+	out, err = Resample(in, srIn, srOut, interpolatorType)
+	if err != nil {
+		return nil, nil, err
+	}
+	remapped, err = remapMarkers(markers, len(in), len(out), srOut/srIn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, remapped, nil
+}
+
+// InterpolateWithMarkers interpolates in to outSamples like Interpolate and remaps
+// markers onto the output. Interpolate aligns the first and last samples, so a
+// marker at index m lands at output position m*(outSamples-1)/(len(in)-1), which
+// is rounded to the nearest output index. Markers may range from 0 to len(in), and
+// the exclusive end len(in) maps to len(out).
+func InterpolateWithMarkers(in []float64, outSamples int, markers []int, interpolatorType InterpolatorType) (out []float64, remapped []int, err error) {
+	out, err = Interpolate(in, outSamples, interpolatorType)
+	if err != nil {
+		return nil, nil, err
+	}
+	scale := 1.0
+	if len(in) > 1 && interpolatorType != None {
+		scale = float64(outSamples-1) / float64(len(in)-1)
+	}
+	remapped, err = remapMarkers(markers, len(in), len(out), scale)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, remapped, nil
+}
+
+// remapMarkers scales marker indices into a signal of n samples by scale and
+// rounds them to the nearest index of an output of outLen samples. The exclusive
+// end n maps to outLen, and the other markers stay within the output.
+func remapMarkers(markers []int, n, outLen int, scale float64) ([]int, error) {
+	remapped := make([]int, len(markers))
+	for i, m := range markers {
+		if m < 0 || m > n {
+			return nil, fmt.Errorf("marker %d at %d lies outside [0, %d]", i, m, n)
+		}
+		if m == n {
+			remapped[i] = outLen
+			continue
+		}
+		remapped[i] = min(int(math.Round(float64(m)*scale)), outLen-1)
+	}
+	return remapped, nil
+}
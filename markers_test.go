@@ -0,0 +1,75 @@
+package interpolators
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestResampleWithMarkers(t *testing.T) {
+	// An impulse at each marker lands on the remapped output index
+	in := make([]float64, 1000)
+	markers := []int{0, 147, 441, 882}
+	for _, m := range markers {
+		in[m] = 1
+	}
+	out, remapped, err := ResampleWithMarkers(in, 44100, 48000, markers, Linear)
+	if err != nil {
+		t.Fatalf("ResampleWithMarkers() returned unexpected error: %v", err)
+	}
+	if want := []int{0, 160, 480, 960}; !reflect.DeepEqual(remapped, want) {
+		t.Errorf("ResampleWithMarkers() markers = %v, want %v", remapped, want)
+	}
+	for _, k := range remapped {
+		if math.Abs(out[k]-1) > 1e-12 {
+			t.Errorf("output at remapped marker %d = %v, want 1", k, out[k])
+		}
+	}
+
+	// Exclusive loop ends map to the matching exclusive end
+	_, remapped, _ = ResampleWithMarkers(in, 48000, 24000, []int{1000}, Linear)
+	if remapped[0] != 500 {
+		t.Errorf("ResampleWithMarkers() loop end = %d, want 500", remapped[0])
+	}
+	out, remapped, _ = ResampleWithMarkers(in, 24000, 48000, []int{999, 1000}, Linear)
+	if want := []int{len(out) - 1, len(out)}; !reflect.DeepEqual(remapped, want) {
+		t.Errorf("ResampleWithMarkers() last sample and loop end = %v, want %v", remapped, want)
+	}
+}
+
+func TestInterpolateWithMarkers(t *testing.T) {
+	in := []float64{0, 1, 2, 3, 4}
+	markers := []int{0, 1, 3, 4}
+	out, remapped, err := InterpolateWithMarkers(in, 9, markers, Linear)
+	if err != nil {
+		t.Fatalf("InterpolateWithMarkers() returned unexpected error: %v", err)
+	}
+	want := []int{0, 2, 6, 8}
+	if !reflect.DeepEqual(remapped, want) {
+		t.Errorf("InterpolateWithMarkers() markers = %v, want %v", remapped, want)
+	}
+	for i, k := range remapped {
+		if out[k] != in[markers[i]] {
+			t.Errorf("output at remapped marker %d = %v, want %v", k, out[k], in[markers[i]])
+		}
+	}
+
+	// The exclusive end maps to the end of the output, not past it
+	out, remapped, _ = InterpolateWithMarkers(in, 9, []int{5}, Linear)
+	if remapped[0] != len(out) {
+		t.Errorf("InterpolateWithMarkers() loop end = %d, want %d", remapped[0], len(out))
+	}
+}
+
+func TestMarkersErrors(t *testing.T) {
+	in := []float64{0, 1, 2}
+	if _, _, err := ResampleWithMarkers(in, 1, 2, []int{4}, Linear); err == nil {
+		t.Error("ResampleWithMarkers() with a marker past the end should return an error")
+	}
+	if _, _, err := InterpolateWithMarkers(in, 5, []int{-1}, Linear); err == nil {
+		t.Error("InterpolateWithMarkers() with a negative marker should return an error")
+	}
+	if _, _, err := ResampleWithMarkers(in, 0, 2, nil, Linear); err == nil {
+		t.Error("ResampleWithMarkers() with a zero rate should return an error")
+	}
+}
@@ -0,0 +1,53 @@
+package interpolators
+
+import "math"
+
+// MatchGrids puts two differently sampled series onto a shared grid for point-wise
+// comparison. The grid is the sorted union of x1 and x2 restricted to the range
+// both series cover, so neither series is extrapolated. a and b hold the two series
+// evaluated on the grid. Invalid coordinates (mismatched lengths or not strictly
+// increasing) or series that do not overlap produce empty results.
+func MatchGrids(x1, y1, x2, y2 []float64, interpolatorType InterpolatorType) (common []float64, a, b []float64) {
+	if checkXY(x1, y1) != nil || checkXY(x2, y2) != nil || len(x1) == 0 || len(x2) == 0 {
+		return []float64{}, []float64{}, []float64{}
+	}
+
+	lo := math.Max(x1[0], x2[0])
+	hi := math.Min(x1[len(x1)-1], x2[len(x2)-1])
+	common = []float64{}
+	i, j := 0, 0
+	for i < len(x1) || j < len(x2) {
+		var v float64
+		switch {
+		case j >= len(x2) || (i < len(x1) && x1[i] < x2[j]):
+			v = x1[i]
+			i++
+		case i >= len(x1) || x2[j] < x1[i]:
+			v = x2[j]
+			j++
+		default:
+			v = x1[i]
+			i++
+			j++
+		}
+		if v >= lo && v <= hi {
+			common = append(common, v)
+		}
+	}
+
+	a, b, _ = MatchGridsOn(x1, y1, x2, y2, common, interpolatorType)
+	return common, a, b
+}
+
+// MatchGridsOn evaluates two differently sampled series on a caller-specified grid
+func MatchGridsOn(x1, y1, x2, y2, grid []float64, interpolatorType InterpolatorType) (a, b []float64, err error) {
+	a, err = InterpolateXY(x1, y1, grid, interpolatorType)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err = InterpolateXY(x2, y2, grid, interpolatorType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, b, nil
+}
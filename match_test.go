@@ -0,0 +1,50 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMatchGrids(t *testing.T) {
+	x1 := []float64{0, 1, 2, 3, 4}
+	y1 := []float64{0, 1, 2, 3, 4}
+	x2 := []float64{0.5, 1.5, 3, 5}
+	y2 := []float64{1, 3, 6, 10}
+
+	common, a, b := MatchGrids(x1, y1, x2, y2, Linear)
+	expectedGrid := []float64{0.5, 1, 1.5, 2, 3, 4}
+	if len(common) != len(expectedGrid) {
+		t.Fatalf("MatchGrids() grid = %v, want %v", common, expectedGrid)
+	}
+	for i := range expectedGrid {
+		if common[i] != expectedGrid[i] {
+			t.Errorf("MatchGrids() grid[%d] = %v, want %v", i, common[i], expectedGrid[i])
+		}
+		if math.Abs(a[i]-common[i]) > 1e-10 {
+			t.Errorf("MatchGrids() a[%d] = %v, want %v", i, a[i], common[i])
+		}
+		if math.Abs(b[i]-2*common[i]) > 1e-10 {
+			t.Errorf("MatchGrids() b[%d] = %v, want %v", i, b[i], 2*common[i])
+		}
+	}
+}
+
+func TestMatchGridsNoOverlap(t *testing.T) {
+	common, a, b := MatchGrids([]float64{0, 1}, []float64{0, 1}, []float64{2, 3}, []float64{0, 1}, Linear)
+	if len(common) != 0 || len(a) != 0 || len(b) != 0 {
+		t.Errorf("MatchGrids() without overlap = %v %v %v, want empty", common, a, b)
+	}
+}
+
+func TestMatchGridsOn(t *testing.T) {
+	a, b, err := MatchGridsOn([]float64{0, 2}, []float64{0, 2}, []float64{0, 4}, []float64{4, 0}, []float64{1}, Linear)
+	if err != nil {
+		t.Fatalf("MatchGridsOn() returned unexpected error: %v", err)
+	}
+	if a[0] != 1 || b[0] != 3 {
+		t.Errorf("MatchGridsOn() = %v %v, want [1] [3]", a, b)
+	}
+	if _, _, err := MatchGridsOn([]float64{1, 0}, []float64{0, 1}, nil, nil, nil, Linear); err == nil {
+		t.Error("MatchGridsOn() with unsorted coordinates should return an error")
+	}
+}
@@ -0,0 +1,249 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+)
+
+// errSingularMatrix is returned when a linear system has no unique solution
+var errSingularMatrix = errors.New("matrix is singular")
+
+// newMatrix allocates a rows x cols matrix of zeros
+func newMatrix(rows, cols int) [][]float64 {
+	m := make([][]float64, rows)
+	for i := range m {
+		m[i] = make([]float64, cols)
+	}
+	return m
+}
+
+// identity returns the n x n identity matrix
+func identity(n int) [][]float64 {
+	m := newMatrix(n, n)
+	for i := range m {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// matMul returns the product a*b
+func matMul(a, b [][]float64) [][]float64 {
+	out := newMatrix(len(a), len(b[0]))
+	for i := range a {
+		for k, aik := range a[i] {
+			if aik == 0 {
+				continue
+			}
+			for j, bkj := range b[k] {
+				out[i][j] += aik * bkj
+			}
+		}
+	}
+	return out
+}
+
+// matVec returns the product m*v
+func matVec(m [][]float64, v []float64) []float64 {
+	out := make([]float64, len(m))
+	for i, row := range m {
+		for j, mij := range row {
+			out[i] += mij * v[j]
+		}
+	}
+	return out
+}
+
+// transpose returns the transpose of m
+func transpose(m [][]float64) [][]float64 {
+	out := newMatrix(len(m[0]), len(m))
+	for i, row := range m {
+		for j, v := range row {
+			out[j][i] = v
+		}
+	}
+	return out
+}
+
+// matAdd returns a + scale*b
+func matAdd(a, b [][]float64, scale float64) [][]float64 {
+	out := newMatrix(len(a), len(a[0]))
+	for i := range a {
+		for j := range a[i] {
+			out[i][j] = a[i][j] + scale*b[i][j]
+		}
+	}
+	return out
+}
+
+// invert returns the inverse of the square matrix m by Gauss-Jordan elimination
+// with partial pivoting
+func invert(m [][]float64) ([][]float64, error) {
+	n := len(m)
+	a := newMatrix(n, 2*n)
+	for i := range m {
+		copy(a[i], m[i])
+		a[i][n+i] = 1
+	}
+	if err := gaussJordan(a); err != nil {
+		return nil, err
+	}
+	inv := newMatrix(n, n)
+	for i := range inv {
+		copy(inv[i], a[i][n:])
+	}
+	return inv, nil
+}
+
+// solveLinear solves m*x = b by Gaussian elimination with partial pivoting
+func solveLinear(m [][]float64, b []float64) ([]float64, error) {
+	n := len(m)
+	a := newMatrix(n, n+1)
+	for i := range m {
+		copy(a[i], m[i])
+		a[i][n] = b[i]
+	}
+	if err := gaussJordan(a); err != nil {
+		return nil, err
+	}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = a[i][n]
+	}
+	return x, nil
+}
+
+// gaussJordan reduces the left square block of the augmented matrix a to the
+// identity in place
+func gaussJordan(a [][]float64) error {
+	n := len(a)
+	scale := 0.0
+	for _, row := range a {
+		for _, v := range row[:n] {
+			scale = math.Max(scale, math.Abs(v))
+		}
+	}
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) <= 1e-14*scale || scale == 0 {
+			return errSingularMatrix
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		p := a[col][col]
+		for j := range a[col] {
+			a[col][j] /= p
+		}
+		for r := range a {
+			if r == col || a[r][col] == 0 {
+				continue
+			}
+			f := a[r][col]
+			for j := range a[r] {
+				a[r][j] -= f * a[col][j]
+			}
+		}
+	}
+	return nil
+}
+
+// cholesky returns the lower-triangular factor L of the symmetric positive-definite
+// matrix m, with m = L*Lᵀ
+func cholesky(m [][]float64) ([][]float64, error) {
+	n := len(m)
+	l := newMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := m[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, errSingularMatrix
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
+
+// forwardSubstitute solves L*x = b for lower-triangular L
+func forwardSubstitute(l [][]float64, b []float64) []float64 {
+	x := make([]float64, len(b))
+	for i := range x {
+		sum := b[i]
+		for k := 0; k < i; k++ {
+			sum -= l[i][k] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
+
+// choleskySolve solves m*x = b given the Cholesky factor L of m
+func choleskySolve(l [][]float64, b []float64) []float64 {
+	y := forwardSubstitute(l, b)
+	// Back substitution with Lᵀ
+	x := make([]float64, len(y))
+	for i := len(x) - 1; i >= 0; i-- {
+		sum := y[i]
+		for k := i + 1; k < len(x); k++ {
+			sum -= l[k][i] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
+
+// bandSolve solves m*x = b for a symmetric positive-definite band matrix given by
+// its diagonal and the diagonals above it, with band[i][d] = m[i][i+d], in O(n·w²)
+// for a bandwidth of w
+func bandSolve(band [][]float64, b []float64) ([]float64, error) {
+	n := len(band)
+	if n == 0 {
+		return nil, nil
+	}
+	w := len(band[0]) - 1
+	// l[i][d] holds L[i][i-d] of the Cholesky factor, which shares the bandwidth
+	l := newMatrix(n, w+1)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-w); j <= i; j++ {
+			sum := band[j][i-j]
+			for k := max(0, i-w); k < j; k++ {
+				sum -= l[i][i-k] * l[j][j-k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, errSingularMatrix
+				}
+				l[i][0] = math.Sqrt(sum)
+			} else {
+				l[i][i-j] = sum / l[j][0]
+			}
+		}
+	}
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[i]
+		for k := max(0, i-w); k < i; k++ {
+			sum -= l[i][i-k] * x[k]
+		}
+		x[i] = sum / l[i][0]
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for k := i + 1; k <= min(n-1, i+w); k++ {
+			sum -= l[k][k-i] * x[k]
+		}
+		x[i] = sum / l[i][0]
+	}
+	return x, nil
+}
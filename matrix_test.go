@@ -0,0 +1,91 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolveLinear(t *testing.T) {
+	m := [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}
+	b := []float64{7, 6, 4}
+	x, err := solveLinear(m, b)
+	if err != nil {
+		t.Fatalf("solveLinear() returned unexpected error: %v", err)
+	}
+	expected := []float64{1, 2, 3}
+	for i := range expected {
+		if math.Abs(x[i]-expected[i]) > 1e-12 {
+			t.Errorf("solveLinear()[%d] = %v, want %v", i, x[i], expected[i])
+		}
+	}
+}
+
+func TestInvert(t *testing.T) {
+	m := [][]float64{{4, 7}, {2, 6}}
+	inv, err := invert(m)
+	if err != nil {
+		t.Fatalf("invert() returned unexpected error: %v", err)
+	}
+	product := matMul(m, inv)
+	for i := range product {
+		for j := range product[i] {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(product[i][j]-want) > 1e-12 {
+				t.Errorf("m*inv[%d][%d] = %v, want %v", i, j, product[i][j], want)
+			}
+		}
+	}
+}
+
+func TestSingularMatrix(t *testing.T) {
+	if _, err := solveLinear([][]float64{{1, 2}, {2, 4}}, []float64{1, 2}); err != errSingularMatrix {
+		t.Errorf("solveLinear() on singular matrix error = %v, want %v", err, errSingularMatrix)
+	}
+}
+
+func TestCholeskySolve(t *testing.T) {
+	m := [][]float64{{4, 2, 0.4}, {2, 5, 1}, {0.4, 1, 3}}
+	l, err := cholesky(m)
+	if err != nil {
+		t.Fatalf("cholesky() returned unexpected error: %v", err)
+	}
+	x := choleskySolve(l, []float64{1, 2, 3})
+	got := matVec(m, x)
+	for i, want := range []float64{1, 2, 3} {
+		if math.Abs(got[i]-want) > 1e-12 {
+			t.Errorf("m*x[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+	if _, err := cholesky([][]float64{{1, 2}, {2, 1}}); err == nil {
+		t.Error("cholesky() of an indefinite matrix should return an error")
+	}
+}
+
+func TestBandSolve(t *testing.T) {
+	// Pentadiagonal matrix with band[i][d] = m[i][i+d]
+	band := [][]float64{{6, -4, 1}, {6, -4, 1}, {6, -4, 1}, {6, -4, 0}, {6, 0, 0}}
+	n := len(band)
+	m := newMatrix(n, n)
+	for i := range band {
+		for d, v := range band[i] {
+			if i+d < n {
+				m[i][i+d] = v
+				m[i+d][i] = v
+			}
+		}
+	}
+	want := []float64{1, -2, 3, 0.5, 4}
+	b := matVec(m, want)
+	x, err := bandSolve(band, b)
+	if err != nil {
+		t.Fatalf("bandSolve() returned unexpected error: %v", err)
+	}
+	for i := range want {
+		if math.Abs(x[i]-want[i]) > 1e-12 {
+			t.Errorf("bandSolve()[%d] = %v, want %v", i, x[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package interpolators
+
+// MinMaxDecimate splits in into buckets contiguous bins of equal width, as
+// AreaAverage does, and returns the minimum and maximum of each bin. Unlike point
+// sampling, every spike in the input survives in one of the two envelopes, which
+// is what waveform and oscilloscope views need when drawing one bin per pixel.
+func MinMaxDecimate(in []float64, buckets int) (min, max []float64) {
+	if len(in) == 0 || buckets <= 0 {
+		return []float64{}, []float64{}
+	}
+
+	min = make([]float64, buckets)
+	max = make([]float64, buckets)
+	for i := range min {
+		start, end := binRange(i, len(in), buckets)
+		lo, hi := minMaxIndices(in, start, end)
+		min[i], max[i] = in[lo], in[hi]
+	}
+	return min, max
+}
+
+// MinMaxInterleaved returns 2*buckets samples holding the minimum and maximum of
+// each bin in the order they occur in the input, so connecting them draws the
+// same outline as the full-resolution signal
+func MinMaxInterleaved(in []float64, buckets int) []float64 {
+	if len(in) == 0 || buckets <= 0 {
+		return []float64{}
+	}
+
+	out := make([]float64, 0, 2*buckets)
+	for i := 0; i < buckets; i++ {
+		start, end := binRange(i, len(in), buckets)
+		lo, hi := minMaxIndices(in, start, end)
+		if hi < lo {
+			lo, hi = hi, lo
+		}
+		out = append(out, in[lo], in[hi])
+	}
+	return out
+}
+
+// minMaxIndices returns the indices of the first minimum and maximum in in[start:end]
+func minMaxIndices(in []float64, start, end int) (lo, hi int) {
+	lo, hi = start, start
+	for j := start + 1; j < end; j++ {
+		if in[j] < in[lo] {
+			lo = j
+		}
+		if in[j] > in[hi] {
+			hi = j
+		}
+	}
+	return lo, hi
+}
@@ -0,0 +1,58 @@
+package interpolators
+
+import "testing"
+
+func TestMinMaxDecimate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []float64
+		buckets  int
+		min, max []float64
+	}{
+		{"empty", []float64{}, 3, []float64{}, []float64{}},
+		{"zero buckets", []float64{1}, 0, []float64{}, []float64{}},
+		{"two bins", []float64{1, 5, -2, 0, 3, 3}, 2, []float64{-2, 0}, []float64{5, 3}},
+		{"more bins than samples", []float64{1, 2}, 4, []float64{1, 1, 2, 2}, []float64{1, 1, 2, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			min, max := MinMaxDecimate(tt.input, tt.buckets)
+			if len(min) != len(tt.min) || len(max) != len(tt.max) {
+				t.Fatalf("MinMaxDecimate() = %v, %v, want %v, %v", min, max, tt.min, tt.max)
+			}
+			for i := range min {
+				if min[i] != tt.min[i] || max[i] != tt.max[i] {
+					t.Fatalf("MinMaxDecimate() = %v, %v, want %v, %v", min, max, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestMinMaxDecimateKeepsTransient(t *testing.T) {
+	in := make([]float64, 48000)
+	in[12345] = 1
+	in[30000] = -0.5
+	min, max := MinMaxDecimate(in, 100)
+	if max[12345*100/48000] != 1 {
+		t.Errorf("MinMaxDecimate() lost the positive spike")
+	}
+	if min[30000*100/48000] != -0.5 {
+		t.Errorf("MinMaxDecimate() lost the negative spike")
+	}
+}
+
+func TestMinMaxInterleaved(t *testing.T) {
+	// The maximum precedes the minimum in the first bin and follows it in the second
+	in := []float64{1, 5, -2, 0, 3, -1, 2, 4}
+	got := MinMaxInterleaved(in, 2)
+	want := []float64{5, -2, -1, 4}
+	if len(got) != len(want) {
+		t.Fatalf("MinMaxInterleaved() = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("MinMaxInterleaved() = %v, want %v", got, want)
+		}
+	}
+}
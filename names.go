@@ -0,0 +1,120 @@
+package interpolators
+
+import (
+	"fmt"
+	"strings"
+)
+
+// interpolatorNames holds the canonical name of each interpolator type, as used in
+// configuration files and command-line flags
+var interpolatorNames = map[InterpolatorType]string{
+	None:              "none",
+	DropSample:        "dropsample",
+	Linear:            "linear",
+	BSpline3:          "bspline3",
+	BSpline5:          "bspline5",
+	Lagrange4:         "lagrange4",
+	Lagrange6:         "lagrange6",
+	Watte:             "watte",
+	Parabolic2x:       "parabolic2x",
+	Osculating4:       "osculating4",
+	Osculating6:       "osculating6",
+	Hermite4:          "hermite4",
+	Hermite6_3:        "hermite6_3",
+	Hermite6_5:        "hermite6_5",
+	CubicSpline:       "cubicspline",
+	MonotonicCubic:    "monotoniccubic",
+	Lanczos2:          "lanczos2",
+	Lanczos3:          "lanczos3",
+	Bezier:            "bezier",
+	Akima:             "akima",
+	AreaAverage:       "areaaverage",
+	LTTB:              "lttb",
+	PreviousHold:      "previoushold",
+	NextHold:          "nexthold",
+	Keys:              "keys",
+	MitchellNetravali: "mitchellnetravali",
+	OMOMS:             "omoms",
+	Gaussian:          "gaussian",
+	LanczosN:          "lanczosn",
+	KaiserSinc:        "kaisersinc",
+	HannSinc:          "hannsinc",
+	HammingSinc:       "hammingsinc",
+	BlackmanSinc:      "blackmansinc",
+	Sinc:              "sinc",
+	BSplineN:          "bsplinen",
+	LagrangeN:         "lagrangen",
+	Barycentric:       "barycentric",
+	FloaterHormann:    "floaterhormann",
+	Cosine:            "cosine",
+	Smoothstep:        "smoothstep",
+	Smootherstep:      "smootherstep",
+	TensionSpline:     "tensionspline",
+	Hyman:             "hyman",
+	Schumaker:         "schumaker",
+	Optimal2x4:        "optimal2x4",
+	Optimal2x6:        "optimal2x6",
+	Optimal4x4:        "optimal4x4",
+	Optimal8x4:        "optimal8x4",
+	Optimal16x4:       "optimal16x4",
+	Optimal32x4:       "optimal32x4",
+	Optimal4x2:        "optimal4x2",
+	Optimal8x2:        "optimal8x2",
+	Optimal16x2:       "optimal16x2",
+	Optimal32x2:       "optimal32x2",
+	Optimal4x6:        "optimal4x6",
+	Optimal8x6:        "optimal8x6",
+	Optimal16x6:       "optimal16x6",
+	Optimal32x6:       "optimal32x6",
+}
+
+// String returns the canonical name of the interpolator type, e.g. "lanczos3"
+func (t InterpolatorType) String() string {
+	if name, ok := interpolatorNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("InterpolatorType(%d)", int(t))
+}
+
+// ParseInterpolatorType returns the interpolator type named s. Matching ignores
+// case, hyphens, underscores and spaces, so "Lanczos3", "cubic-spline" and
+// "Hermite6_3" are all accepted.
+func ParseInterpolatorType(s string) (InterpolatorType, error) {
+	key := normalizeName(s)
+	for t, name := range interpolatorNames {
+		if normalizeName(name) == key {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownInterpolator, s)
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical name
+func (t InterpolatorType) MarshalText() ([]byte, error) {
+	name, ok := interpolatorNames[t]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownInterpolator, int(t))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with ParseInterpolatorType
+func (t *InterpolatorType) UnmarshalText(text []byte) error {
+	parsed, err := ParseInterpolatorType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// normalizeName lowercases s and strips the separators ignored by ParseInterpolatorType
+func normalizeName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(s))
+}
@@ -0,0 +1,84 @@
+package interpolators
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestInterpolatorTypeStringRoundTrip(t *testing.T) {
+	for _, info := range All() {
+		typ := info.Type
+		name := typ.String()
+		parsed, err := ParseInterpolatorType(name)
+		if err != nil {
+			t.Fatalf("ParseInterpolatorType(%q) returned unexpected error: %v", name, err)
+		}
+		if parsed != typ {
+			t.Errorf("ParseInterpolatorType(%q) = %d, want %d", name, parsed, typ)
+		}
+	}
+}
+
+func TestParseInterpolatorType(t *testing.T) {
+	tests := []struct {
+		input string
+		want  InterpolatorType
+	}{
+		{"lanczos3", Lanczos3},
+		{"Hermite4", Hermite4},
+		{"hermite6_3", Hermite6_3},
+		{"Hermite6-5", Hermite6_5},
+		{"cubic-spline", CubicSpline},
+		{"Monotonic Cubic", MonotonicCubic},
+		{"LTTB", LTTB},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseInterpolatorType(tt.input)
+			if err != nil {
+				t.Fatalf("ParseInterpolatorType() returned unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseInterpolatorType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseInterpolatorType("sinc9000"); !errors.Is(err, ErrUnknownInterpolator) {
+		t.Errorf("ParseInterpolatorType() error = %v, want %v", err, ErrUnknownInterpolator)
+	}
+}
+
+func TestInterpolatorTypeJSON(t *testing.T) {
+	type config struct {
+		Interpolator InterpolatorType `json:"interpolator"`
+	}
+	data, err := json.Marshal(config{Interpolator: Hermite6_3})
+	if err != nil {
+		t.Fatalf("json.Marshal() returned unexpected error: %v", err)
+	}
+	if string(data) != `{"interpolator":"hermite6_3"}` {
+		t.Errorf("json.Marshal() = %s, want %s", data, `{"interpolator":"hermite6_3"}`)
+	}
+
+	var c config
+	if err := json.Unmarshal([]byte(`{"interpolator":"Lanczos2"}`), &c); err != nil {
+		t.Fatalf("json.Unmarshal() returned unexpected error: %v", err)
+	}
+	if c.Interpolator != Lanczos2 {
+		t.Errorf("json.Unmarshal() = %v, want %v", c.Interpolator, Lanczos2)
+	}
+	if err := json.Unmarshal([]byte(`{"interpolator":"bogus"}`), &c); err == nil {
+		t.Error("json.Unmarshal() of an unknown name should return an error")
+	}
+	if _, err := json.Marshal(config{Interpolator: InterpolatorType(999)}); err == nil {
+		t.Error("json.Marshal() of an unknown type should return an error")
+	}
+}
+
+func TestInterpolatorTypeStringUnknown(t *testing.T) {
+	if got := InterpolatorType(999).String(); got != "InterpolatorType(999)" {
+		t.Errorf("String() = %q, want %q", got, "InterpolatorType(999)")
+	}
+}
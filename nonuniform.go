@@ -0,0 +1,116 @@
+package interpolators
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InterpolateXY interpolates the samples y taken at the strictly increasing
+// coordinates x and evaluates the result at the coordinates xq. CubicSpline,
+// MonotonicCubic and Akima are fitted on the true coordinates; the kernel-based
+// interpolators are evaluated at the fractional index obtained by locating each
+// query between its neighbouring coordinates.
+func InterpolateXY(x, y, xq []float64, interpolatorType InterpolatorType) (out []float64, err error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+	f := newXYEvaluator(x, y, interpolatorType)
+	out = make([]float64, len(xq))
+	for i, q := range xq {
+		out[i] = f(q)
+	}
+	return out, nil
+}
+
+// checkXY validates a set of coordinates and their samples
+func checkXY(x, y []float64) error {
+	if len(x) != len(y) {
+		return fmt.Errorf("coordinate and sample lengths differ: %d and %d", len(x), len(y))
+	}
+	for i := 1; i < len(x); i++ {
+		if !(x[i] > x[i-1]) {
+			return fmt.Errorf("coordinates must be strictly increasing, x[%d] = %v follows %v", i, x[i], x[i-1])
+		}
+	}
+	return nil
+}
+
+// newXYEvaluator returns a function evaluating the interpolant of (x, y) at a coordinate
+func newXYEvaluator(x, y []float64, interpolatorType InterpolatorType) func(q float64) float64 {
+	n := len(x)
+	if n < 2 {
+		return newEvaluator(y, interpolatorType)
+	}
+
+	switch interpolatorType {
+	case CubicSpline:
+		a, b, c, d := cubicSplineCoefficients(x, y)
+		return func(q float64) float64 {
+			j := searchSegment(x, q)
+			dx := q - x[j]
+			return a[j] + b[j]*dx + c[j]*dx*dx + d[j]*dx*dx*dx
+		}
+	case MonotonicCubic:
+		m := monotonicCubicSlopes(x, y)
+		return func(q float64) float64 { return hermiteSegmentXY(x, y, m, q) }
+	case Akima:
+		m := akimaSlopes(x, y)
+		return func(q float64) float64 { return hermiteSegmentXY(x, y, m, q) }
+	case Hyman:
+		m := hymanSlopes(x, y)
+		return func(q float64) float64 { return hermiteSegmentXY(x, y, m, q) }
+	case PreviousHold, NextHold:
+		return func(q float64) float64 { return holdSegmentXY(x, y, q, interpolatorType) }
+	case Barycentric:
+		return newBarycentricXY(x, y).at
+	case FloaterHormann:
+		return newFloaterHormann(x, y, 0).at
+	case TensionSpline:
+		return newTensionSpline(x, y, 0).at
+	case Schumaker:
+		return newSchumaker(x, y).at
+	}
+
+	f := newEvaluator(y, interpolatorType)
+	return func(q float64) float64 { return f(fractionalIndex(x, q)) }
+}
+
+// searchSegment returns the index j of the segment [x[j], x[j+1]] containing q,
+// clamped to the first or last segment outside the coordinate range
+func searchSegment(x []float64, q float64) int {
+	j := sort.SearchFloat64s(x, q) - 1
+	if j < 0 {
+		j = 0
+	}
+	if j > len(x)-2 {
+		j = len(x) - 2
+	}
+	return j
+}
+
+// fractionalIndex maps a coordinate to a fractional sample index by linear
+// interpolation between the neighbouring coordinates
+func fractionalIndex(x []float64, q float64) float64 {
+	j := searchSegment(x, q)
+	return float64(j) + (q-x[j])/(x[j+1]-x[j])
+}
+
+// hermiteSegmentXY evaluates the cubic Hermite spline through (x, y) with slopes m at q
+func hermiteSegmentXY(x, y, m []float64, q float64) float64 {
+	j := searchSegment(x, q)
+	h := x[j+1] - x[j]
+	t := (q - x[j]) / h
+	t2 := t * t
+	t3 := t2 * t
+
+	// Hermite basis functions
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := t3 - 2*t2 + t
+	h01 := -2*t3 + 3*t2
+	h11 := t3 - t2
+
+	return h00*y[j] + h10*h*m[j] + h01*y[j+1] + h11*h*m[j+1]
+}
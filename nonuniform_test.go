@@ -0,0 +1,62 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateXYUniformMatchesInterpolateAt(t *testing.T) {
+	y := []float64{1, 3, 2, 5, 4, 6, 3}
+	x := make([]float64, len(y))
+	for i := range x {
+		// Uniform coordinates with a scale and offset
+		x[i] = 10 + 2*float64(i)
+	}
+	xq := []float64{10, 11.5, 13, 17.25, 22}
+	positions := []float64{0, 0.75, 1.5, 3.625, 6}
+
+	for typ := DropSample; typ <= Akima; typ++ {
+		got, err := InterpolateXY(x, y, xq, typ)
+		if err != nil {
+			t.Fatalf("InterpolateXY() returned unexpected error: %v", err)
+		}
+		want, _ := InterpolateAt(y, positions, typ)
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > 1e-9 {
+				t.Errorf("InterpolateXY(%d)[%d] = %v, want %v", typ, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestInterpolateXYNonUniform(t *testing.T) {
+	x := []float64{0, 1, 3, 6}
+	y := []float64{0, 2, 6, 12}
+	got, err := InterpolateXY(x, y, []float64{0.5, 2, 4.5}, Linear)
+	if err != nil {
+		t.Fatalf("InterpolateXY() returned unexpected error: %v", err)
+	}
+	expected := []float64{1, 4, 9}
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 1e-10 {
+			t.Errorf("InterpolateXY()[%d] = %v, want %v", i, got[i], expected[i])
+		}
+	}
+
+	// A cubic spline through points on a line stays on the line
+	got, _ = InterpolateXY(x, y, []float64{0.5, 2, 4.5}, CubicSpline)
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 1e-10 {
+			t.Errorf("InterpolateXY() CubicSpline[%d] = %v, want %v", i, got[i], expected[i])
+		}
+	}
+}
+
+func TestInterpolateXYErrors(t *testing.T) {
+	if _, err := InterpolateXY([]float64{0, 1}, []float64{1}, nil, Linear); err == nil {
+		t.Error("InterpolateXY() with mismatched lengths should return an error")
+	}
+	if _, err := InterpolateXY([]float64{0, 2, 1}, []float64{1, 2, 3}, nil, Linear); err == nil {
+		t.Error("InterpolateXY() with unsorted coordinates should return an error")
+	}
+}
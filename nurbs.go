@@ -0,0 +1,251 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// BSplineCurve is a B-spline curve of any degree in any number of dimensions,
+// defined by control points and a non-decreasing knot vector with one more knot
+// than the number of points plus the degree. With weights on the control points
+// it is a NURBS curve, which represents conics such as circles exactly. The curve
+// is defined for parameters from knot degree to knot len(points), see Domain.
+type BSplineCurve struct {
+	degree  int
+	knots   []float64
+	points  [][]float64
+	weights []float64
+}
+
+// NewBSplineCurve creates a polynomial B-spline curve of the given degree. A nil
+// knot vector selects ClampedKnots, so the curve starts at the first control
+// point and ends at the last.
+func NewBSplineCurve(degree int, controlPoints [][]float64, knots []float64) (*BSplineCurve, error) {
+	return NewNURBSCurve(degree, controlPoints, nil, knots)
+}
+
+// NewNURBSCurve creates a rational B-spline curve whose control points pull on
+// the curve in proportion to their positive weights. Nil weights are all 1, and
+// a nil knot vector selects ClampedKnots.
+func NewNURBSCurve(degree int, controlPoints [][]float64, weights, knots []float64) (*BSplineCurve, error) {
+	if degree < 1 {
+		return nil, fmt.Errorf("B-spline degree must be at least 1, got %d", degree)
+	}
+	if _, err := checkPoints(controlPoints); err != nil {
+		return nil, err
+	}
+	n := len(controlPoints)
+	if n < degree+1 {
+		return nil, fmt.Errorf("%w: a degree %d B-spline needs at least %d control points, got %d", ErrTooFewPoints, degree, degree+1, n)
+	}
+	if knots == nil {
+		knots = ClampedKnots(degree, n)
+	}
+	if len(knots) != n+degree+1 {
+		return nil, fmt.Errorf("a degree %d B-spline with %d control points needs %d knots, got %d", degree, n, n+degree+1, len(knots))
+	}
+	for i, k := range knots {
+		if math.IsNaN(k) || math.IsInf(k, 0) || (i > 0 && k < knots[i-1]) {
+			return nil, fmt.Errorf("knots must be finite and non-decreasing, got %v at index %d", k, i)
+		}
+	}
+	if !(knots[degree] < knots[n]) {
+		return nil, fmt.Errorf("knot vector has an empty domain [%v, %v]", knots[degree], knots[n])
+	}
+	for i := degree + 1; i < len(knots); i++ {
+		// An interior knot repeated more than the degree would split the curve
+		if knots[i-degree] == knots[i] && knots[i] > knots[degree] && knots[i] < knots[n] {
+			return nil, fmt.Errorf("knot %v is repeated more than the degree %d", knots[i], degree)
+		}
+	}
+	if weights == nil {
+		weights = make([]float64, n)
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	if len(weights) != n {
+		return nil, fmt.Errorf("weights have length %d, want one per control point (%d)", len(weights), n)
+	}
+	for i, w := range weights {
+		if !(w > 0) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("weights must be positive and finite, got %v at index %d", w, i)
+		}
+	}
+
+	points := make([][]float64, n)
+	for i, p := range controlPoints {
+		points[i] = append([]float64(nil), p...)
+	}
+	return &BSplineCurve{
+		degree:  degree,
+		knots:   append([]float64(nil), knots...),
+		points:  points,
+		weights: append([]float64(nil), weights...),
+	}, nil
+}
+
+// ClampedKnots returns the clamped uniform knot vector on [0, 1] for n control
+// points of the given degree: degree+1 zeros, evenly spaced interior knots and
+// degree+1 ones
+func ClampedKnots(degree, n int) []float64 {
+	if degree < 0 || n < degree+1 {
+		return nil
+	}
+	knots := make([]float64, n+degree+1)
+	spans := n - degree
+	for i := range knots {
+		knots[i] = math.Max(0, math.Min(1, float64(i-degree)/float64(spans)))
+	}
+	return knots
+}
+
+// Degree returns the polynomial degree of the curve
+func (c *BSplineCurve) Degree() int {
+	return c.degree
+}
+
+// Knots returns a copy of the knot vector
+func (c *BSplineCurve) Knots() []float64 {
+	return append([]float64(nil), c.knots...)
+}
+
+// ControlPoints returns a copy of the control points
+func (c *BSplineCurve) ControlPoints() [][]float64 {
+	out := make([][]float64, len(c.points))
+	for i, p := range c.points {
+		out[i] = append([]float64(nil), p...)
+	}
+	return out
+}
+
+// Weights returns a copy of the control point weights, all 1 for a polynomial
+// curve
+func (c *BSplineCurve) Weights() []float64 {
+	return append([]float64(nil), c.weights...)
+}
+
+// Domain returns the parameter range over which the curve is defined
+func (c *BSplineCurve) Domain() (lo, hi float64) {
+	return c.knots[c.degree], c.knots[len(c.points)]
+}
+
+// span returns the index k of the knot span [knots[k], knots[k+1]) holding u,
+// clamped to the domain so that its end belongs to the last non-empty span
+func (c *BSplineCurve) span(u float64) int {
+	n := len(c.points)
+	k := sort.Search(n-c.degree, func(i int) bool { return c.knots[c.degree+i+1] > u }) + c.degree
+	for k >= n || c.knots[k] == c.knots[k+1] {
+		k--
+	}
+	return k
+}
+
+// homogeneous returns control point i scaled by its weight, with the weight
+// appended as a final coordinate
+func (c *BSplineCurve) homogeneous(i int) []float64 {
+	w := c.weights[i]
+	h := make([]float64, len(c.points[i])+1)
+	for d, v := range c.points[i] {
+		h[d] = v * w
+	}
+	h[len(h)-1] = w
+	return h
+}
+
+// At returns the point of the curve at parameter u, clamped to the domain,
+// evaluated with de Boor's algorithm on the weighted control points
+func (c *BSplineCurve) At(u float64) []float64 {
+	lo, hi := c.Domain()
+	u = math.Max(lo, math.Min(hi, u))
+	p := c.degree
+	k := c.span(u)
+	d := make([][]float64, p+1)
+	for j := range d {
+		d[j] = c.homogeneous(j + k - p)
+	}
+	for r := 1; r <= p; r++ {
+		for j := p; j >= r; j-- {
+			left, right := c.knots[j+k-p], c.knots[j+1+k-r]
+			alpha := (u - left) / (right - left)
+			for i := range d[j] {
+				d[j][i] = (1-alpha)*d[j-1][i] + alpha*d[j][i]
+			}
+		}
+	}
+	out, _ := projectHomogeneous(d[p])
+	return out
+}
+
+// Resample returns outSamples points equally spaced in the parameter over the
+// domain, from the start of the curve to its end
+func (c *BSplineCurve) Resample(outSamples int) ([][]float64, error) {
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	lo, hi := c.Domain()
+	out := make([][]float64, outSamples)
+	for i := range out {
+		out[i] = c.At(lo + outputPosition(i, 2, outSamples)*(hi-lo))
+	}
+	return out, nil
+}
+
+// InsertKnot returns the same curve with the knot u added, using Boehm's
+// algorithm: one more control point and one more knot, so that later edits can
+// reshape the curve more locally. u must lie strictly inside the domain and may
+// not already be repeated as often as the degree.
+func (c *BSplineCurve) InsertKnot(u float64) (*BSplineCurve, error) {
+	lo, hi := c.Domain()
+	if !(u > lo && u < hi) {
+		return nil, fmt.Errorf("knot to insert must be inside (%v, %v), got %v", lo, hi, u)
+	}
+	multiplicity := 0
+	for _, k := range c.knots {
+		if k == u {
+			multiplicity++
+		}
+	}
+	p := c.degree
+	if multiplicity >= p {
+		return nil, fmt.Errorf("knot %v already has multiplicity %d, the degree", u, multiplicity)
+	}
+
+	k := c.span(u)
+	n := len(c.points)
+	points := make([][]float64, n+1)
+	weights := make([]float64, n+1)
+	for i := range points {
+		var h []float64
+		switch {
+		case i <= k-p:
+			h = c.homogeneous(i)
+		case i > k:
+			h = c.homogeneous(i - 1)
+		default:
+			alpha := (u - c.knots[i]) / (c.knots[i+p] - c.knots[i])
+			h = c.homogeneous(i)
+			prev := c.homogeneous(i - 1)
+			for d := range h {
+				h[d] = (1-alpha)*prev[d] + alpha*h[d]
+			}
+		}
+		points[i], weights[i] = projectHomogeneous(h)
+	}
+	knots := make([]float64, 0, len(c.knots)+1)
+	knots = append(knots, c.knots[:k+1]...)
+	knots = append(knots, u)
+	knots = append(knots, c.knots[k+1:]...)
+	return &BSplineCurve{degree: p, knots: knots, points: points, weights: weights}, nil
+}
+
+// projectHomogeneous splits a weighted point back into the point and its weight
+func projectHomogeneous(h []float64) ([]float64, float64) {
+	w := h[len(h)-1]
+	point := make([]float64, len(h)-1)
+	for d := range point {
+		point[d] = h[d] / w
+	}
+	return point, w
+}
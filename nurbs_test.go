@@ -0,0 +1,160 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBSplineCurveBezier(t *testing.T) {
+	// A clamped cubic with four control points is a single Bezier segment
+	control := [][]float64{{0, 0, 1}, {1, 3, 0}, {3, -1, 2}, {4, 2, 2}}
+	c, err := NewBSplineCurve(3, control, nil)
+	if err != nil {
+		t.Fatalf("NewBSplineCurve returned unexpected error: %v", err)
+	}
+	b, _ := NewBezierCurve(control)
+	for _, u := range []float64{0, 0.1, 0.35, 0.5, 0.8, 1} {
+		got, want := c.At(u), b.At(u)
+		for d := range want {
+			if math.Abs(got[d]-want[d]) > 1e-12 {
+				t.Errorf("At(%v) = %v, want %v", u, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestBSplineCurveUniform(t *testing.T) {
+	// At a knot of a uniform cubic B-spline the curve is (P0 + 4P1 + P2)/6, the
+	// BSpline3 kernel's weights
+	control := [][]float64{{0}, {6}, {0}, {12}, {-6}, {3}}
+	knots := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	c, err := NewBSplineCurve(3, control, knots)
+	if err != nil {
+		t.Fatalf("NewBSplineCurve returned unexpected error: %v", err)
+	}
+	if lo, hi := c.Domain(); lo != 3 || hi != 6 {
+		t.Errorf("Domain() = %v, %v, want 3, 6", lo, hi)
+	}
+	for i := 1; i+1 < len(control); i++ {
+		want := (control[i-1][0] + 4*control[i][0] + control[i+1][0]) / 6
+		if got := c.At(float64(i + 2)); math.Abs(got[0]-want) > 1e-12 {
+			t.Errorf("At(%d) = %v, want %v", i+2, got[0], want)
+		}
+	}
+}
+
+func TestNURBSCircle(t *testing.T) {
+	// Four rational quadratic arcs make an exact unit circle
+	s := math.Sqrt2 / 2
+	control := [][]float64{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}, {1, 0}}
+	weights := []float64{1, s, 1, s, 1, s, 1, s, 1}
+	knots := []float64{0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+	c, err := NewNURBSCurve(2, control, weights, knots)
+	if err != nil {
+		t.Fatalf("NewNURBSCurve returned unexpected error: %v", err)
+	}
+	points, _ := c.Resample(101)
+	for i, p := range points {
+		if r := math.Hypot(p[0], p[1]); math.Abs(r-1) > 1e-12 {
+			t.Errorf("point %d = %v has radius %v, want 1", i, p, r)
+		}
+	}
+	if got := c.At(2); math.Abs(got[0]+1) > 1e-12 || math.Abs(got[1]) > 1e-12 {
+		t.Errorf("At(2) = %v, want [-1 0]", got)
+	}
+}
+
+func TestBSplineCurveInsertKnot(t *testing.T) {
+	s := math.Sqrt2 / 2
+	control := [][]float64{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}}
+	c, err := NewNURBSCurve(2, control, []float64{1, s, 1, s, 1}, []float64{0, 0, 0, 1, 1, 2, 2, 2})
+	if err != nil {
+		t.Fatalf("NewNURBSCurve returned unexpected error: %v", err)
+	}
+	cubic, _ := NewBSplineCurve(3, [][]float64{{0, 0}, {1, 2}, {2, -1}, {4, 1}, {5, 3}, {7, 0}}, nil)
+	for _, tc := range []struct {
+		c     *BSplineCurve
+		knots []float64
+	}{
+		{c, []float64{0.3, 1.5, 1.5}},
+		{cubic, []float64{0.1, 0.5, 0.5, 0.5, 0.9}},
+	} {
+		curve := tc.c
+		for _, u := range tc.knots {
+			refined, err := curve.InsertKnot(u)
+			if err != nil {
+				t.Fatalf("InsertKnot(%v) returned unexpected error: %v", u, err)
+			}
+			if len(refined.ControlPoints()) != len(curve.ControlPoints())+1 || len(refined.Knots()) != len(curve.Knots())+1 {
+				t.Fatalf("InsertKnot(%v) gave %d points and %d knots", u, len(refined.ControlPoints()), len(refined.Knots()))
+			}
+			curve = refined
+		}
+		lo, hi := tc.c.Domain()
+		for i := 0; i <= 40; i++ {
+			u := lo + (hi-lo)*float64(i)/40
+			got, want := curve.At(u), tc.c.At(u)
+			for d := range want {
+				if math.Abs(got[d]-want[d]) > 1e-12 {
+					t.Errorf("refined curve at %v = %v, want %v", u, got, want)
+					break
+				}
+			}
+		}
+	}
+	// The cubic's knot 0.5 now has multiplicity 3, the degree
+	refined, _ := cubic.InsertKnot(0.5)
+	refined, _ = refined.InsertKnot(0.5)
+	if _, err := refined.InsertKnot(0.5); err != nil {
+		t.Fatalf("InsertKnot up to the degree returned unexpected error: %v", err)
+	}
+	refined, _ = refined.InsertKnot(0.25)
+	refined, _ = refined.InsertKnot(0.25)
+	refined, _ = refined.InsertKnot(0.25)
+	if _, err := refined.InsertKnot(0.25); err == nil {
+		t.Error("InsertKnot beyond the degree returned nil error")
+	}
+	for _, u := range []float64{0, 1, -0.5, math.NaN()} {
+		if _, err := cubic.InsertKnot(u); err == nil {
+			t.Errorf("InsertKnot(%v) returned nil error", u)
+		}
+	}
+}
+
+func TestBSplineCurveErrors(t *testing.T) {
+	line := [][]float64{{0}, {1}, {2}, {3}}
+	for _, tc := range []struct {
+		name    string
+		degree  int
+		points  [][]float64
+		weights []float64
+		knots   []float64
+	}{
+		{"degree 0", 0, line, nil, nil},
+		{"too few points", 4, line, nil, nil},
+		{"knot count", 2, line, nil, []float64{0, 0, 0, 1, 1, 1}},
+		{"decreasing knots", 2, line, nil, []float64{0, 0, 0, 0.6, 0.4, 1, 1}},
+		{"NaN knot", 2, line, nil, []float64{0, 0, 0, math.NaN(), 1, 1, 1}},
+		{"empty domain", 2, line, nil, []float64{0, 0, 1, 1, 1, 1, 1}},
+		{"interior multiplicity", 1, line, nil, []float64{0, 0, 0.5, 0.5, 1, 1}},
+		{"weight count", 2, line, []float64{1, 1, 1}, nil},
+		{"zero weight", 2, line, []float64{1, 0, 1, 1}, nil},
+		{"ragged points", 2, [][]float64{{0}, {1, 2}, {2}, {3}}, nil, nil},
+	} {
+		if _, err := NewNURBSCurve(tc.degree, tc.points, tc.weights, tc.knots); err == nil {
+			t.Errorf("%s: NewNURBSCurve returned nil error", tc.name)
+		}
+	}
+	if _, err := NewBSplineCurve(3, line[:2], nil); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("NewBSplineCurve with 2 points returned %v, want ErrTooFewPoints", err)
+	}
+	c, _ := NewBSplineCurve(1, line, nil)
+	if _, err := c.Resample(0); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("Resample(0) returned %v, want ErrInvalidOutSamples", err)
+	}
+	if got := ClampedKnots(2, 5); len(got) != 8 || got[2] != 0 || got[3] != 1.0/3 || got[4] != 2.0/3 || got[5] != 1 {
+		t.Errorf("ClampedKnots(2, 5) = %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// octaveRatio is the base-ten octave frequency ratio G = 10^(3/10) of IEC 61260-1
+var octaveRatio = math.Pow(10, 0.3)
+
+// octaveReference is the reference frequency all band centers are derived from
+const octaveReference = 1000.0
+
+// OctaveBand is one fractional-octave band and the power it contains
+type OctaveBand struct {
+	Lower, Center, Upper float64
+	// Power is the integrated power of the spectrum within the band
+	Power float64
+}
+
+// OctaveBands aggregates a narrowband power spectral density measured at the
+// strictly increasing frequencies freqs into 1/fraction-octave bands, e.g. 1, 3,
+// 6 or 12 for octave, third-octave, sixth-octave and twelfth-octave bands. Band
+// centers and edges follow the base-ten definition of IEC 61260-1, anchored at
+// 1 kHz. The density is interpolated and integrated across each band as in
+// RebinPSD, so narrowband bins straddling a band edge are split between the two
+// bands by frequency. Only bands lying entirely within the measured range are
+// returned, in increasing frequency order; a sample at DC does not extend the
+// range below the first positive frequency.
+func OctaveBands(freqs, psd []float64, fraction int, interpolatorType InterpolatorType) ([]OctaveBand, error) {
+	if fraction <= 0 {
+		return nil, fmt.Errorf("band fraction must be positive, got %d", fraction)
+	}
+	if err := checkXY(freqs, psd); err != nil {
+		return nil, err
+	}
+	if len(freqs) < 2 {
+		return nil, fmt.Errorf("at least two frequencies are required")
+	}
+
+	// Bands far below the first positive frequency would only see the
+	// interpolation between it and DC, so a DC sample does not extend the range
+	lo := freqs[0]
+	if lo <= 0 {
+		lo = freqs[1]
+	}
+	if lo <= 0 {
+		return nil, fmt.Errorf("frequencies must include a positive value")
+	}
+	hi := freqs[len(freqs)-1]
+	b := float64(fraction)
+	center := func(x int) float64 {
+		if fraction%2 == 1 {
+			return octaveReference * math.Pow(octaveRatio, float64(x)/b)
+		}
+		return octaveReference * math.Pow(octaveRatio, float64(2*x+1)/(2*b))
+	}
+	halfWidth := math.Pow(octaveRatio, 1/(2*b))
+
+	// First band whose lower edge is at or above lo
+	first := int(math.Floor(b*math.Log(lo/octaveReference)/math.Log(octaveRatio))) - 2
+	for center(first)/halfWidth < lo {
+		first++
+	}
+
+	var bands []OctaveBand
+	edges := []float64{}
+	for x := first; center(x)*halfWidth <= hi; x++ {
+		c := center(x)
+		if len(edges) == 0 {
+			edges = append(edges, c/halfWidth)
+		}
+		// Adjacent bands share an edge exactly
+		edges = append(edges, c*halfWidth)
+		bands = append(bands, OctaveBand{Lower: edges[len(edges)-2], Center: c, Upper: c * halfWidth})
+	}
+	if len(bands) == 0 {
+		return []OctaveBand{}, nil
+	}
+
+	density, err := RebinPSD(freqs, psd, edges, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bands {
+		bands[i].Power = density[i] * (edges[i+1] - edges[i])
+	}
+	return bands, nil
+}
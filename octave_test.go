@@ -0,0 +1,95 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOctaveBandsCenters(t *testing.T) {
+	freqs := []float64{0, 10, 24000}
+	psd := []float64{1, 1, 1}
+
+	bands, err := OctaveBands(freqs, psd, 1, Linear)
+	if err != nil {
+		t.Fatalf("OctaveBands() returned unexpected error: %v", err)
+	}
+	// Nominal octave centers 16 Hz .. 16 kHz; exact base-ten values differ slightly
+	nominal := []float64{16, 31.5, 63, 125, 250, 500, 1000, 2000, 4000, 8000, 16000}
+	if len(bands) != len(nominal) {
+		t.Fatalf("OctaveBands() returned %d bands, want %d", len(bands), len(nominal))
+	}
+	for i, band := range bands {
+		if math.Abs(band.Center-nominal[i])/nominal[i] > 0.03 {
+			t.Errorf("band %d center = %v, want about %v", i, band.Center, nominal[i])
+		}
+		// A flat unit density gives power equal to the bandwidth
+		if math.Abs(band.Power-(band.Upper-band.Lower)) > 1e-9*band.Upper {
+			t.Errorf("band %d power = %v, want %v", i, band.Power, band.Upper-band.Lower)
+		}
+		if i > 0 && band.Lower != bands[i-1].Upper {
+			t.Errorf("band %d lower edge %v does not meet previous upper edge %v", i, band.Lower, bands[i-1].Upper)
+		}
+	}
+
+	third, _ := OctaveBands(freqs, psd, 3, Linear)
+	if len(third) < 3*len(bands) {
+		t.Errorf("OctaveBands() third-octave count = %d, want at least %d", len(third), 3*len(bands))
+	}
+	found := false
+	for _, band := range third {
+		found = found || band.Center == 1000
+	}
+	if !found {
+		t.Error("OctaveBands() with fraction 3 has no band centered on 1 kHz")
+	}
+}
+
+func TestOctaveBandsEvenFraction(t *testing.T) {
+	bands, err := OctaveBands([]float64{100, 10000}, []float64{1, 1}, 6, Linear)
+	if err != nil {
+		t.Fatalf("OctaveBands() returned unexpected error: %v", err)
+	}
+	// Even fractions place 1 kHz on a band edge rather than a center
+	found := false
+	for _, band := range bands {
+		if math.Abs(band.Lower-1000) < 1e-9 {
+			found = true
+		}
+		if band.Lower < 100 || band.Upper > 10000 {
+			t.Errorf("band [%v, %v] extends beyond the measured range", band.Lower, band.Upper)
+		}
+	}
+	if !found {
+		t.Error("OctaveBands() with fraction 6 has no band edge at 1 kHz")
+	}
+}
+
+func TestOctaveBandsSplitsTone(t *testing.T) {
+	// A narrow line at the edge between the 1 kHz and 2 kHz octaves is split
+	// between them by frequency
+	edge := 1000 * math.Pow(octaveRatio, 0.5)
+	freqs := []float64{10, edge - 1, edge, edge + 1, 20000}
+	psd := []float64{0, 0, 2, 0, 0}
+	bands, _ := OctaveBands(freqs, psd, 1, Linear)
+	var below, above float64
+	for _, band := range bands {
+		switch band.Center {
+		case 1000:
+			below = band.Power
+		case 1000 * octaveRatio:
+			above = band.Power
+		}
+	}
+	if math.Abs(below-1) > 1e-9 || math.Abs(above-1) > 1e-9 {
+		t.Errorf("split tone power = %v and %v, want 1 and 1", below, above)
+	}
+}
+
+func TestOctaveBandsInvalidInput(t *testing.T) {
+	if _, err := OctaveBands([]float64{1, 2}, []float64{1, 1}, 0, Linear); err == nil {
+		t.Error("OctaveBands() with zero fraction should return an error")
+	}
+	if _, err := OctaveBands([]float64{2, 1}, []float64{1, 1}, 3, Linear); err == nil {
+		t.Error("OctaveBands() with unsorted frequencies should return an error")
+	}
+}
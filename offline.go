@@ -0,0 +1,135 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// offlineRadius gives OfflineBest a 256-tap kernel at the lower of the two rates
+	offlineRadius = 128
+	// offlineCutoff places the filter's -6 dB point just below the Nyquist frequency
+	// of the lower rate so the transition band ends at it
+	offlineCutoff = 0.975
+	// offlineBeta shapes the Kaiser window for about 100 dB of stopband attenuation
+	offlineBeta = 10.0
+	// offlineOrder is the order of the autoregressive model extending the edges
+	offlineOrder = 32
+)
+
+// OfflineBest converts in from the integer sample rate srIn to srOut for
+// mastering-grade work where speed does not matter. It uses a 256-tap
+// Kaiser-windowed sinc (about 100 dB stopband, passband flat to roughly 95% of the
+// lower Nyquist frequency), widened into an anti-aliasing filter when downsampling;
+// exact rational phase, with output sample k at input position k*srIn/srOut
+// computed in integers; and double precision throughout. Rather than treating the
+// signal as silent beyond its ends, which rings, each end is extended by
+// autoregressive (Burg) prediction tapered to zero, so the filter sees a plausible
+// continuation. The output covers the input like Resample.
+func OfflineBest(in []float64, srIn, srOut int) ([]float64, error) {
+	if srIn <= 0 || srOut <= 0 {
+		return nil, fmt.Errorf("sample rates must be positive, got %d and %d", srIn, srOut)
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if srIn == srOut {
+		return append([]float64(nil), in...), nil
+	}
+
+	k := kernel{impulse: kaiserSincImpulse(offlineRadius, offlineCutoff, offlineBeta), radius: offlineRadius, boundary: BoundaryZero}
+	if srIn > srOut {
+		k = k.stretched(float64(srIn) / float64(srOut))
+	}
+	p, err := newPolyphase(srOut, srIn, k)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extend both ends by the kernel's reach
+	pad := k.radius + 1
+	extended := make([]float64, 0, len(in)+2*pad)
+	extended = append(extended, reversed(extendAR(reversed(in), pad))...)
+	extended = append(extended, in...)
+	extended = append(extended, extendAR(in, pad)...)
+
+	lastIdx := len(in) - 1
+	out := make([]float64, lastIdx*p.up/p.down+1)
+	for i := range out {
+		num := i * p.down
+		first := num/p.up - k.radius + 1 + pad
+		sum := 0.0
+		for t, w := range p.coeffs[num%p.up] {
+			sum += extended[first+t] * w
+		}
+		out[i] = sum
+	}
+	return out, nil
+}
+
+// extendAR predicts count samples following x with an autoregressive model fitted
+// by Burg's method, fading the prediction out with a half cosine so an unstable or
+// poorly fitting model cannot run away
+func extendAR(x []float64, count int) []float64 {
+	out := make([]float64, count)
+	order := min(offlineOrder, len(x)/2)
+	if order < 1 {
+		for i := range out {
+			out[i] = x[len(x)-1] * 0.5 * (1 + math.Cos(math.Pi*float64(i+1)/float64(count+1)))
+		}
+		return out
+	}
+	a := burg(x, order)
+	history := append([]float64(nil), x[len(x)-order:]...)
+	for i := range out {
+		pred := 0.0
+		for j, c := range a {
+			pred -= c * history[len(history)-1-j]
+		}
+		history = append(history[1:], pred)
+		out[i] = pred * 0.5 * (1 + math.Cos(math.Pi*float64(i+1)/float64(count+1)))
+	}
+	return out
+}
+
+// burg fits an autoregressive model x[n] + a[0]x[n-1] + ... + a[p-1]x[n-p] = e[n]
+// of the given order with Burg's method and returns a
+func burg(x []float64, order int) []float64 {
+	n := len(x)
+	f := append([]float64(nil), x...)
+	b := append([]float64(nil), x...)
+	a := make([]float64, 0, order)
+	for m := 0; m < order; m++ {
+		num, den := 0.0, 0.0
+		for i := m + 1; i < n; i++ {
+			num += f[i] * b[i-1]
+			den += f[i]*f[i] + b[i-1]*b[i-1]
+		}
+		if den == 0 {
+			break
+		}
+		k := -2 * num / den
+		// Levinson update of the coefficients
+		next := make([]float64, m+1)
+		for j := 0; j < m; j++ {
+			next[j] = a[j] + k*a[m-1-j]
+		}
+		next[m] = k
+		a = next
+		for i := n - 1; i > m; i-- {
+			fi := f[i]
+			f[i] += k * b[i-1]
+			b[i] = b[i-1] + k*fi
+		}
+	}
+	return a
+}
+
+// reversed returns a reversed copy of x
+func reversed(x []float64) []float64 {
+	out := make([]float64, len(x))
+	for i, v := range x {
+		out[len(x)-1-i] = v
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+// sineSNR returns the signal-to-noise ratio in dB of out against a unit sine of
+// frequency freq at sample rate sr
+func sineSNR(out []float64, freq, sr float64) float64 {
+	var signal, noise float64
+	for i, v := range out {
+		want := math.Sin(2 * math.Pi * freq * float64(i) / sr)
+		signal += want * want
+		noise += (v - want) * (v - want)
+	}
+	return 10 * math.Log10(signal/noise)
+}
+
+func TestOfflineBestSNR(t *testing.T) {
+	tests := []struct {
+		freq   float64
+		srIn   int
+		srOut  int
+		minSNR float64
+	}{
+		{997, 44100, 48000, 110},
+		{10000, 44100, 48000, 110},
+		{997, 48000, 44100, 110},
+		{5000, 44100, 88200, 110},
+	}
+	for _, tt := range tests {
+		in := make([]float64, tt.srIn/2)
+		for i := range in {
+			in[i] = math.Sin(2 * math.Pi * tt.freq * float64(i) / float64(tt.srIn))
+		}
+		out, err := OfflineBest(in, tt.srIn, tt.srOut)
+		if err != nil {
+			t.Fatalf("OfflineBest() returned unexpected error: %v", err)
+		}
+		fast, _ := Resample(in, float64(tt.srIn), float64(tt.srOut), Lanczos3)
+		if len(out) != len(fast) {
+			t.Errorf("OfflineBest(%d -> %d) length = %d, want %d like Resample", tt.srIn, tt.srOut, len(out), len(fast))
+		}
+		// Measured over the whole output, edges included
+		if snr := sineSNR(out, tt.freq, float64(tt.srOut)); snr < tt.minSNR {
+			t.Errorf("OfflineBest(%v Hz, %d -> %d) SNR = %.1f dB, want at least %v", tt.freq, tt.srIn, tt.srOut, snr, tt.minSNR)
+		}
+	}
+}
+
+func TestOfflineBestRemovesAliases(t *testing.T) {
+	// 23 kHz does not fit below the 22.05 kHz Nyquist frequency of the output
+	in := make([]float64, 48000)
+	for i := range in {
+		in[i] = math.Sin(2 * math.Pi * 23000 * float64(i) / 48000)
+	}
+	out, _ := OfflineBest(in, 48000, 44100)
+	peak := 0.0
+	for _, v := range out[1000 : len(out)-1000] {
+		peak = math.Max(peak, math.Abs(v))
+	}
+	if db := 20 * math.Log10(peak); db > -90 {
+		t.Errorf("OfflineBest() residual of an out-of-band tone = %.1f dB, want below -90", db)
+	}
+}
+
+func TestOfflineBestEdgeCases(t *testing.T) {
+	if _, err := OfflineBest([]float64{1, 2}, 0, 48000); err == nil {
+		t.Error("OfflineBest() with a zero rate should return an error")
+	}
+	if out, _ := OfflineBest(nil, 44100, 48000); len(out) != 0 {
+		t.Errorf("OfflineBest() of no samples = %v, want empty", out)
+	}
+	// Short input still works without a usable AR model
+	out, err := OfflineBest([]float64{1, 1, 1}, 1, 2)
+	if err != nil || len(out) != 5 {
+		t.Errorf("OfflineBest() of three samples = %v, %v, want 5 samples", out, err)
+	}
+}
+
+func TestBurgRecoversModel(t *testing.T) {
+	// A sine obeys x[n] = 2cos(w) x[n-1] - x[n-2]
+	w := 0.3
+	x := make([]float64, 500)
+	for i := range x {
+		x[i] = math.Sin(w*float64(i) + 0.4)
+	}
+	a := burg(x, 2)
+	if math.Abs(a[0]+2*math.Cos(w)) > 1e-2 || math.Abs(a[1]-1) > 1e-2 {
+		t.Errorf("burg() = %v, want [%v 1]", a, -2*math.Cos(w))
+	}
+}
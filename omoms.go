@@ -0,0 +1,32 @@
+package interpolators
+
+import "math"
+
+// omomsPole is the pole of the cubic O-MOMS prefilter, whose kernel takes the
+// values 4/21, 13/21, 4/21 at the integers
+var omomsPole = (math.Sqrt(105) - 13) / 8
+
+// omomsKernel is the cubic O-MOMS kernel applied to prefiltered coefficients. Its
+// edge handling mirrors, matching the prefilter.
+var omomsKernel = kernel{impulse: omomsImpulse, radius: 2, boundary: BoundaryMirror}
+
+// omomsImpulse is the cubic O-MOMS basis function β³(x) + β³⁽²⁾(x)/42, which has the
+// smallest approximation error of all cubic kernels of support 4
+func omomsImpulse(x float64) float64 {
+	x = math.Abs(x)
+	switch {
+	case x < 1:
+		return ((0.5*x-1)*x+1.0/14)*x + 13.0/21
+	case x < 2:
+		return ((-x/6+1)*x-85.0/42)*x + 29.0/21
+	}
+	return 0
+}
+
+// omomsCoefficients returns the O-MOMS coefficients of in, so that the kernel
+// through them passes through the samples
+func omomsCoefficients(in []float64) []float64 {
+	c := append([]float64(nil), in...)
+	prefilter(c, omomsPole)
+	return c
+}
@@ -0,0 +1,142 @@
+package interpolators
+
+import "math"
+
+// The optimal interpolators of Olli Niemitalo's "Polynomial Interpolators for
+// High-Quality Resampling of Oversampled Audio" are piecewise polynomials whose
+// coefficients were optimized for input oversampled by a given factor. They do
+// not pass through the samples; instead they trade a slight droop of the
+// passband, which an equalizer can undo, for much stronger rejection of the
+// images than B-spline or Lagrange interpolators of the same size.
+//
+// The tables hold the z-form coefficients of the paper. Row g gives the weight
+// of the sample pair at distances g to g+1 as the polynomial Σ c[k]·vᵏ in
+// v = g + ½ - |x|, the paper's z up to sign.
+
+// optimal2x4Coefficients is the 4-point, 4th-order optimal 2x interpolator
+var optimal2x4Coefficients = [][]float64{
+	{0.45645918406487612, 0.47236675362442071, -0.253674794204558521, -0.37917091811631082, 0.04252164479749607},
+	{0.04354173901996461, 0.17686613581136501, 0.25371918651882464, 0.11952965967158000, -0.04289144034653719},
+}
+
+// optimal2x6Coefficients is the 6-point, 5th-order optimal 2x interpolator
+var optimal2x6Coefficients = [][]float64{
+	{0.40513396007145713, 0.28342806338906690, -0.191337682540351941, -0.16471626190554542, 0.03845798729588149, 0.04317950185225609},
+	{0.09251794438424393, 0.21703277024054901, 0.16187844487943592, -0.00154547203542499, -0.05712936104242644, -0.01802814255926417},
+	{0.00234806603570670, 0.01309294748731515, 0.02946017143111912, 0.03399271444851909, 0.01866750929921070, 0.00152170021558204},
+}
+
+// optimal4x4Coefficients is the 4-point, 4th-order optimal 4x interpolator
+var optimal4x4Coefficients = [][]float64{
+	{0.46567255120778489, 0.53743830753560162, -0.251942101340217441, -0.46896069955075126, 0.00986988334359864},
+	{0.03432729708429672, 0.15429462557307461, 0.25194744935939062, 0.15578800670302476, -0.00989340017126506},
+}
+
+// optimal8x4Coefficients is the 4-point, 4th-order optimal 8x interpolator
+var optimal8x4Coefficients = [][]float64{
+	{0.46771532012068961, 0.55448654344364423, -0.250587283698110121, -0.49209020939096676, 0.00255074537015887},
+	{0.03228466824404497, 0.14851181120641987, 0.25058765188457821, 0.16399414834151946, -0.00255226912537286},
+}
+
+// optimal16x4Coefficients is the 4-point, 4th-order optimal 16x interpolator
+var optimal16x4Coefficients = [][]float64{
+	{0.46822774170144532, 0.55890365706150436, -0.250153411893796031, -0.49800710906733769, 0.00064264050033187},
+	{0.03177225758005808, 0.14703258836343669, 0.25015343462990891, 0.16600005174304033, -0.00064273459469381},
+}
+
+// optimal32x4Coefficients is the 4-point, 4th-order optimal 32x interpolator
+var optimal32x4Coefficients = [][]float64{
+	{0.46835497211269561, 0.56001293337091440, -0.250038759826233691, -0.49949850957839148, 0.00016095224137360},
+	{0.03164502784253309, 0.14666238593949288, 0.25003876124297131, 0.16649935475113800, -0.00016095810460478},
+}
+
+// optimal4x2Coefficients is the 2-point, 3rd-order optimal 4x interpolator
+var optimal4x2Coefficients = [][]float64{
+	{0.50013034073688023, 1.09617817497678520, -0.001564088842561871, -1.32598918957298410},
+}
+
+// optimal8x2Coefficients is the 2-point, 3rd-order optimal 8x interpolator
+var optimal8x2Coefficients = [][]float64{
+	{0.50004007194083089, 1.06397659072500650, -0.000480863289971321, -0.73514591836770027},
+}
+
+// optimal16x2Coefficients is the 2-point, 3rd-order optimal 16x interpolator
+var optimal16x2Coefficients = [][]float64{
+	{0.50001096675880796, 1.03585606328743830, -0.000131601105693441, -0.38606621963374965},
+}
+
+// optimal32x2Coefficients is the 2-point, 3rd-order optimal 32x interpolator
+var optimal32x2Coefficients = [][]float64{
+	{0.50000286037713559, 1.01889120864375270, -0.000034324525627571, -0.19775766248673177},
+}
+
+// optimal4x6Coefficients is the 6-point, 5th-order optimal 4x interpolator
+var optimal4x6Coefficients = [][]float64{
+	{0.41496902959240894, 0.31625515004859783, -0.203271896548875371, -0.20209241069835732, 0.04100948858761910, 0.06607747864416924},
+	{0.08343081932889224, 0.21197848565176958, 0.17989908432249280, 0.01760734419526000, -0.06147760875085254, -0.03255079211953620},
+	{0.00160015038681571, 0.00956166668408054, 0.02337283412161328, 0.02985927012435252, 0.02046802954581191, 0.00628989632244913},
+}
+
+// optimal8x6Coefficients is the 6-point, 5th-order optimal 8x interpolator
+var optimal8x6Coefficients = [][]float64{
+	{0.41660797292569773, 0.32232780822726981, -0.205219993961471501, -0.21022298520246224, 0.04149963966704384, 0.07517133281176167},
+	{0.08188468587188069, 0.21076321997422021, 0.18282942057327367, 0.02176417471349534, -0.06224707096203808, -0.03751837438141215},
+	{0.00150734119050266, 0.00907649978070957, 0.02239057377093268, 0.02898626924395209, 0.02074742969707599, 0.00747588873055296},
+}
+
+// optimal16x6Coefficients is the 6-point, 5th-order optimal 16x interpolator
+var optimal16x6Coefficients = [][]float64{
+	{0.41809989254549901, 0.32767596257424964, -0.206944618112960001, -0.21686095413034051, 0.04163046817137675, 0.07990500783668089},
+	{0.08049339946273310, 0.20978189376640677, 0.18541689550861262, 0.02509557922091643, -0.06244556931623735, -0.03994519162531633},
+	{0.00140670799165932, 0.00859567104974701, 0.02152772260740132, 0.02831484751363800, 0.02081510113314315, 0.00798609327859495},
+}
+
+// optimal32x6Coefficients is the 6-point, 5th-order optimal 32x interpolator
+var optimal32x6Coefficients = [][]float64{
+	{0.42685983409379380, 0.35831772348893259, -0.217009177221292431, -0.25112715343740988, 0.04166946673533273, 0.08349799235675044},
+	{0.07238123511170030, 0.20451644554758297, 0.20051376594086157, 0.04223025992200458, -0.06250420114356986, -0.04174912841630993},
+	{0.00075893079450573, 0.00562658797241955, 0.01649541128040211, 0.02488727472995134, 0.02083473440841799, 0.00834987866042734},
+}
+
+// optimalKernel returns the symmetric piecewise-polynomial kernel described by a
+// coefficient table, with its derivatives taken term by term
+func optimalKernel(coeffs [][]float64) kernel {
+	// piece returns the polynomial of row g and its first two derivatives in v
+	piece := func(x float64) (p, d1, d2 float64, ok bool) {
+		a := math.Abs(x)
+		g := int(a)
+		if x < 0 && float64(g) == a {
+			// The pieces jump at whole distances. A tap ahead of the position, at
+			// negative x, takes the piece below the jump as in the paper, so at
+			// whole positions the taps at both ends of the window all count.
+			g--
+		}
+		if g >= len(coeffs) {
+			return 0, 0, 0, false
+		}
+		v := float64(g) + 0.5 - a
+		c := coeffs[g]
+		for k := len(c) - 1; k >= 0; k-- {
+			d2 = d2*v + 2*d1
+			d1 = d1*v + p
+			p = p*v + c[k]
+		}
+		return p, d1, d2, true
+	}
+	impulse := func(x float64) float64 {
+		p, _, _, _ := piece(x)
+		return p
+	}
+	derivative := func(x float64, order int) float64 {
+		_, d1, d2, ok := piece(x)
+		if !ok {
+			return 0
+		}
+		if order == 1 {
+			// v falls as |x| grows
+			return -math.Copysign(d1, x)
+		}
+		return d2
+	}
+	return kernel{impulse: impulse, radius: len(coeffs), boundary: BoundaryClamp, derivative: derivative}
+}
@@ -0,0 +1,178 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Options configures InterpolateWithOptions. The zero value reproduces Interpolate.
+type Options struct {
+	// Boundary selects how kernel taps outside the input are handled. It applies to
+	// the convolution-based interpolators; the splines are fitted to the input alone.
+	Boundary Boundary `json:"boundary,omitempty"`
+	// Normalize divides each output by the sum of the kernel weights actually used,
+	// so kernels whose taps are dropped at the edges (or whose weights do not sum
+	// to one, like Lanczos) still reproduce a constant signal exactly
+	Normalize bool `json:"normalize,omitempty"`
+	// GradientDomain interpolates the differences between successive samples and
+	// integrates them back into the output, anchored to the first and last samples.
+	// This follows local slopes more faithfully for signals that are themselves
+	// integrals, such as displacement reconstructed from a velocity sensor.
+	GradientDomain bool `json:"gradient_domain,omitempty"`
+	// LogDomain interpolates the logarithms of the samples and exponentiates the
+	// result, exp(interp(log(y))), for strictly positive data such as decaying
+	// quantities, frequencies and prices. Straight lines in the log domain are
+	// exponential curves, so an exponential decay is followed closely instead of
+	// being bowed outward, and the output can never turn negative.
+	LogDomain bool `json:"log_domain,omitempty"`
+	// PixelCenters treats samples as the centers of equal cells, as image resizers
+	// do: output sample i sits at input position (i+0.5)*len(in)/outSamples-0.5
+	// instead of aligning the first and last samples. It is ignored with
+	// GradientDomain.
+	PixelCenters bool `json:"pixel_centers,omitempty"`
+	// AntiAlias widens the kernel of the convolution-based interpolators into a
+	// low-pass filter when reducing the number of samples, so detail finer than
+	// the output spacing is averaged instead of aliased. It is ignored with
+	// GradientDomain.
+	AntiAlias bool `json:"anti_alias,omitempty"`
+	// Revision pins the kernels to the behavior of an earlier release so stored
+	// outputs can be reproduced; the zero value, RevisionLatest, tracks fixes
+	Revision Revision `json:"revision,omitempty"`
+	// Kernel sets the parameters of the parameterized interpolators such as Keys
+	Kernel KernelParams `json:"kernel,omitzero"`
+}
+
+// InterpolateWithOptions performs interpolation like Interpolate with additional
+// control over the algorithm
+func InterpolateWithOptions(in []float64, outSamples int, interpolatorType InterpolatorType, opts Options) (out []float64, err error) {
+	revision, err := opts.Revision.resolve()
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.Kernel.validate(); err != nil {
+		return nil, err
+	}
+	if revision == latestRevision {
+		opts.Revision = RevisionLatest
+	}
+	if opts.LogDomain && interpolatorType != None {
+		return logDomainInterpolate(in, outSamples, interpolatorType, opts)
+	}
+	_, ok := kernelFor(interpolatorType)
+	if _, parameterized := paramEvaluators[interpolatorType]; parameterized {
+		ok = true
+	}
+	if opts.GradientDomain && interpolatorType != None && len(in) > 1 {
+		if err := validateParams(len(in), outSamples, interpolatorType, opts.Kernel); err != nil {
+			return nil, err
+		}
+		return gradientDomainInterpolate(in, outSamples, interpolatorType, opts), nil
+	}
+	if opts == (Options{}) || (!ok && !opts.PixelCenters) || interpolatorType == None || len(in) <= 1 {
+		return Interpolate(in, outSamples, interpolatorType)
+	}
+	if err := validateParams(len(in), outSamples, interpolatorType, opts.Kernel); err != nil {
+		return nil, err
+	}
+
+	position := func(i int) float64 { return outputPosition(i, len(in), outSamples) }
+	if opts.PixelCenters {
+		position = func(i int) float64 { return pixelCenterPosition(i, len(in), outSamples) }
+	}
+	stretch := 1.0
+	if opts.AntiAlias && outSamples > 1 {
+		stretch = math.Max(1, position(1)-position(0))
+	}
+	f := newOptionsEvaluator(in, interpolatorType, opts, stretch)
+	out = make([]float64, outSamples)
+	for i := range out {
+		out[i] = f(position(i))
+	}
+	return out, nil
+}
+
+// newOptionsEvaluator returns a function evaluating the interpolant of in with the
+// kernel options applied and the kernel widened by stretch
+func newOptionsEvaluator(in []float64, interpolatorType InterpolatorType, opts Options, stretch float64) func(pos float64) float64 {
+	if build, ok := paramEvaluators[interpolatorType]; ok && len(in) > 1 {
+		return build(in, opts.Kernel)
+	}
+	k, ok := opts.Kernel.kernel(interpolatorType)
+	if !ok || len(in) <= 1 {
+		return newEvaluator(in, interpolatorType)
+	}
+	k = k.withBoundary(opts.Boundary).atRevision(opts.Revision, interpolatorType)
+	if stretch > 1 {
+		k = k.stretched(stretch)
+	}
+	k.normalize = k.normalize || opts.Normalize
+	return func(pos float64) float64 { return k.eval(in, pos) }
+}
+
+// logDomainInterpolate interpolates the logarithms of in with the remaining
+// options and returns their exponentials
+func logDomainInterpolate(in []float64, outSamples int, interpolatorType InterpolatorType, opts Options) ([]float64, error) {
+	logs := make([]float64, len(in))
+	for i, v := range in {
+		if !(v > 0) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("log-domain interpolation needs positive finite samples, got %v at index %d", v, i)
+		}
+		logs[i] = math.Log(v)
+	}
+	opts.LogDomain = false
+	out, err := InterpolateWithOptions(logs, outSamples, interpolatorType, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range out {
+		out[i] = math.Exp(v)
+	}
+	return out, nil
+}
+
+// gradientDomainInterpolate interpolates the first differences of in, which sit
+// halfway between the samples, integrates them between successive output
+// positions and spreads any residual drift linearly so the output ends on the
+// last input sample
+func gradientDomainInterpolate(in []float64, outSamples int, interpolatorType InterpolatorType, opts Options) []float64 {
+	n := len(in)
+	diffs := make([]float64, n-1)
+	for j := range diffs {
+		diffs[j] = in[j+1] - in[j]
+	}
+	g := newOptionsEvaluator(diffs, interpolatorType, opts, 1)
+
+	out := make([]float64, outSamples)
+	out[0] = in[0]
+	prev := 0.0
+	for i := 1; i < outSamples; i++ {
+		pos := outputPosition(i, n, outSamples)
+		// Difference j sits at position j+0.5, so shift the bounds into its indexing
+		out[i] = out[i-1] + integrate(g, prev-0.5, pos-0.5)
+		prev = pos
+	}
+
+	if outSamples > 1 {
+		drift := in[n-1] - out[outSamples-1]
+		for i := range out {
+			out[i] += drift * outputPosition(i, n, outSamples) / float64(n-1)
+		}
+	}
+	return out
+}
+
+// outputPosition returns the input position of output sample i when n input samples
+// are resampled to outSamples with the first and last samples aligned
+func outputPosition(i, n, outSamples int) float64 {
+	if outSamples <= 1 {
+		return 0
+	}
+	ratio := float64(n-1) / float64(outSamples-1)
+	return float64(i) * ratio
+}
+
+// pixelCenterPosition returns the input position of output sample i when n input
+// cells are resampled to outSamples cells with their outer edges aligned
+func pixelCenterPosition(i, n, outSamples int) float64 {
+	return (float64(i)+0.5)*float64(n)/float64(outSamples) - 0.5
+}
@@ -0,0 +1,236 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateWithOptionsDefault(t *testing.T) {
+	in := []float64{1, 4, 2, 8, 5, 7}
+	for typ := None; typ <= Akima; typ++ {
+		expected, _ := Interpolate(in, 17, typ)
+		out, err := InterpolateWithOptions(in, 17, typ, Options{})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+		}
+		if len(out) != len(expected) {
+			t.Fatalf("InterpolateWithOptions(%d) length = %d, want %d", typ, len(out), len(expected))
+		}
+		for i := range out {
+			if out[i] != expected[i] {
+				t.Errorf("InterpolateWithOptions(%d)[%d] = %v, want %v", typ, i, out[i], expected[i])
+			}
+		}
+	}
+}
+
+func TestInterpolateWithOptionsClampConstant(t *testing.T) {
+	// Dropping taps attenuates a constant at the edges; clamping does not
+	in := []float64{3, 3, 3, 3, 3}
+	for _, typ := range []InterpolatorType{BSpline3, BSpline5, Lagrange4, Osculating6} {
+		out, err := InterpolateWithOptions(in, 13, typ, Options{Boundary: BoundaryClamp})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+		}
+		for i, v := range out {
+			if math.Abs(v-3) > 1e-10 {
+				t.Errorf("InterpolateWithOptions(%d)[%d] = %v, want 3", typ, i, v)
+			}
+		}
+	}
+}
+
+func TestInterpolateWithOptionsMirrorAndWrap(t *testing.T) {
+	in := []float64{1, 5, 2, 7}
+	// Explicitly extended copies of the input, with the original at offset 3
+	mirrored := []float64{7, 2, 5, 1, 5, 2, 7, 2, 5, 1}
+	wrapped := []float64{5, 2, 7, 1, 5, 2, 7, 1, 5, 2}
+
+	tests := []struct {
+		name     string
+		boundary Boundary
+		extended []float64
+	}{
+		{"mirror", BoundaryMirror, mirrored},
+		{"wrap", BoundaryWrap, wrapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := InterpolateWithOptions(in, 7, Lanczos3, Options{Boundary: tt.boundary})
+			if err != nil {
+				t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+			}
+			k, _ := kernelFor(Lanczos3)
+			for i := range out {
+				want := k.eval(tt.extended, 3+outputPosition(i, len(in), len(out)))
+				if math.Abs(out[i]-want) > 1e-10 {
+					t.Errorf("InterpolateWithOptions()[%d] = %v, want %v", i, out[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolateWithOptionsNormalize(t *testing.T) {
+	// BSpline3 drops taps at the edges, attenuating a constant there
+	in := []float64{5, 5, 5, 5, 5, 5}
+	plain, _ := Interpolate(in, 11, BSpline3)
+	if math.Abs(plain[0]-5) < 1e-6 {
+		t.Fatalf("Interpolate() BSpline3 at the edge = %v, expected attenuation", plain[0])
+	}
+
+	for _, typ := range []InterpolatorType{BSpline3, BSpline5, Lagrange6, Lanczos2, Lanczos3} {
+		out, err := InterpolateWithOptions(in, 11, typ, Options{Normalize: true})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+		}
+		for i, v := range out {
+			if math.Abs(v-5) > 1e-10 {
+				t.Errorf("InterpolateWithOptions(%d, Normalize)[%d] = %v, want 5", typ, i, v)
+			}
+		}
+	}
+}
+
+func TestInterpolateWithOptionsNormalizeInterior(t *testing.T) {
+	// Away from the edges a partition-of-unity kernel is unchanged by normalization
+	in := []float64{1, 4, 2, 8, 5, 7, 3, 6, 9, 2}
+	plain, _ := Interpolate(in, 19, BSpline3)
+	out, _ := InterpolateWithOptions(in, 19, BSpline3, Options{Normalize: true})
+	for i := 4; i < 15; i++ {
+		if math.Abs(out[i]-plain[i]) > 1e-10 {
+			t.Errorf("InterpolateWithOptions(Normalize)[%d] = %v, want %v", i, out[i], plain[i])
+		}
+	}
+}
+
+func TestInterpolateWithOptionsGradientDomain(t *testing.T) {
+	// Displacement sampled from a quadratic; the reconstruction passes through the
+	// samples and tracks the slope between them
+	in := make([]float64, 21)
+	for i := range in {
+		x := float64(i) / 4
+		in[i] = x * x
+	}
+	for _, typ := range []InterpolatorType{Linear, Hermite4, CubicSpline} {
+		out, err := InterpolateWithOptions(in, 81, typ, Options{GradientDomain: true})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(%d) returned unexpected error: %v", typ, err)
+		}
+		if len(out) != 81 {
+			t.Fatalf("InterpolateWithOptions(%d) length = %d, want 81", typ, len(out))
+		}
+		if out[0] != in[0] || math.Abs(out[80]-in[20]) > 1e-12 {
+			t.Errorf("InterpolateWithOptions(%d) endpoints = %v, %v, want %v, %v", typ, out[0], out[80], in[0], in[20])
+		}
+		for i := 8; i <= 72; i++ {
+			x := float64(i) / 16
+			if want := x * x; math.Abs(out[i]-want) > 0.02 {
+				t.Errorf("InterpolateWithOptions(%d)[%d] = %v, want %v", typ, i, out[i], want)
+			}
+		}
+	}
+}
+
+func TestInterpolateWithOptionsGradientDomainLinear(t *testing.T) {
+	// A linear ramp has constant differences, so every sample is reproduced
+	in := []float64{2, 5, 8, 11, 14}
+	out, err := InterpolateWithOptions(in, 9, Lanczos3, Options{GradientDomain: true, Boundary: BoundaryClamp, Normalize: true})
+	if err != nil {
+		t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+	}
+	for i, v := range out {
+		if want := 2 + 1.5*float64(i); math.Abs(v-want) > 1e-9 {
+			t.Errorf("InterpolateWithOptions()[%d] = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestInterpolateWithOptionsPixelCenters(t *testing.T) {
+	// Doubling four cells puts the outputs a quarter cell either side of each input
+	in := []float64{0, 4, 8, 12}
+	out, err := InterpolateWithOptions(in, 8, Linear, Options{PixelCenters: true})
+	if err != nil {
+		t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+	}
+	want := []float64{0, 1, 3, 5, 7, 9, 11, 12}
+	for i := range want {
+		if math.Abs(out[i]-want[i]) > 1e-12 {
+			t.Errorf("InterpolateWithOptions()[%d] = %v, want %v", i, out[i], want[i])
+		}
+	}
+
+	// Splines honor the positions too
+	spline, _ := InterpolateWithOptions([]float64{0, 2, 4, 6}, 2, CubicSpline, Options{PixelCenters: true})
+	if math.Abs(spline[0]-1) > 1e-12 || math.Abs(spline[1]-5) > 1e-12 {
+		t.Errorf("InterpolateWithOptions(CubicSpline) = %v, want [1 5]", spline)
+	}
+}
+
+func TestInterpolateWithOptionsAntiAlias(t *testing.T) {
+	// Alternating samples are pure Nyquist content, which aliases when decimated
+	in := make([]float64, 400)
+	for i := range in {
+		in[i] = float64(1 - 2*(i%2))
+	}
+	opts := Options{PixelCenters: true, Boundary: BoundaryClamp, Normalize: true}
+	plain, _ := InterpolateWithOptions(in, 57, Lanczos3, opts)
+	opts.AntiAlias = true
+	filtered, err := InterpolateWithOptions(in, 57, Lanczos3, opts)
+	if err != nil {
+		t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+	}
+	peak := func(x []float64) float64 {
+		m := 0.0
+		for _, v := range x[5 : len(x)-5] {
+			m = math.Max(m, math.Abs(v))
+		}
+		return m
+	}
+	if peak(plain) < 0.5 {
+		t.Fatalf("decimation without anti-aliasing peak = %v, want aliasing above 0.5", peak(plain))
+	}
+	if p := peak(filtered); p > 0.05 {
+		t.Errorf("decimation with anti-aliasing peak = %v, want below 0.05", p)
+	}
+}
+
+func TestInterpolateWithOptionsLogDomain(t *testing.T) {
+	// An exponential decay is a straight line in the log domain, so every
+	// interpolator that reproduces lines follows it exactly
+	in := make([]float64, 9)
+	for i := range in {
+		in[i] = 1000 * math.Exp(-2.5*float64(i))
+	}
+	for _, typ := range []InterpolatorType{Linear, CubicSpline, MonotonicCubic, Akima} {
+		out, err := InterpolateWithOptions(in, 33, typ, Options{LogDomain: true})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(%v) returned unexpected error: %v", typ, err)
+		}
+		for i, v := range out {
+			want := 1000 * math.Exp(-2.5*outputPosition(i, len(in), len(out)))
+			if math.Abs(v-want) > 1e-9*want {
+				t.Errorf("InterpolateWithOptions(%v, LogDomain)[%d] = %v, want %v", typ, i, v, want)
+			}
+		}
+	}
+	// Without it the cubic spline undershoots below zero in the tail
+	plain, _ := Interpolate(in, 33, CubicSpline)
+	negative := false
+	for _, v := range plain {
+		negative = negative || v < 0
+	}
+	if !negative {
+		t.Error("CubicSpline of the decay never goes negative, the test needs new data")
+	}
+
+	for _, bad := range [][]float64{{1, 0, 2}, {1, -3}, {2, math.Inf(1)}, {math.NaN(), 1}} {
+		if _, err := InterpolateWithOptions(bad, 5, Linear, Options{LogDomain: true}); err == nil {
+			t.Errorf("InterpolateWithOptions(%v, LogDomain) returned nil error", bad)
+		}
+	}
+	if out, err := InterpolateWithOptions([]float64{-1, 0}, 5, None, Options{LogDomain: true}); err != nil || len(out) != 2 {
+		t.Errorf("InterpolateWithOptions(None, LogDomain) = %v, %v, want the input back", out, err)
+	}
+}
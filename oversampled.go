@@ -0,0 +1,146 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// oversampledRadius and oversampledBeta set the Kaiser window of the upsampling
+// filter of Oversampled: 64 taps per phase, with sidelobes down by about 100 dB
+const (
+	oversampledRadius = 32
+	oversampledBeta   = 10
+)
+
+// oversampledBands is the number of pieces of the passband integral that designs
+// the upsampling filter
+const oversampledBands = 64
+
+// Oversampled resamples in two stages, as recommended for high-quality audio
+// resampling with polynomial interpolators: the input is first upsampled by a
+// small integer factor with a long windowed-sinc FIR, which is cheap because the
+// ratio is fixed, and the oversampled signal is then interpolated with a short
+// kernel. The short kernel only has to reject images far from the signal band,
+// where polynomials do well; the optimal interpolators designed for the factor,
+// such as Optimal2x6 after 2x and Optimal4x4 after 4x, are the natural choice.
+// Their passband droops, as does that of most short kernels, so the upsampling
+// filter also boosts the band by the inverse of the kernel's response and the
+// two stages together pass the signal band flat.
+type Oversampled struct {
+	factor    int
+	upsampler *Polyphase
+	k         kernel
+}
+
+// NewOversampled creates a two-stage resampler that upsamples by factor, usually 2
+// or 4, before interpolating with interpolatorType. Only the convolution-based
+// interpolators are supported as the second stage.
+func NewOversampled(factor int, interpolatorType InterpolatorType) (*Oversampled, error) {
+	if factor < 1 {
+		return nil, fmt.Errorf("oversampling factor must be at least 1, got %d", factor)
+	}
+	k, ok := kernelFor(interpolatorType)
+	if !ok {
+		return nil, fmt.Errorf("interpolator type %d has no kernel for the oversampled stage", interpolatorType)
+	}
+	upsampler, err := newPolyphase(factor, 1, oversampledFilter(factor, k))
+	if err != nil {
+		return nil, err
+	}
+	return &Oversampled{factor: factor, upsampler: upsampler, k: k}, nil
+}
+
+// Factor returns the oversampling factor of the first stage
+func (o *Oversampled) Factor() int {
+	return o.factor
+}
+
+// Upsample returns the first stage's output: in upsampled by the factor, with
+// input sample j at index j*factor. The signal band carries the boost for the
+// second stage, so it is not a flat-response upsampling of in.
+func (o *Oversampled) Upsample(in []float64) []float64 {
+	return o.upsampler.Resample(in)
+}
+
+// Interpolate resamples in to outSamples on the same output grid as Interpolate
+func (o *Oversampled) Interpolate(in []float64, outSamples int) ([]float64, error) {
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	positions := make([]float64, outSamples)
+	for i := range positions {
+		positions[i] = outputPosition(i, len(in), outSamples)
+	}
+	return o.InterpolateAt(in, positions), nil
+}
+
+// InterpolateAt evaluates the two-stage interpolant of in at fractional input
+// positions
+func (o *Oversampled) InterpolateAt(in []float64, positions []float64) []float64 {
+	up := o.Upsample(in)
+	out := make([]float64, len(positions))
+	if len(up) == 0 {
+		return out
+	}
+	for i, pos := range positions {
+		out[i] = o.k.eval(up, pos*float64(o.factor))
+	}
+	return out
+}
+
+// Resample converts in from sample rate srIn to srOut with the output positions
+// of Resample
+func (o *Oversampled) Resample(in []float64, srIn, srOut float64) ([]float64, error) {
+	if err := checkRates(srIn, srOut); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	step := srIn / srOut
+	last := float64(len(in) - 1)
+	n, err := steppedLength(last, step)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]float64, 0, n)
+	for k := 0; k < n && float64(k)*step <= last; k++ {
+		positions = append(positions, float64(k)*step)
+	}
+	return o.InterpolateAt(in, positions), nil
+}
+
+// oversampledFilter returns the upsampling filter for a second stage with kernel
+// k: the Kaiser-windowed inverse transform of 1/H(f/factor) over the input band
+// |f| < ½, where H is the response of k in oversampled samples
+func oversampledFilter(factor int, k kernel) kernel {
+	// Nodes and weights of the passband integral with the equalization folded in
+	var freqs, weights []float64
+	r := float64(k.radius)
+	for b := 0; b < oversampledBands; b++ {
+		for i, x := range gaussNodes {
+			f := (float64(b) + (x+1)/2) / (2 * oversampledBands)
+			fk := f / float64(factor)
+			response := integrate(func(t float64) float64 { return k.impulse(t) * math.Cos(2*math.Pi*fk*t) }, -r, r)
+			freqs = append(freqs, f)
+			weights = append(weights, gaussWeights[i]/(2*oversampledBands)/response)
+		}
+	}
+	window := kaiserWindow(oversampledRadius, oversampledBeta)
+	impulse := func(t float64) float64 {
+		if math.Abs(t) >= oversampledRadius {
+			return 0
+		}
+		// The response is even, so the inverse transform is twice a cosine integral
+		sum := 0.0
+		for i, f := range freqs {
+			sum += weights[i] * math.Cos(2*math.Pi*f*t)
+		}
+		w, _, _ := window(t)
+		return sum * w
+	}
+	return kernel{impulse: impulse, radius: oversampledRadius, boundary: BoundaryClamp}
+}
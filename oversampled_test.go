@@ -0,0 +1,114 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOversampledAccuracy(t *testing.T) {
+	// A sine close to the Nyquist frequency defeats every short kernel on its own,
+	// but not after oversampling
+	const f = 0.45
+	in := make([]float64, 400)
+	for i := range in {
+		in[i] = math.Sin(2 * math.Pi * f * float64(i))
+	}
+	var positions []float64
+	for p := 100.0; p < 300; p += 0.37 {
+		positions = append(positions, p)
+	}
+	worst := func(out []float64) float64 {
+		e := 0.0
+		for i, p := range positions {
+			e = math.Max(e, math.Abs(out[i]-math.Sin(2*math.Pi*f*p)))
+		}
+		return e
+	}
+	for _, tc := range []struct {
+		factor int
+		typ    InterpolatorType
+		want   float64
+	}{
+		{2, Optimal2x6, 5e-3},
+		{4, Optimal4x4, 1e-3},
+		{4, Lagrange6, 1e-3},
+	} {
+		o, err := NewOversampled(tc.factor, tc.typ)
+		if err != nil {
+			t.Fatalf("NewOversampled(%d, %v) returned unexpected error: %v", tc.factor, tc.typ, err)
+		}
+		got := worst(o.InterpolateAt(in, positions))
+		direct, _ := InterpolateAt(in, positions, tc.typ)
+		if got > tc.want || got > worst(direct)/100 {
+			t.Errorf("%dx %v error = %v, want below %v and a hundredth of the direct %v", tc.factor, tc.typ, got, tc.want, worst(direct))
+		}
+	}
+}
+
+func TestOversampledFlatPassband(t *testing.T) {
+	// The droop of the optimal kernel is equalized in the first stage, so a low
+	// frequency keeps its amplitude
+	o, err := NewOversampled(2, Optimal2x6)
+	if err != nil {
+		t.Fatalf("NewOversampled returned unexpected error: %v", err)
+	}
+	in := make([]float64, 300)
+	for i := range in {
+		in[i] = math.Cos(2 * math.Pi * 0.2 * float64(i))
+	}
+	out := o.InterpolateAt(in, []float64{150})
+	direct, _ := InterpolateAt(in, []float64{150}, Optimal2x6)
+	if math.Abs(out[0]-1) > 1e-3 {
+		t.Errorf("oversampled peak = %v, want 1", out[0])
+	}
+	if direct[0] > 0.9 {
+		t.Errorf("direct Optimal2x6 peak = %v, want the droop below 0.9 that the test relies on", direct[0])
+	}
+}
+
+func TestOversampledGrids(t *testing.T) {
+	o, err := NewOversampled(4, Optimal4x4)
+	if err != nil {
+		t.Fatalf("NewOversampled returned unexpected error: %v", err)
+	}
+	if o.Factor() != 4 {
+		t.Errorf("Factor() = %d, want 4", o.Factor())
+	}
+	in := []float64{0, 1, 0, -1, 0, 1, 0, -1, 0, 1}
+	if got := len(o.Upsample(in)); got != 37 {
+		t.Errorf("Upsample length = %d, want 37", got)
+	}
+	out, err := o.Interpolate(in, 19)
+	if err != nil || len(out) != 19 {
+		t.Fatalf("Interpolate returned %d samples and %v, want 19 and no error", len(out), err)
+	}
+	want, _ := Resample(in, 44100, 48000, Linear)
+	got, err := o.Resample(in, 44100, 48000)
+	if err != nil || len(got) != len(want) {
+		t.Errorf("Resample returned %d samples and %v, want %d like Resample", len(got), err, len(want))
+	}
+	if out, err := o.Interpolate(nil, 5); err != nil || len(out) != 0 {
+		t.Errorf("Interpolate(nil) = %v, %v, want empty output", out, err)
+	}
+}
+
+func TestNewOversampledErrors(t *testing.T) {
+	if _, err := NewOversampled(0, Optimal2x4); err == nil {
+		t.Error("NewOversampled(0) returned no error")
+	}
+	if _, err := NewOversampled(2, CubicSpline); err == nil {
+		t.Error("NewOversampled with a spline returned no error")
+	}
+	o, _ := NewOversampled(2, Optimal2x4)
+	if _, err := o.Interpolate([]float64{1, 2}, 0); err == nil {
+		t.Error("Interpolate with 0 output samples returned no error")
+	}
+	if _, err := o.Resample([]float64{1, 2}, 0, 1); err == nil {
+		t.Error("Resample with a zero rate returned no error")
+	}
+	for _, rates := range [][2]float64{{48000, math.Inf(1)}, {math.NaN(), 48000}, {1, 1e300}} {
+		if _, err := o.Resample([]float64{1, 2}, rates[0], rates[1]); err == nil {
+			t.Errorf("Resample(%v, %v) returned no error", rates[0], rates[1])
+		}
+	}
+}
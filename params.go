@@ -0,0 +1,168 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// KernelParams holds the parameters of the parameterized interpolators. Zero values
+// select each interpolator's defaults, and interpolators ignore the fields they do
+// not use.
+type KernelParams struct {
+	// A is the Keys cubic convolution parameter: -0.5 (the default) gives
+	// Catmull-Rom, and -0.75 and -1 sharpen progressively more
+	A float64 `json:"a,omitempty"`
+	// B and C are the Mitchell-Netravali parameters, 1/3 each when both are zero.
+	// B blurs and C rings: B = 1 and C = 0 give the cubic B-spline, and B = 0 and
+	// C = 0.5 Catmull-Rom.
+	B float64 `json:"b,omitempty"`
+	C float64 `json:"c,omitempty"`
+	// Sigma is the standard deviation of the Gaussian kernel in input samples,
+	// 1 by default
+	Sigma float64 `json:"sigma,omitempty"`
+	// Radius is the half-width ±Radius of the kernel support in samples. It
+	// truncates the Gaussian, by default at the smallest integer covering 3σ, and
+	// sets the a of LanczosN and the Hann, Hamming and Blackman sincs (default 4),
+	// the 2*Radius taps of KaiserSinc (default 8) and the 2*Radius points of
+	// LagrangeN (default 4)
+	Radius int `json:"radius,omitempty"`
+	// Beta shapes the Kaiser window of KaiserSinc, 8.6 by default: larger values
+	// attenuate the stopband further at the cost of a wider transition band
+	Beta float64 `json:"beta,omitempty"`
+	// Degree is the polynomial degree of BSplineN and the blend parameter d of
+	// FloaterHormann, 3 by default for both
+	Degree int `json:"degree,omitempty"`
+	// Tension is the tension σ of TensionSpline in inverse input samples: 0 (the
+	// default) gives the natural cubic spline, and the spline approaches straight
+	// lines between the samples as σ grows
+	Tension float64 `json:"tension,omitempty"`
+}
+
+// maxKernelRadius bounds the support the parameters may ask for, far wider than
+// any useful kernel but small enough that the radius and the tap loops cannot
+// overflow
+const maxKernelRadius = 1 << 16
+
+// validate rejects parameters that select no kernel: non-finite values, negative
+// ones, and a radius, 3σ or degree beyond maxKernelRadius
+func (p KernelParams) validate() error {
+	for _, v := range []float64{p.A, p.B, p.C, p.Sigma, p.Beta, p.Tension} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("kernel parameters must be finite, got a=%v b=%v c=%v sigma=%v beta=%v tension=%v", p.A, p.B, p.C, p.Sigma, p.Beta, p.Tension)
+		}
+	}
+	if p.Sigma < 0 || p.Radius < 0 || p.Beta < 0 || p.Degree < 0 || p.Tension < 0 {
+		return fmt.Errorf("kernel sigma, radius, beta, degree and tension must not be negative, got %v, %d, %v, %d and %v", p.Sigma, p.Radius, p.Beta, p.Degree, p.Tension)
+	}
+	if p.Radius > maxKernelRadius || 3*p.Sigma > maxKernelRadius || p.Degree > maxKernelRadius {
+		return fmt.Errorf("kernel radius, 3*sigma and degree must not exceed %d, got %d, %v and %d", maxKernelRadius, p.Radius, 3*p.Sigma, p.Degree)
+	}
+	return nil
+}
+
+// kernel returns the convolution kernel of interpolatorType with these parameters
+func (p KernelParams) kernel(interpolatorType InterpolatorType) (kernel, bool) {
+	switch interpolatorType {
+	case Keys:
+		return keysKernel(p.A), true
+	case MitchellNetravali:
+		return mitchellNetravaliKernel(p.B, p.C), true
+	case Gaussian:
+		return gaussianKernel(p.Sigma, p.Radius), true
+	case LanczosN:
+		return lanczosNKernel(p.Radius), true
+	case KaiserSinc:
+		return kaiserSincKernel(p.Radius, p.Beta), true
+	case HannSinc, HammingSinc, BlackmanSinc:
+		return cosineSincKernel(interpolatorType, p.Radius), true
+	case BSplineN:
+		return bsplineNKernel(p.Degree), true
+	case LagrangeN:
+		return lagrangeNKernel(p.Radius), true
+	}
+	return kernelFor(interpolatorType)
+}
+
+// paramEvaluators builds the interpolants of the parameterized interpolators that
+// are not convolution kernels
+var paramEvaluators = map[InterpolatorType]func(in []float64, p KernelParams) func(pos float64) float64{
+	FloaterHormann: func(in []float64, p KernelParams) func(float64) float64 {
+		return newFloaterHormann(samplePositions(len(in)), in, p.Degree).at
+	},
+	TensionSpline: func(in []float64, p KernelParams) func(float64) float64 {
+		return newTensionSpline(samplePositions(len(in)), in, p.Tension).at
+	},
+}
+
+// keysKernel returns the Keys cubic convolution kernel with parameter a, or -0.5
+// for a = 0
+func keysKernel(a float64) kernel {
+	if a == 0 {
+		a = -0.5
+	}
+	impulse := func(x float64) float64 {
+		x = math.Abs(x)
+		switch {
+		case x < 1:
+			return ((a+2)*x-(a+3))*x*x + 1
+		case x < 2:
+			return ((a*x-5*a)*x+8*a)*x - 4*a
+		}
+		return 0
+	}
+	return kernel{impulse: impulse, radius: 2, boundary: BoundaryClamp}
+}
+
+// mitchellNetravaliKernel returns the Mitchell-Netravali kernel with parameters b
+// and c, or 1/3 each when both are zero
+func mitchellNetravaliKernel(b, c float64) kernel {
+	if b == 0 && c == 0 {
+		b, c = 1.0/3, 1.0/3
+	}
+	impulse := func(x float64) float64 {
+		x = math.Abs(x)
+		switch {
+		case x < 1:
+			return ((12-9*b-6*c)*x*x*x + (-18+12*b+6*c)*x*x + (6 - 2*b)) / 6
+		case x < 2:
+			return ((-b-6*c)*x*x*x + (6*b+30*c)*x*x + (-12*b-48*c)*x + (8*b + 24*c)) / 6
+		}
+		return 0
+	}
+	return kernel{impulse: impulse, radius: 2, boundary: BoundaryClamp}
+}
+
+// gaussianKernel returns the Gaussian kernel with standard deviation sigma
+// truncated to ±radius, or the defaults for zero values. The Gaussian is lowered
+// to reach zero at the radius, which keeps the interpolant continuous, and scaled
+// to unit area; the weights are also normalized at each position, so constant
+// signals keep their level exactly.
+func gaussianKernel(sigma float64, radius int) kernel {
+	if sigma == 0 {
+		sigma = 1
+	}
+	if radius == 0 {
+		radius = int(math.Ceil(3 * sigma))
+	}
+	r := float64(radius)
+	s2 := sigma * sigma
+	gauss := func(x float64) float64 { return math.Exp(-x * x / (2 * s2)) }
+	floor := gauss(r)
+	area := sigma*math.Sqrt(2*math.Pi)*math.Erf(r/(sigma*math.Sqrt2)) - 2*r*floor
+	impulse := func(x float64) float64 {
+		if math.Abs(x) >= r {
+			return 0
+		}
+		return (gauss(x) - floor) / area
+	}
+	derivative := func(x float64, order int) float64 {
+		if math.Abs(x) >= r {
+			return 0
+		}
+		if order == 1 {
+			return -x / s2 * gauss(x) / area
+		}
+		return (x*x/s2 - 1) / s2 * gauss(x) / area
+	}
+	return kernel{impulse: impulse, radius: radius, boundary: BoundaryClamp, normalize: true, derivative: derivative}
+}
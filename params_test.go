@@ -0,0 +1,222 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKeysDefaultIsCatmullRom(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1}
+	got, err := Interpolate(in, 22, Keys)
+	if err != nil {
+		t.Fatalf("Interpolate(Keys) returned unexpected error: %v", err)
+	}
+	want, _ := Interpolate(in, 22, Hermite4)
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("Interpolate(Keys)[%d] = %v, want Catmull-Rom %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestKeysParameter(t *testing.T) {
+	tests := []struct {
+		a    float64
+		x    float64
+		want float64
+	}{
+		// (a+2)|x|³ - (a+3)|x|² + 1 inside one sample, a|x|³ - 5a|x|² + 8a|x| - 4a beyond
+		{-0.5, 0.5, 0.5625},
+		{-0.75, 0.5, 0.59375},
+		{-1, 0.5, 0.625},
+		{-0.75, 1.5, -0.09375},
+		{-1, -1.5, -0.125},
+		{-0.75, 2, 0},
+	}
+	for _, tt := range tests {
+		k, _ := KernelParams{A: tt.a}.kernel(Keys)
+		if got := k.impulse(tt.x); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("Keys(a=%v) impulse(%v) = %v, want %v", tt.a, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestKeysWithOptions(t *testing.T) {
+	// Sharper kernels overshoot more at a step
+	in := []float64{0, 0, 0, 1, 1, 1}
+	undershoot := func(a float64) float64 {
+		out, err := InterpolateWithOptions(in, 51, Keys, Options{Kernel: KernelParams{A: a}})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(Keys, a=%v) returned unexpected error: %v", a, err)
+		}
+		lowest := 0.0
+		for _, v := range out {
+			lowest = math.Min(lowest, v)
+		}
+		return lowest
+	}
+	if catmullRom, sharp := undershoot(-0.5), undershoot(-1); !(sharp < catmullRom && catmullRom < 0) {
+		t.Errorf("Keys undershoot a=-0.5: %v, a=-1: %v, want a=-1 to ring more", catmullRom, sharp)
+	}
+}
+
+func TestMitchellNetravaliSpecialCases(t *testing.T) {
+	bspline, _ := kernelFor(BSpline3)
+	catmullRom, _ := kernelFor(Hermite4)
+	tests := []struct {
+		name string
+		b, c float64
+		want func(float64) float64
+	}{
+		{"cubic B-spline", 1, 0, bspline.impulse},
+		{"Catmull-Rom", 0, 0.5, catmullRom.impulse},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _ := KernelParams{B: tt.b, C: tt.c}.kernel(MitchellNetravali)
+			for x := -2.5; x <= 2.5; x += 0.125 {
+				if got, want := k.impulse(x), tt.want(x); math.Abs(got-want) > 1e-12 {
+					t.Errorf("MitchellNetravali(B=%v, C=%v) impulse(%v) = %v, want %v", tt.b, tt.c, x, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMitchellNetravaliDefault(t *testing.T) {
+	k, _ := kernelFor(MitchellNetravali)
+	// With B = C = 1/3 the center tap is (6-2B)/6 = 8/9 and the neighbors 1/18
+	if got := k.impulse(0); math.Abs(got-8.0/9) > 1e-12 {
+		t.Errorf("MitchellNetravali impulse(0) = %v, want 8/9", got)
+	}
+	if got := k.impulse(1); math.Abs(got-1.0/18) > 1e-12 {
+		t.Errorf("MitchellNetravali impulse(1) = %v, want 1/18", got)
+	}
+	// Every BC-spline reproduces constants
+	out, _ := Interpolate([]float64{2, 2, 2, 2, 2}, 17, MitchellNetravali)
+	for i, v := range out {
+		if math.Abs(v-2) > 1e-12 {
+			t.Errorf("Interpolate(MitchellNetravali) of a constant [%d] = %v, want 2", i, v)
+		}
+	}
+}
+
+func TestGaussianKernel(t *testing.T) {
+	k, _ := kernelFor(Gaussian)
+	if k.radius != 3 {
+		t.Errorf("Gaussian default radius = %d, want 3", k.radius)
+	}
+	if got := k.impulse(3); got != 0 {
+		t.Errorf("Gaussian impulse at the radius = %v, want 0", got)
+	}
+	// Unit area keeps a constant signal at its level, up to the sampling ripple
+	out, _ := Interpolate([]float64{2, 2, 2, 2, 2, 2, 2, 2, 2}, 33, Gaussian)
+	for i, v := range out {
+		if math.Abs(v-2) > 1e-6 {
+			t.Errorf("Interpolate(Gaussian) of a constant [%d] = %v, want 2", i, v)
+		}
+	}
+}
+
+func TestGaussianSigma(t *testing.T) {
+	// Wider kernels smooth an impulse into a lower, broader bump
+	in := make([]float64, 21)
+	in[10] = 1
+	peak := func(sigma float64, radius int) float64 {
+		out, err := InterpolateWithOptions(in, len(in), Gaussian, Options{Kernel: KernelParams{Sigma: sigma, Radius: radius}})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(Gaussian, σ=%v) returned unexpected error: %v", sigma, err)
+		}
+		return out[10]
+	}
+	narrow, wide := peak(0.8, 0), peak(2, 0)
+	if !(wide < narrow && narrow < 1) {
+		t.Errorf("Gaussian peaks σ=0.8: %v, σ=2: %v, want lower for wider kernels", narrow, wide)
+	}
+	// Without truncation effects the peak approaches 1/(σ√(2π))
+	if got, want := peak(2, 10), 1/(2*math.Sqrt(2*math.Pi)); math.Abs(got-want) > 1e-3 {
+		t.Errorf("Gaussian peak σ=2 = %v, want about %v", got, want)
+	}
+	if _, err := InterpolateWithOptions(in, 5, Gaussian, Options{Kernel: KernelParams{Sigma: -1}}); err == nil {
+		t.Error("InterpolateWithOptions() with a negative sigma should return an error")
+	}
+}
+
+func TestKernelParamsRejected(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1}
+	for _, tc := range []struct {
+		typ    InterpolatorType
+		params KernelParams
+	}{
+		{Keys, KernelParams{A: math.NaN()}},
+		{MitchellNetravali, KernelParams{B: math.Inf(1)}},
+		{Gaussian, KernelParams{Sigma: math.NaN()}},
+		{Gaussian, KernelParams{Sigma: math.Inf(1)}},
+		{Gaussian, KernelParams{Sigma: 1e300}},
+		{Gaussian, KernelParams{Radius: 1 << 40}},
+		{KaiserSinc, KernelParams{Beta: math.NaN()}},
+		{BSplineN, KernelParams{Degree: 1 << 40}},
+		{TensionSpline, KernelParams{Tension: math.Inf(1)}},
+	} {
+		if _, err := InterpolateWithOptions(in, 20, tc.typ, Options{Kernel: tc.params}); err == nil {
+			t.Errorf("InterpolateWithOptions(%v, %+v) should return an error", tc.typ, tc.params)
+		}
+	}
+}
+
+func TestGaussianDerivative(t *testing.T) {
+	k, _ := kernelFor(Gaussian)
+	for _, order := range []int{1, 2} {
+		d := mustKernelDerivative(t, Gaussian, k, order)
+		f := k.impulse
+		if order == 2 {
+			f = mustKernelDerivative(t, Gaussian, k, 1)
+		}
+		for _, x := range []float64{-2.3, -0.7, 0.4, 1.9} {
+			h := 1e-5
+			if want := (f(x+h) - f(x-h)) / (2 * h); math.Abs(d(x)-want) > 1e-6 {
+				t.Errorf("Gaussian derivative %d at %v = %v, want %v", order, x, d(x), want)
+			}
+		}
+	}
+}
+
+func TestGaussianInterpolantDerivative(t *testing.T) {
+	// The normalized interpolant is differentiated by the quotient rule
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2}
+	positions := []float64{2.3, 4.5, 6.9}
+	for _, order := range []int{1, 2} {
+		var got []float64
+		if order == 1 {
+			got, _ = DerivativeAt(in, positions, Gaussian)
+		} else {
+			got, _ = SecondDerivativeAt(in, positions, Gaussian)
+		}
+		for i, pos := range positions {
+			h := 1e-4
+			f, _ := InterpolateAt(in, []float64{pos - h, pos, pos + h}, Gaussian)
+			want := (f[2] - f[0]) / (2 * h)
+			if order == 2 {
+				want = (f[2] - 2*f[1] + f[0]) / (h * h)
+			}
+			if math.Abs(got[i]-want) > 1e-4 {
+				t.Errorf("derivative %d of Interpolate(Gaussian) at %v = %v, want %v", order, pos, got[i], want)
+			}
+		}
+	}
+}
+
+func TestGaussianPolyphase(t *testing.T) {
+	// Every phase is normalized like the direct evaluation
+	p, err := NewPolyphase(3, 2, Gaussian)
+	if err != nil {
+		t.Fatalf("NewPolyphase(Gaussian) returned unexpected error: %v", err)
+	}
+	in := []float64{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	out := p.Resample(in)
+	for i := 4; i < len(out)-4; i++ {
+		if math.Abs(out[i]-2) > 1e-12 {
+			t.Errorf("Polyphase(Gaussian).Resample() of a constant [%d] = %v, want 2", i, out[i])
+		}
+	}
+}
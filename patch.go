@@ -0,0 +1,32 @@
+package interpolators
+
+import "fmt"
+
+// EvalBicubicPatch evaluates the 4x4 patch of samples p, indexed p[row][column], at
+// the fractional position (u, v) within its central cell, where (0, 0) is p[1][1]
+// and (1, 1) is p[2][2]. The interpolator is applied separably along both axes, so
+// Hermite4 gives a Catmull-Rom patch and BSpline3 a uniform cubic B-spline patch.
+// Any interpolator whose kernel spans at most four samples is supported.
+func EvalBicubicPatch(p [4][4]float64, u, v float64, interpolatorType InterpolatorType) (float64, error) {
+	k, ok := kernelFor(interpolatorType)
+	if !ok || k.radius > 2 {
+		return 0, fmt.Errorf("interpolator type %d has no 4x4 patch form", interpolatorType)
+	}
+
+	var wu, wv [4]float64
+	for i := range wu {
+		// Tap i sits at offset i-1 from the cell origin
+		wu[i] = k.impulse(u - float64(i-1))
+		wv[i] = k.impulse(v - float64(i-1))
+	}
+
+	sum := 0.0
+	for r := range p {
+		row := 0.0
+		for c := range p[r] {
+			row += p[r][c] * wu[c]
+		}
+		sum += row * wv[r]
+	}
+	return sum, nil
+}
@@ -0,0 +1,72 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvalBicubicPatch(t *testing.T) {
+	// f(x, y) = 2x + 3y + 1 sampled at integer offsets -1..2
+	var p [4][4]float64
+	for r := range p {
+		for c := range p[r] {
+			p[r][c] = 2*float64(c-1) + 3*float64(r-1) + 1
+		}
+	}
+
+	types := []struct {
+		name string
+		typ  InterpolatorType
+	}{
+		{"Linear", Linear},
+		{"BSpline3", BSpline3},
+		{"Lagrange4", Lagrange4},
+		{"Hermite4", Hermite4},
+	}
+	for _, tt := range types {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, uv := range [][2]float64{{0, 0}, {1, 1}, {0.25, 0.75}, {0.5, 0.1}} {
+				got, err := EvalBicubicPatch(p, uv[0], uv[1], tt.typ)
+				if err != nil {
+					t.Fatalf("EvalBicubicPatch() returned unexpected error: %v", err)
+				}
+				// All of these reproduce linear functions exactly
+				want := 2*uv[0] + 3*uv[1] + 1
+				if math.Abs(got-want) > 1e-12 {
+					t.Errorf("EvalBicubicPatch(%v) = %v, want %v", uv, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEvalBicubicPatchMatchesInterpolateAt(t *testing.T) {
+	// Along a single row the patch reduces to the 1D interpolator
+	row := []float64{1, 4, -2, 3}
+	var p [4][4]float64
+	for r := range p {
+		p[r] = [4]float64{row[0], row[1], row[2], row[3]}
+	}
+	for _, typ := range []InterpolatorType{Hermite4, BSpline3, Watte} {
+		want, _ := InterpolateAt(row, []float64{1.3}, typ)
+		got, _ := EvalBicubicPatch(p, 0.3, 0.6, typ)
+		k, _ := kernelFor(typ)
+		// A constant column scales by the kernel's partition of unity
+		gain := 0.0
+		for i := 0; i < 4; i++ {
+			gain += k.impulse(0.6 - float64(i-1))
+		}
+		if math.Abs(got-want[0]*gain) > 1e-12 {
+			t.Errorf("EvalBicubicPatch(%d) = %v, want %v", typ, got, want[0]*gain)
+		}
+	}
+}
+
+func TestEvalBicubicPatchUnsupported(t *testing.T) {
+	var p [4][4]float64
+	for _, typ := range []InterpolatorType{Lanczos3, CubicSpline, Akima} {
+		if _, err := EvalBicubicPatch(p, 0.5, 0.5, typ); err == nil {
+			t.Errorf("EvalBicubicPatch(%d) should return an error", typ)
+		}
+	}
+}
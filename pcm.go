@@ -0,0 +1,55 @@
+package interpolators
+
+import "math"
+
+// InterpolateInt16 resamples 16-bit PCM samples like Interpolate. Results are
+// rounded like InterpolateInt and saturate at the ends of the int16 range, so the
+// overshoot of ringing kernels such as Lanczos or CubicSpline on a full-scale
+// signal clips instead of wrapping around to the opposite sign.
+func InterpolateInt16(in []int16, outSamples int, interpolatorType InterpolatorType) ([]int16, error) {
+	if len(in) == 0 {
+		return []int16{}, nil
+	}
+	inFloat := make([]float64, len(in))
+	for i, v := range in {
+		inFloat[i] = float64(v)
+	}
+	outFloat, err := Interpolate(inFloat, outSamples, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]int16, len(outFloat))
+	for i, v := range outFloat {
+		out[i] = int16(saturate(v, math.MinInt16, math.MaxInt16))
+	}
+	return out, nil
+}
+
+// InterpolateUint8 resamples 8-bit data, such as image rows or 8-bit sensor
+// readings, like Interpolate. Results are rounded and clamped to [0, 255], so the
+// undershoot of ringing kernels next to a black edge stays black rather than
+// wrapping around to white.
+func InterpolateUint8(in []uint8, outSamples int, interpolatorType InterpolatorType) ([]uint8, error) {
+	if len(in) == 0 {
+		return []uint8{}, nil
+	}
+	inFloat := make([]float64, len(in))
+	for i, v := range in {
+		inFloat[i] = float64(v)
+	}
+	outFloat, err := Interpolate(inFloat, outSamples, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]uint8, len(outFloat))
+	for i, v := range outFloat {
+		out[i] = uint8(saturate(v, 0, math.MaxUint8))
+	}
+	return out, nil
+}
+
+// saturate rounds v to the nearest integer, halves away from zero, and clamps it
+// to [lo, hi]
+func saturate(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, math.Round(v)))
+}
@@ -0,0 +1,98 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestInterpolateInt16(t *testing.T) {
+	in := []int16{0, 1000, -1000, 2001, 3}
+	for _, typ := range []InterpolatorType{Linear, Lanczos3, CubicSpline, Hermite4} {
+		got, err := InterpolateInt16(in, 17, typ)
+		if err != nil {
+			t.Fatalf("InterpolateInt16(%v) returned unexpected error: %v", typ, err)
+		}
+		inFloat := []float64{0, 1000, -1000, 2001, 3}
+		want, _ := Interpolate(inFloat, 17, typ)
+		for i := range want {
+			if got[i] != int16(math.Round(want[i])) {
+				t.Errorf("InterpolateInt16(%v)[%d] = %d, want %v rounded", typ, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestInterpolateInt16Saturation(t *testing.T) {
+	// A full-scale square wave makes Lanczos3 and CubicSpline overshoot both rails
+	in := []int16{math.MaxInt16, math.MaxInt16, math.MaxInt16, math.MinInt16, math.MinInt16, math.MinInt16, math.MaxInt16, math.MaxInt16}
+	inFloat := make([]float64, len(in))
+	for i, v := range in {
+		inFloat[i] = float64(v)
+	}
+	for _, typ := range []InterpolatorType{Lanczos3, CubicSpline} {
+		want, _ := Interpolate(inFloat, 50, typ)
+		above, below := false, false
+		for _, v := range want {
+			above = above || v > math.MaxInt16
+			below = below || v < math.MinInt16
+		}
+		if !above || !below {
+			t.Fatalf("%v does not overshoot both rails, the test needs new data", typ)
+		}
+		got, err := InterpolateInt16(in, 50, typ)
+		if err != nil {
+			t.Fatalf("InterpolateInt16(%v) returned unexpected error: %v", typ, err)
+		}
+		for i, v := range want {
+			switch {
+			case v > math.MaxInt16 && got[i] != math.MaxInt16:
+				t.Errorf("InterpolateInt16(%v)[%d] = %d, want %d for %v", typ, i, got[i], math.MaxInt16, v)
+			case v < math.MinInt16 && got[i] != math.MinInt16:
+				t.Errorf("InterpolateInt16(%v)[%d] = %d, want %d for %v", typ, i, got[i], math.MinInt16, v)
+			}
+		}
+	}
+}
+
+func TestInterpolateInt16Errors(t *testing.T) {
+	if out, err := InterpolateInt16(nil, 5, Linear); err != nil || len(out) != 0 {
+		t.Errorf("InterpolateInt16(nil) = %v, %v, want empty output", out, err)
+	}
+	if _, err := InterpolateInt16([]int16{1, 2, 3}, 0, Linear); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateInt16(outSamples=0) returned %v, want ErrInvalidOutSamples", err)
+	}
+}
+
+func TestInterpolateUint8(t *testing.T) {
+	// A hard edge between black and white rings past both ends with Lanczos3 and
+	// CubicSpline
+	in := []uint8{0, 0, 0, 0, 255, 255, 255, 255, 0, 0, 0}
+	inFloat := make([]float64, len(in))
+	for i, v := range in {
+		inFloat[i] = float64(v)
+	}
+	for _, typ := range []InterpolatorType{Linear, Lanczos3, CubicSpline} {
+		want, _ := Interpolate(inFloat, 41, typ)
+		if typ != Linear && (slices.Min(want) >= 0 || slices.Max(want) <= 255) {
+			t.Fatalf("%v does not ring past both ends, the test needs new data", typ)
+		}
+		got, err := InterpolateUint8(in, 41, typ)
+		if err != nil {
+			t.Fatalf("InterpolateUint8(%v) returned unexpected error: %v", typ, err)
+		}
+		for i, v := range want {
+			expected := uint8(math.Max(0, math.Min(255, math.Round(v))))
+			if got[i] != expected {
+				t.Errorf("InterpolateUint8(%v)[%d] = %d, want %d for %v", typ, i, got[i], expected, v)
+			}
+		}
+	}
+	if out, err := InterpolateUint8(nil, 5, Linear); err != nil || len(out) != 0 {
+		t.Errorf("InterpolateUint8(nil) = %v, %v, want empty output", out, err)
+	}
+	if _, err := InterpolateUint8([]uint8{1, 2}, 5, Lanczos3); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("InterpolateUint8(Lanczos3, 2 samples) returned %v, want ErrTooFewPoints", err)
+	}
+}
@@ -0,0 +1,132 @@
+package interpolators
+
+import "math"
+
+// refineTolerance is the position accuracy targeted by the sub-sample searches
+const refineTolerance = 1e-10
+
+// RefinePeak locates the extremum of the interpolant near sample i with sub-sample
+// precision and returns its position and value. Whether a maximum or a minimum is
+// sought follows from the curvature of the samples around i. The piecewise-constant
+// types and Linear peak exactly on a sample, so for those a three-point parabolic
+// fit is used instead. An index outside in returns NaN for both values.
+func RefinePeak(in []float64, i int, interpolatorType InterpolatorType) (pos, val float64) {
+	n := len(in)
+	if i < 0 || i >= n {
+		return math.NaN(), math.NaN()
+	}
+	if n < 3 {
+		return float64(i), in[i]
+	}
+
+	// Choose the neighbours so edge samples still get a three-point neighbourhood
+	center := i
+	if center == 0 {
+		center = 1
+	} else if center == n-1 {
+		center = n - 2
+	}
+	y0, y1, y2 := in[center-1], in[center], in[center+1]
+	sign := 1.0
+	if y0-2*y1+y2 > 0 {
+		// Convex around i, so look for a minimum
+		sign = -1.0
+	}
+
+	switch interpolatorType {
+	case None, DropSample, Linear:
+		offset, value := parabolicPeak(y0, y1, y2)
+		return float64(center) + offset, value
+	}
+
+	f := newEvaluator(in, interpolatorType)
+	lo := math.Max(0, float64(i-1))
+	hi := math.Min(float64(n-1), float64(i+1))
+	pos = maximize(func(x float64) float64 { return sign * f(x) }, lo, hi)
+	return pos, f(pos)
+}
+
+// maximize finds the maximum of f on [lo, hi] with a coarse scan followed by a
+// golden-section search around the best scan point
+func maximize(f func(float64) float64, lo, hi float64) float64 {
+	const scanSteps = 16
+	step := (hi - lo) / scanSteps
+	best := lo
+	bestVal := f(lo)
+	for s := 1; s <= scanSteps; s++ {
+		x := lo + float64(s)*step
+		if v := f(x); v > bestVal {
+			best, bestVal = x, v
+		}
+	}
+
+	a := math.Max(lo, best-step)
+	b := math.Min(hi, best+step)
+	invPhi := (math.Sqrt(5) - 1) / 2
+	c := b - invPhi*(b-a)
+	d := a + invPhi*(b-a)
+	fc, fd := f(c), f(d)
+	for b-a > refineTolerance {
+		if fc > fd {
+			b, d, fd = d, c, fc
+			c = b - invPhi*(b-a)
+			fc = f(c)
+		} else {
+			a, c, fc = c, d, fd
+			d = a + invPhi*(b-a)
+			fd = f(d)
+		}
+	}
+
+	x := (a + b) / 2
+	if f(x) < bestVal {
+		return best
+	}
+	return x
+}
+
+// ZeroCrossings returns the fractional positions where the interpolant of in crosses
+// zero, in increasing order. Each unit interval is checked for a sign change of the
+// interpolant at its ends and the crossing is found by bisection, so a pair of
+// crossings inside a single interval is not reported. Samples that are exactly zero
+// are reported at their integer position.
+func ZeroCrossings(in []float64, interpolatorType InterpolatorType) []float64 {
+	crossings := []float64{}
+	if len(in) == 0 {
+		return crossings
+	}
+
+	f := newEvaluator(in, interpolatorType)
+	prev := f(0)
+	if prev == 0 {
+		crossings = append(crossings, 0)
+	}
+	for k := 1; k < len(in); k++ {
+		cur := f(float64(k))
+		if prev*cur < 0 {
+			crossings = append(crossings, bisect(f, float64(k-1), float64(k), prev))
+		}
+		if cur == 0 {
+			crossings = append(crossings, float64(k))
+		}
+		prev = cur
+	}
+	return crossings
+}
+
+// bisect finds a root of f in [a, b] given fa = f(a) and a sign change across the interval
+func bisect(f func(float64) float64, a, b, fa float64) float64 {
+	for b-a > refineTolerance {
+		mid := (a + b) / 2
+		fm := f(mid)
+		if fm == 0 {
+			return mid
+		}
+		if (fm < 0) == (fa < 0) {
+			a, fa = mid, fm
+		} else {
+			b = mid
+		}
+	}
+	return (a + b) / 2
+}
@@ -0,0 +1,127 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRefinePeak(t *testing.T) {
+	// Samples of a cosine whose peak sits between samples
+	peak := 10.3
+	in := make([]float64, 21)
+	for i := range in {
+		in[i] = math.Cos((float64(i) - peak) * 0.2)
+	}
+
+	tests := []struct {
+		name         string
+		interpolator InterpolatorType
+		tolerance    float64
+	}{
+		{"Linear", Linear, 0.05},
+		{"CubicSpline", CubicSpline, 0.01},
+		{"Lagrange6", Lagrange6, 0.01},
+		{"Hermite4", Hermite4, 0.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, val := RefinePeak(in, 10, tt.interpolator)
+			if math.Abs(pos-peak) > tt.tolerance {
+				t.Errorf("RefinePeak() pos = %v, want %v", pos, peak)
+			}
+			if val < in[10] || math.Abs(val-1) > tt.tolerance {
+				t.Errorf("RefinePeak() val = %v, want close to 1", val)
+			}
+		})
+	}
+}
+
+func TestRefinePeakMinimum(t *testing.T) {
+	in := []float64{4, 1, 0.2, 0.8, 3}
+	pos, val := RefinePeak(in, 2, CubicSpline)
+	if pos < 1.5 || pos > 2.5 {
+		t.Errorf("RefinePeak() pos = %v, want within half a sample of 2", pos)
+	}
+	if val > 0.2 {
+		t.Errorf("RefinePeak() val = %v, want at most the sample minimum 0.2", val)
+	}
+}
+
+func TestRefinePeakOutOfRange(t *testing.T) {
+	pos, val := RefinePeak([]float64{1, 2, 3}, 5, Linear)
+	if !math.IsNaN(pos) || !math.IsNaN(val) {
+		t.Errorf("RefinePeak() out of range = (%v, %v), want NaN", pos, val)
+	}
+}
+
+func TestZeroCrossings(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        []float64
+		interpolator InterpolatorType
+		expected     []float64
+	}{
+		{
+			name:         "linear crossings",
+			input:        []float64{-1, 1, 3, -1},
+			interpolator: Linear,
+			expected:     []float64{0.5, 2.75},
+		},
+		{
+			name:         "exact zero sample",
+			input:        []float64{-1, 0, 1},
+			interpolator: Linear,
+			expected:     []float64{1},
+		},
+		{
+			name:         "no crossings",
+			input:        []float64{1, 2, 3},
+			interpolator: CubicSpline,
+			expected:     []float64{},
+		},
+		{
+			name:         "drop sample switches halfway",
+			input:        []float64{-1, 1},
+			interpolator: DropSample,
+			expected:     []float64{0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ZeroCrossings(tt.input, tt.interpolator)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("ZeroCrossings() = %v, want %v", got, tt.expected)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.expected[i]) > 1e-8 {
+					t.Errorf("ZeroCrossings()[%d] = %v, want %v", i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestZeroCrossingsSine(t *testing.T) {
+	in := make([]float64, 64)
+	for i := range in {
+		in[i] = math.Sin(float64(i)*0.3 + 0.1)
+	}
+	got := ZeroCrossings(in, Lanczos3)
+	for _, pos := range got {
+		if pos > float64(len(in)-4) {
+			// Lanczos3 clamps its taps near the end of the input
+			continue
+		}
+		// Zeros of sin(0.3x + 0.1) are at x = (k*pi - 0.1) / 0.3
+		k := math.Round((pos*0.3 + 0.1) / math.Pi)
+		want := (k*math.Pi - 0.1) / 0.3
+		if math.Abs(pos-want) > 0.02 {
+			t.Errorf("ZeroCrossings() crossing at %v, want %v", pos, want)
+		}
+	}
+	if len(got) != 6 {
+		t.Errorf("ZeroCrossings() found %d crossings, want 6", len(got))
+	}
+}
@@ -0,0 +1,82 @@
+package interpolators
+
+import "math"
+
+// periodicSplinePad is the number of wrapped samples added on each side for the
+// natural cubic spline; the influence of the artificial ends decays by roughly a
+// factor of four per sample, so this makes them negligible
+const periodicSplinePad = 24
+
+// periodicGlobalPeriods is the number of whole cycles added on each side for the
+// interpolators that use every sample, so the cycle sits well inside the padded
+// signal instead of near its ends
+const periodicGlobalPeriods = 2
+
+// InterpolatePeriodic treats in as one cycle of a periodic signal and resamples
+// that cycle to outSamples. Output sample i sits at position i*len(in)/outSamples,
+// so the output is itself one seamless cycle, and kernels wrap around the ends
+// instead of seeing an edge.
+func InterpolatePeriodic(in []float64, outSamples int, interpolatorType InterpolatorType) (out []float64, err error) {
+	if interpolatorType == None {
+		out = make([]float64, len(in))
+		copy(out, in)
+		return out, nil
+	}
+	// The wrapped kernels never run out of taps, so only the output length and
+	// the type need checking
+	if err := validateOutSamples(len(in), outSamples); err != nil {
+		return nil, err
+	}
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	f := newPeriodicEvaluator(in, interpolatorType)
+	out = make([]float64, outSamples)
+	step := float64(len(in)) / float64(outSamples)
+	for i := range out {
+		out[i] = f(float64(i) * step)
+	}
+	return out, nil
+}
+
+// newPeriodicEvaluator returns a function evaluating the periodic interpolant of the
+// cycle in at any position, which is wrapped into [0, len(in)). Sinc sums the
+// periodic sinc over the cycle, the band-limited interpolant of a periodic signal.
+func newPeriodicEvaluator(in []float64, interpolatorType InterpolatorType) func(pos float64) float64 {
+	n := len(in)
+	if n == 0 {
+		return func(float64) float64 { return 0 }
+	}
+
+	period := float64(n)
+	wrap := func(pos float64) float64 {
+		pos = math.Mod(pos, period)
+		if pos < 0 {
+			pos += period
+		}
+		return pos
+	}
+	if interpolatorType == Sinc {
+		impulse := dirichletImpulse(n)
+		return func(pos float64) float64 { return sincSum(in, wrap(pos), impulse) }
+	}
+
+	// Pad with enough wrapped samples that the ends of the padded signal are
+	// outside the reach of any position in the cycle
+	pad := 3
+	if k, ok := kernelFor(interpolatorType); ok {
+		pad = k.radius + 1
+	} else if interpolatorType == CubicSpline || interpolatorType == OMOMS || interpolatorType == TensionSpline || interpolatorType == Hyman {
+		pad = periodicSplinePad
+	} else if interpolatorType == Barycentric || interpolatorType == FloaterHormann {
+		pad = periodicGlobalPeriods * n
+	}
+
+	extended := make([]float64, n+2*pad)
+	for i := range extended {
+		idx, _ := BoundaryWrap.index(i-pad, n)
+		extended[i] = in[idx]
+	}
+	f := newEvaluator(extended, interpolatorType)
+	return func(pos float64) float64 { return f(wrap(pos) + float64(pad)) }
+}
@@ -0,0 +1,84 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterpolatePeriodicSine(t *testing.T) {
+	// One cycle of a sine sampled at 16 points, upsampled to 64
+	n := 16
+	in := make([]float64, n)
+	for i := range in {
+		in[i] = math.Sin(2 * math.Pi * float64(i) / float64(n))
+	}
+
+	tests := []struct {
+		name         string
+		interpolator InterpolatorType
+		tolerance    float64
+	}{
+		{"Linear", Linear, 0.02},
+		{"Lagrange6", Lagrange6, 1e-4},
+		{"Hermite4", Hermite4, 0.01},
+		{"CubicSpline", CubicSpline, 1e-3},
+		{"Akima", Akima, 0.01},
+		{"Sinc", Sinc, 1e-12},
+		{"Barycentric", Barycentric, 1e-10},
+		{"FloaterHormann", FloaterHormann, 1e-3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := InterpolatePeriodic(in, 64, tt.interpolator)
+			if err != nil {
+				t.Fatalf("InterpolatePeriodic() returned unexpected error: %v", err)
+			}
+			if len(out) != 64 {
+				t.Fatalf("InterpolatePeriodic() length = %d, want 64", len(out))
+			}
+			for i, v := range out {
+				want := math.Sin(2 * math.Pi * float64(i) / 64)
+				if math.Abs(v-want) > tt.tolerance {
+					t.Errorf("InterpolatePeriodic()[%d] = %v, want %v", i, v, want)
+				}
+			}
+		})
+	}
+}
+
+func TestInterpolatePeriodicSeam(t *testing.T) {
+	// Positions just before the end of the cycle blend toward the first sample
+	in := []float64{0, 1, 2, 3}
+	out, err := InterpolatePeriodic(in, 8, Linear)
+	if err != nil {
+		t.Fatalf("InterpolatePeriodic() returned unexpected error: %v", err)
+	}
+	if math.Abs(out[7]-1.5) > 1e-10 {
+		t.Errorf("InterpolatePeriodic()[7] = %v, want 1.5 (halfway from 3 back to 0)", out[7])
+	}
+}
+
+func TestPeriodicEvaluatorWraps(t *testing.T) {
+	in := []float64{1, 4, 2, 8, 5}
+	f := newPeriodicEvaluator(in, Lanczos3)
+	for _, pos := range []float64{0.3, 2.7, 4.5} {
+		if math.Abs(f(pos)-f(pos+5)) > 1e-12 || math.Abs(f(pos)-f(pos-10)) > 1e-12 {
+			t.Errorf("periodic evaluator at %v differs across cycles: %v %v %v", pos, f(pos), f(pos+5), f(pos-10))
+		}
+	}
+}
+
+func TestInterpolatePeriodicValidation(t *testing.T) {
+	for _, info := range All()[1:] {
+		for _, in := range [][]float64{nil, {1, 2, 3}} {
+			if _, err := InterpolatePeriodic(in, -1, info.Type); !errors.Is(err, ErrInvalidOutSamples) {
+				t.Errorf("InterpolatePeriodic(%d samples, -1, %v) error = %v, want %v", len(in), info.Type, err, ErrInvalidOutSamples)
+			}
+		}
+		if _, err := InterpolatePeriodic([]float64{1, 2, 3}, 0, info.Type); !errors.Is(err, ErrInvalidOutSamples) {
+			t.Errorf("InterpolatePeriodic(3 samples, 0, %v) error = %v, want %v", info.Type, err, ErrInvalidOutSamples)
+		}
+	}
+}
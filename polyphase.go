@@ -0,0 +1,111 @@
+package interpolators
+
+import "fmt"
+
+// maxPolyphasePhases bounds the size of the precomputed coefficient table
+const maxPolyphasePhases = 1 << 16
+
+// Polyphase resamples by a fixed rational ratio up/down. Output sample k sits at
+// input position k*down/up, so only up distinct fractional offsets ever occur and
+// the kernel weights for each of them are computed once up front.
+type Polyphase struct {
+	up, down int
+	k        kernel
+	// coeffs[p][t] is the weight of tap floor(pos)-radius+1+t at phase p, where the
+	// fractional part of pos is p/up
+	coeffs [][]float64
+}
+
+// NewPolyphase creates a polyphase resampler producing up output samples for every
+// down input samples, e.g. NewPolyphase(160, 147, Lanczos3) for 44.1 kHz to 48 kHz.
+// The ratio is reduced to lowest terms. Only the convolution-based interpolators
+// are supported.
+func NewPolyphase(up, down int, interpolatorType InterpolatorType) (*Polyphase, error) {
+	k, ok := kernelFor(interpolatorType)
+	if !ok {
+		return nil, fmt.Errorf("interpolator type %d has no polyphase form", interpolatorType)
+	}
+	return newPolyphase(up, down, k)
+}
+
+// newPolyphase builds the coefficient table for an arbitrary kernel
+func newPolyphase(up, down int, k kernel) (*Polyphase, error) {
+	if up <= 0 || down <= 0 {
+		return nil, fmt.Errorf("resampling factors must be positive, got %d/%d", up, down)
+	}
+	g := gcd(up, down)
+	up /= g
+	down /= g
+	if up > maxPolyphasePhases {
+		return nil, fmt.Errorf("up factor %d exceeds the maximum of %d phases", up, maxPolyphasePhases)
+	}
+
+	taps := 2 * k.radius
+	coeffs := make([][]float64, up)
+	for p := range coeffs {
+		frac := float64(p) / float64(up)
+		coeffs[p] = make([]float64, taps)
+		for t := 0; t < taps; t++ {
+			// Distance from the tap to the output position
+			coeffs[p][t] = k.impulse(frac + float64(k.radius-1-t))
+		}
+		if k.normalize {
+			sum := 0.0
+			for _, c := range coeffs[p] {
+				sum += c
+			}
+			for t := range coeffs[p] {
+				coeffs[p][t] /= sum
+			}
+		}
+	}
+
+	return &Polyphase{up: up, down: down, k: k, coeffs: coeffs}, nil
+}
+
+// Ratio returns the reduced up and down factors
+func (p *Polyphase) Ratio() (up, down int) {
+	return p.up, p.down
+}
+
+// Resample converts in by the polyphase ratio. The output covers the same span as
+// the input, from position 0 to the last input sample.
+func (p *Polyphase) Resample(in []float64) []float64 {
+	if len(in) == 0 {
+		return []float64{}
+	}
+
+	lastIdx := len(in) - 1
+	outSamples := lastIdx*p.up/p.down + 1
+	out := make([]float64, outSamples)
+	radius := p.k.radius
+
+	for i := range out {
+		// Exact integer phase tracking: position = i*down/up
+		num := i * p.down
+		base := num / p.up
+		phase := num % p.up
+		c := p.coeffs[phase]
+
+		sum := 0.0
+		first := base - radius + 1
+		for t, w := range c {
+			idx, ok := p.k.boundary.index(first+t, len(in))
+			if !ok {
+				continue
+			}
+			sum += in[idx] * w
+		}
+		out[i] = sum
+	}
+
+	return out
+}
+
+// gcd returns the greatest common divisor of two positive integers
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
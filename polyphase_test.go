@@ -0,0 +1,81 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolyphaseMatchesKernel(t *testing.T) {
+	in := make([]float64, 300)
+	for i := range in {
+		in[i] = math.Sin(float64(i)*0.05) - 0.4*math.Sin(float64(i)*0.23)
+	}
+
+	ratios := []struct{ up, down int }{
+		{2, 3},
+		{3, 2},
+		{160, 147},
+		{147, 160},
+		{4, 1},
+	}
+	types := []InterpolatorType{Linear, BSpline3, Lagrange6, Hermite4, Lanczos3}
+
+	for _, r := range ratios {
+		for _, typ := range types {
+			p, err := NewPolyphase(r.up, r.down, typ)
+			if err != nil {
+				t.Fatalf("NewPolyphase() returned unexpected error: %v", err)
+			}
+			out := p.Resample(in)
+			expectedLen := (len(in)-1)*r.up/r.down + 1
+			if len(out) != expectedLen {
+				t.Fatalf("Resample() %d/%d length = %d, want %d", r.up, r.down, len(out), expectedLen)
+			}
+			k, _ := kernelFor(typ)
+			for i := range out {
+				want := k.eval(in, float64(i)*float64(r.down)/float64(r.up))
+				if math.Abs(out[i]-want) > 1e-9 {
+					t.Errorf("Resample() %d/%d type %d out[%d] = %v, want %v", r.up, r.down, typ, i, out[i], want)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestPolyphaseRatioReduced(t *testing.T) {
+	p, err := NewPolyphase(320, 294, Linear)
+	if err != nil {
+		t.Fatalf("NewPolyphase() returned unexpected error: %v", err)
+	}
+	if up, down := p.Ratio(); up != 160 || down != 147 {
+		t.Errorf("Ratio() = %d/%d, want 160/147", up, down)
+	}
+}
+
+func TestNewPolyphaseErrors(t *testing.T) {
+	if _, err := NewPolyphase(0, 3, Linear); err == nil {
+		t.Error("NewPolyphase() with zero up factor should return an error")
+	}
+	if _, err := NewPolyphase(2, 3, Akima); err == nil {
+		t.Error("NewPolyphase() with a spline should return an error")
+	}
+	if _, err := NewPolyphase(maxPolyphasePhases+1, 1, Linear); err == nil {
+		t.Error("NewPolyphase() with too many phases should return an error")
+	}
+}
+
+func BenchmarkPolyphase(b *testing.B) {
+	in := make([]float64, 44100)
+	for i := range in {
+		in[i] = math.Sin(float64(i) * 0.01)
+	}
+	p, err := NewPolyphase(160, 147, Lanczos3)
+	if err != nil {
+		b.Fatalf("NewPolyphase() returned unexpected error: %v", err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Resample(in)
+	}
+}
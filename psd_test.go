@@ -0,0 +1,66 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRebinPSDConservesPower(t *testing.T) {
+	// A peaked spectrum on an uneven grid
+	freqs := []float64{0, 50, 100, 120, 140, 200, 400, 800, 1000}
+	psd := []float64{1, 2, 10, 40, 10, 3, 1, 0.5, 0.2}
+	edges := []float64{0, 125, 250, 1000}
+
+	for _, typ := range []InterpolatorType{Linear, MonotonicCubic, CubicSpline} {
+		out, err := RebinPSD(freqs, psd, edges, typ)
+		if err != nil {
+			t.Fatalf("RebinPSD(%d) returned unexpected error: %v", typ, err)
+		}
+		f := newXYEvaluator(freqs, psd, typ)
+		total := integrateXY(f, freqs, 0, 1000)
+		rebinned := 0.0
+		for k, v := range out {
+			rebinned += v * (edges[k+1] - edges[k])
+		}
+		if math.Abs(rebinned-total) > 1e-9*total {
+			t.Errorf("RebinPSD(%d) total power = %v, want %v", typ, rebinned, total)
+		}
+	}
+}
+
+func TestRebinPSDLinear(t *testing.T) {
+	freqs := []float64{0, 10, 20}
+	psd := []float64{0, 10, 0}
+	out, err := RebinPSD(freqs, psd, []float64{0, 10, 20, 30}, Linear)
+	if err != nil {
+		t.Fatalf("RebinPSD() returned unexpected error: %v", err)
+	}
+	// Each triangle half holds 50 units of power over a 10 Hz bin; the last bin
+	// lies beyond the measurement
+	want := []float64{5, 5, 0}
+	for k := range want {
+		if math.Abs(out[k]-want[k]) > 1e-12 {
+			t.Errorf("RebinPSD()[%d] = %v, want %v", k, out[k], want[k])
+		}
+	}
+}
+
+func TestRebinPSDInvalidInput(t *testing.T) {
+	tests := []struct {
+		name              string
+		freqs, psd, edges []float64
+	}{
+		{"length mismatch", []float64{0, 1}, []float64{1}, []float64{0, 1}},
+		{"unsorted frequencies", []float64{1, 0}, []float64{1, 1}, []float64{0, 1}},
+		{"single frequency", []float64{0}, []float64{1}, []float64{0, 1}},
+		{"single edge", []float64{0, 1}, []float64{1, 1}, []float64{0}},
+		{"unsorted edges", []float64{0, 1}, []float64{1, 1}, []float64{0, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := RebinPSD(tt.freqs, tt.psd, tt.edges, Linear); err == nil {
+				t.Error("RebinPSD() should return an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Pyramid holds progressively halved, anti-aliased copies of a signal (a 1D
+// mip-map), so a signal can be sampled at any zoom level at constant cost
+type Pyramid struct {
+	levels [][]float64
+	evals  []func(pos float64) float64
+}
+
+// BuildPyramid builds up to levels levels from in, where level 0 is the input and
+// level k holds one sample per 2^k input samples. Each level is low-pass filtered
+// with the interpolator's kernel widened by two before decimation; the splines,
+// which have no kernel, use a linear (tent) filter. Building stops early once a
+// level has a single sample.
+func BuildPyramid(in []float64, levels int, interpolatorType InterpolatorType) (*Pyramid, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if levels <= 0 {
+		return nil, fmt.Errorf("level count must be positive, got %d", levels)
+	}
+	if len(in) == 0 {
+		return nil, fmt.Errorf("input must not be empty")
+	}
+
+	k, ok := kernelFor(interpolatorType)
+	if !ok {
+		k, _ = kernelFor(Linear)
+	}
+	// Mirroring keeps the edges of the coarse levels from fading toward zero
+	halve, err := newPolyphase(1, 2, k.withBoundary(BoundaryMirror).stretched(2))
+	if err != nil {
+		return nil, err
+	}
+
+	level := append([]float64(nil), in...)
+	p := &Pyramid{}
+	for {
+		p.levels = append(p.levels, level)
+		p.evals = append(p.evals, newEvaluator(level, interpolatorType))
+		if len(p.levels) == levels || len(level) == 1 {
+			break
+		}
+		level = halve.Resample(level)
+	}
+	return p, nil
+}
+
+// Levels returns the number of levels built
+func (p *Pyramid) Levels() int {
+	return len(p.levels)
+}
+
+// Level returns the samples of level k, where sample j sits at input position j*2^k
+func (p *Pyramid) Level(k int) []float64 {
+	return p.levels[k]
+}
+
+// AtScale evaluates the signal at input position pos as seen when scale input
+// samples map to one output sample. The two levels bracketing log2(scale) are
+// each interpolated at pos and blended linearly, like trilinear texture sampling.
+// Scales at or below 1 read level 0; scales beyond the coarsest level read it.
+func (p *Pyramid) AtScale(pos, scale float64) float64 {
+	l := 0.0
+	if scale > 1 {
+		l = math.Log2(scale)
+	}
+	top := float64(len(p.levels) - 1)
+	if l >= top {
+		return p.at(len(p.levels)-1, pos)
+	}
+	k := int(l)
+	frac := l - float64(k)
+	v := p.at(k, pos)
+	if frac == 0 {
+		return v
+	}
+	return v*(1-frac) + p.at(k+1, pos)*frac
+}
+
+// at interpolates level k at input position pos
+func (p *Pyramid) at(k int, pos float64) float64 {
+	return p.evals[k](pos / float64(int(1)<<k))
+}
@@ -0,0 +1,96 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBuildPyramidLevels(t *testing.T) {
+	in := make([]float64, 17)
+	for i := range in {
+		in[i] = 3
+	}
+	p, err := BuildPyramid(in, 10, Lanczos3)
+	if err != nil {
+		t.Fatalf("BuildPyramid() returned unexpected error: %v", err)
+	}
+	// 17 -> 9 -> 5 -> 3 -> 2 -> 1
+	wantLengths := []int{17, 9, 5, 3, 2, 1}
+	if p.Levels() != len(wantLengths) {
+		t.Fatalf("Levels() = %d, want %d", p.Levels(), len(wantLengths))
+	}
+	for k, want := range wantLengths {
+		level := p.Level(k)
+		if len(level) != want {
+			t.Errorf("len(Level(%d)) = %d, want %d", k, len(level), want)
+		}
+		// The filters have unit gain and mirrored edges, so a constant stays constant
+		for j, v := range level {
+			if math.Abs(v-3) > 0.05 {
+				t.Errorf("Level(%d)[%d] = %v, want 3", k, j, v)
+			}
+		}
+	}
+
+	p, _ = BuildPyramid(in, 2, Linear)
+	if p.Levels() != 2 {
+		t.Errorf("Levels() = %d, want 2", p.Levels())
+	}
+}
+
+func TestPyramidRemovesAliasing(t *testing.T) {
+	// A Nyquist-rate oscillation on a slow ramp; coarse levels keep only the ramp
+	n := 1025
+	in := make([]float64, n)
+	for i := range in {
+		in[i] = float64(i) / 100
+		if i%2 == 1 {
+			in[i] += 1
+		} else {
+			in[i] -= 1
+		}
+	}
+	for _, typ := range []InterpolatorType{Linear, Lanczos3, CubicSpline} {
+		p, err := BuildPyramid(in, 6, typ)
+		if err != nil {
+			t.Fatalf("BuildPyramid(%d) returned unexpected error: %v", typ, err)
+		}
+		for _, pos := range []float64{300, 512.5, 700.25} {
+			got := p.AtScale(pos, 8)
+			// Lanczos weights do not sum exactly to one, which scales the ramp slightly
+			if want := pos / 100; math.Abs(got-want) > 0.15 {
+				t.Errorf("BuildPyramid(%d).AtScale(%v, 8) = %v, want about %v", typ, pos, got, want)
+			}
+		}
+	}
+}
+
+func TestPyramidAtScaleBlendsLevels(t *testing.T) {
+	in := []float64{0, 4, 0, 4, 0, 4, 0, 4, 0}
+	p, _ := BuildPyramid(in, 4, Linear)
+
+	if got := p.AtScale(3, 1); got != 4 {
+		t.Errorf("AtScale(3, 1) = %v, want 4", got)
+	}
+	if got := p.AtScale(3, 0.25); got != 4 {
+		t.Errorf("AtScale(3, 0.25) = %v, want 4", got)
+	}
+	fine := p.at(1, 3)
+	coarse := p.at(2, 3)
+	want := (fine + coarse) / 2
+	if got := p.AtScale(3, math.Sqrt(8)); math.Abs(got-want) > 1e-12 {
+		t.Errorf("AtScale(3, 2^1.5) = %v, want %v", got, want)
+	}
+	if got, want := p.AtScale(3, 1e6), p.at(3, 3); got != want {
+		t.Errorf("AtScale(3, 1e6) = %v, want coarsest level %v", got, want)
+	}
+}
+
+func TestBuildPyramidInvalidInput(t *testing.T) {
+	if _, err := BuildPyramid([]float64{1, 2}, 0, Linear); err == nil {
+		t.Error("BuildPyramid() with zero levels should return an error")
+	}
+	if _, err := BuildPyramid([]float64{}, 3, Linear); err == nil {
+		t.Error("BuildPyramid() with empty input should return an error")
+	}
+}
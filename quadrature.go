@@ -0,0 +1,73 @@
+package interpolators
+
+import (
+	"math"
+	"sort"
+)
+
+// Six-point Gauss-Legendre nodes and weights on [-1, 1]. The rule is exact for
+// polynomials up to degree 11, which covers the square of every quintic kernel
+// segment, so integrals of the polynomial interpolants are exact.
+var (
+	gaussNodes = [6]float64{
+		-0.9324695142031521, -0.6612093864662645, -0.2386191860831969,
+		0.2386191860831969, 0.6612093864662645, 0.9324695142031521,
+	}
+	gaussWeights = [6]float64{
+		0.1713244923791704, 0.3607615730481386, 0.4679139345726910,
+		0.4679139345726910, 0.3607615730481386, 0.1713244923791704,
+	}
+)
+
+// integrate returns the integral of f over [a, b], applying the quadrature rule
+// separately on each piece between integer positions where the piecewise
+// interpolants change polynomial
+func integrate(f func(float64) float64, a, b float64) float64 {
+	if b < a {
+		return -integrate(f, b, a)
+	}
+	sum := 0.0
+	for lo := a; lo < b; {
+		hi := math.Min(b, math.Floor(lo)+1)
+		half := (hi - lo) / 2
+		mid := (hi + lo) / 2
+		piece := 0.0
+		for i, x := range gaussNodes {
+			piece += gaussWeights[i] * f(mid+half*x)
+		}
+		sum += piece * half
+		lo = hi
+	}
+	return sum
+}
+
+// integrateXY returns the integral of f over [a, b] for an interpolant of samples
+// at the coordinates x, applying the quadrature rule separately on each piece
+// between coordinates
+func integrateXY(f func(float64) float64, x []float64, a, b float64) float64 {
+	if b < a {
+		return -integrateXY(f, x, b, a)
+	}
+	sum := 0.0
+	for lo := a; lo < b; {
+		// Next breakpoint above lo, or b beyond the last coordinate
+		hi := b
+		if j := sort.SearchFloat64s(x, lo); j < len(x) {
+			if x[j] == lo {
+				j++
+			}
+			if j < len(x) {
+				hi = math.Min(b, x[j])
+			}
+		}
+		half := (hi - lo) / 2
+		mid := (hi + lo) / 2
+		piece := 0.0
+		for i, node := range gaussNodes {
+			piece += gaussWeights[i] * f(mid+half*node)
+		}
+		sum += piece * half
+		lo = hi
+	}
+	return sum
+}
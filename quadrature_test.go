@@ -0,0 +1,34 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegrate(t *testing.T) {
+	// Exact for a quintic over a range crossing integer positions
+	f := func(x float64) float64 { return x*x*x*x*x - 2*x*x + 1 }
+	F := func(x float64) float64 { return x*x*x*x*x*x/6 - 2*x*x*x/3 + x }
+	got := integrate(f, 0.3, 2.7)
+	if want := F(2.7) - F(0.3); math.Abs(got-want) > 1e-10 {
+		t.Errorf("integrate() = %v, want %v", got, want)
+	}
+	if got := integrate(f, 2.7, 0.3); math.Abs(got+F(2.7)-F(0.3)) > 1e-10 {
+		t.Errorf("integrate() reversed = %v, want %v", got, F(0.3)-F(2.7))
+	}
+}
+
+func TestIntegrateXY(t *testing.T) {
+	// Exact on a piecewise-linear interpolant with uneven breakpoints
+	x := []float64{0, 0.5, 2, 3}
+	y := []float64{1, 3, -1, 2}
+	f := newXYEvaluator(x, y, Linear)
+	// Trapezoids over [0.25, 0.5], [0.5, 2] and [2, 2.5]
+	want := 0.25*(2+3)/2 + 1.5*(3-1)/2 + 0.5*(-1+0.5)/2
+	if got := integrateXY(f, x, 0.25, 2.5); math.Abs(got-want) > 1e-12 {
+		t.Errorf("integrateXY() = %v, want %v", got, want)
+	}
+	if got := integrateXY(f, x, 2.5, 0.25); math.Abs(got+want) > 1e-12 {
+		t.Errorf("integrateXY() reversed = %v, want %v", got, -want)
+	}
+}
@@ -0,0 +1,47 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// InterpolateQuantiles resamples aligned quantile series jointly, such as the p5,
+// p25, p50, p75 and p95 series behind a box plot or fan chart. quantiles holds one
+// series per quantile level in ascending order of level, all of the same length.
+// Each series is resampled to outSamples with the given interpolator; overshoot can
+// make neighbouring levels cross between samples, so the values at each output
+// point are then sorted (monotone rearrangement), which keeps the levels ordered
+// and moves no value further than needed.
+func InterpolateQuantiles(quantiles [][]float64, outSamples int, interpolatorType InterpolatorType) ([][]float64, error) {
+	if len(quantiles) == 0 {
+		return nil, errors.New("at least one quantile series is required")
+	}
+	n := len(quantiles[0])
+	for q, series := range quantiles {
+		if len(series) != n {
+			return nil, fmt.Errorf("quantile series %d has %d samples, want %d", q, len(series), n)
+		}
+	}
+
+	out := make([][]float64, len(quantiles))
+	for q, series := range quantiles {
+		resampled, err := Interpolate(series, outSamples, interpolatorType)
+		if err != nil {
+			return nil, err
+		}
+		out[q] = resampled
+	}
+
+	column := make([]float64, len(out))
+	for i := range out[0] {
+		for q := range out {
+			column[q] = out[q][i]
+		}
+		sort.Float64s(column)
+		for q := range out {
+			out[q][i] = column[q]
+		}
+	}
+	return out, nil
+}
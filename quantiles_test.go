@@ -0,0 +1,54 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateQuantilesKeepsOrder(t *testing.T) {
+	// p25 and p50 nearly touch next to a jump, where cubic overshoot would cross them
+	p25 := []float64{0, 0, 0, 10, 10, 10, 0, 0}
+	p50 := []float64{0.1, 0.1, 0.1, 10.1, 10.1, 10.1, 0.1, 0.1}
+	p75 := []float64{1, 1, 1, 1, 11, 11, 11, 1}
+	quantiles := [][]float64{p25, p50, p75}
+
+	for _, typ := range []InterpolatorType{Linear, CubicSpline, Lanczos3} {
+		out, err := InterpolateQuantiles(quantiles, 57, typ)
+		if err != nil {
+			t.Fatalf("InterpolateQuantiles(%v) returned unexpected error: %v", typ, err)
+		}
+		if len(out) != 3 || len(out[0]) != 57 {
+			t.Fatalf("InterpolateQuantiles(%v) shape = %d x %d, want 3 x 57", typ, len(out), len(out[0]))
+		}
+		for i := range out[0] {
+			if out[0][i] > out[1][i] || out[1][i] > out[2][i] {
+				t.Errorf("InterpolateQuantiles(%v) at %d = %v, %v, %v, want ascending", typ, i, out[0][i], out[1][i], out[2][i])
+			}
+		}
+	}
+}
+
+func TestInterpolateQuantilesUnchangedWhenOrdered(t *testing.T) {
+	quantiles := [][]float64{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}
+	out, _ := InterpolateQuantiles(quantiles, 5, Linear)
+	for q := range quantiles {
+		want, _ := Interpolate(quantiles[q], 5, Linear)
+		for i := range want {
+			if math.Abs(out[q][i]-want[i]) > 1e-12 {
+				t.Errorf("InterpolateQuantiles()[%d][%d] = %v, want %v", q, i, out[q][i], want[i])
+			}
+		}
+	}
+}
+
+func TestInterpolateQuantilesErrors(t *testing.T) {
+	if _, err := InterpolateQuantiles(nil, 4, Linear); err == nil {
+		t.Error("InterpolateQuantiles() with no series should return an error")
+	}
+	if _, err := InterpolateQuantiles([][]float64{{0, 1}, {0}}, 4, Linear); err == nil {
+		t.Error("InterpolateQuantiles() with ragged series should return an error")
+	}
+	if _, err := InterpolateQuantiles([][]float64{{0, 1}}, 0, Linear); err == nil {
+		t.Error("InterpolateQuantiles() with zero output samples should return an error")
+	}
+}
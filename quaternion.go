@@ -0,0 +1,160 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quaternion is the rotation quaternion W + Xi + Yj + Zk
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// RotationMethod selects how InterpolateQuaternions moves between orientations
+type RotationMethod int
+
+const (
+	// RotationSlerp rotates at constant angular speed along the shortest arc between
+	// successive orientations; the angular velocity jumps at each sample
+	RotationSlerp RotationMethod = iota
+	// RotationSquad blends slerps through control orientations chosen so the angular
+	// velocity is continuous across the samples, the rotational analogue of a
+	// cubic spline
+	RotationSquad
+)
+
+// dot returns the four-dimensional dot product of q and r
+func (q Quaternion) dot(r Quaternion) float64 {
+	return q.W*r.W + q.X*r.X + q.Y*r.Y + q.Z*r.Z
+}
+
+// scale returns q with every component multiplied by s
+func (q Quaternion) scale(s float64) Quaternion {
+	return Quaternion{q.W * s, q.X * s, q.Y * s, q.Z * s}
+}
+
+// add returns the componentwise sum of q and r
+func (q Quaternion) add(r Quaternion) Quaternion {
+	return Quaternion{q.W + r.W, q.X + r.X, q.Y + r.Y, q.Z + r.Z}
+}
+
+// mul returns the Hamilton product q·r, the rotation r followed by q
+func (q Quaternion) mul(r Quaternion) Quaternion {
+	return Quaternion{
+		W: q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+		X: q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		Y: q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		Z: q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+	}
+}
+
+// conj returns the conjugate of q, the inverse of a unit quaternion
+func (q Quaternion) conj() Quaternion {
+	return Quaternion{q.W, -q.X, -q.Y, -q.Z}
+}
+
+// log returns the logarithm of the unit quaternion q, a pure quaternion holding
+// the rotation axis scaled by half the rotation angle
+func (q Quaternion) log() Quaternion {
+	v := math.Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	if v < 1e-12 {
+		return Quaternion{}
+	}
+	s := math.Atan2(v, q.W) / v
+	return Quaternion{0, q.X * s, q.Y * s, q.Z * s}
+}
+
+// exp returns the exponential of the pure quaternion q, the inverse of log
+func (q Quaternion) exp() Quaternion {
+	theta := math.Sqrt(q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	if theta < 1e-12 {
+		return Quaternion{1, q.X, q.Y, q.Z}
+	}
+	s := math.Sin(theta) / theta
+	return Quaternion{math.Cos(theta), q.X * s, q.Y * s, q.Z * s}
+}
+
+// Slerp returns the spherical linear interpolation from unit quaternion a at t=0
+// to b at t=1, taking the shorter of the two arcs between the rotations
+func Slerp(a, b Quaternion, t float64) Quaternion {
+	d := a.dot(b)
+	if d < 0 {
+		// q and -q are the same rotation; flip b onto a's hemisphere
+		b, d = b.scale(-1), -d
+	}
+	if d > 0.9995 {
+		// Nearly parallel: linear interpolation is accurate and avoids 0/0
+		q := a.scale(1 - t).add(b.scale(t))
+		return q.scale(1 / math.Sqrt(q.dot(q)))
+	}
+	theta := math.Acos(d)
+	s := math.Sin(theta)
+	return a.scale(math.Sin((1-t)*theta) / s).add(b.scale(math.Sin(t*theta) / s))
+}
+
+// InterpolateQuaternions resamples a sequence of orientations, such as an IMU or
+// animation stream, to outSamples on the output grid of Interpolate. The inputs
+// are normalized and, since q and -q describe the same rotation, each is flipped
+// if needed to lie on the same side as its predecessor so the output never takes
+// the long way round. Zero or non-finite quaternions are rejected.
+func InterpolateQuaternions(in []Quaternion, outSamples int, method RotationMethod) ([]Quaternion, error) {
+	if method != RotationSlerp && method != RotationSquad {
+		return nil, fmt.Errorf("unknown rotation method %d", method)
+	}
+	n := len(in)
+	if n == 0 {
+		return []Quaternion{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	q := make([]Quaternion, n)
+	for i, v := range in {
+		norm := math.Sqrt(v.dot(v))
+		if !(norm > 0) || math.IsInf(norm, 0) {
+			return nil, fmt.Errorf("quaternion %d is not a rotation: %v", i, v)
+		}
+		q[i] = v.scale(1 / norm)
+		if i > 0 && q[i].dot(q[i-1]) < 0 {
+			q[i] = q[i].scale(-1)
+		}
+	}
+
+	out := make([]Quaternion, outSamples)
+	if n == 1 {
+		for i := range out {
+			out[i] = q[0]
+		}
+		return out, nil
+	}
+	var s []Quaternion
+	if method == RotationSquad {
+		s = squadControls(q)
+	}
+	for i := range out {
+		pos := outputPosition(i, n, outSamples)
+		j := segmentIndex(pos, n)
+		t := pos - float64(j)
+		if method == RotationSquad {
+			out[i] = Slerp(Slerp(q[j], q[j+1], t), Slerp(s[j], s[j+1], t), 2*t*(1-t))
+			continue
+		}
+		out[i] = Slerp(q[j], q[j+1], t)
+	}
+	return out, nil
+}
+
+// squadControls returns the inner control orientation of each sample for squad,
+// s_i = q_i·exp(-(log(q_i⁻¹q_{i+1}) + log(q_i⁻¹q_{i-1}))/4), with the end samples
+// as their own controls
+func squadControls(q []Quaternion) []Quaternion {
+	n := len(q)
+	s := make([]Quaternion, n)
+	s[0], s[n-1] = q[0], q[n-1]
+	for i := 1; i < n-1; i++ {
+		inv := q[i].conj()
+		next, prev := inv.mul(q[i+1]).log(), inv.mul(q[i-1]).log()
+		s[i] = q[i].mul(next.add(prev).scale(-0.25).exp())
+	}
+	return s
+}
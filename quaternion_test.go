@@ -0,0 +1,121 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// axisAngle returns the rotation by angle radians about the unit axis (x, y, z)
+func axisAngle(x, y, z, angle float64) Quaternion {
+	s := math.Sin(angle / 2)
+	return Quaternion{math.Cos(angle / 2), x * s, y * s, z * s}
+}
+
+// rotationAngle returns the angle in radians of the rotation between a and b
+func rotationAngle(a, b Quaternion) float64 {
+	return 2 * math.Acos(math.Min(1, math.Abs(a.dot(b))))
+}
+
+func TestSlerp(t *testing.T) {
+	a, b := axisAngle(0, 0, 1, 0), axisAngle(0, 0, 1, 2)
+	for _, tt := range []float64{0, 0.25, 0.5, 0.9, 1} {
+		got := Slerp(a, b, tt)
+		if want := axisAngle(0, 0, 1, 2*tt); rotationAngle(got, want) > 1e-7 {
+			t.Errorf("Slerp(%v) = %v, want %v", tt, got, want)
+		}
+	}
+	// The negated end point is the same rotation, so the path is the same
+	got, want := Slerp(a, b.scale(-1), 0.3), Slerp(a, b, 0.3)
+	if rotationAngle(got, want) > 1e-7 {
+		t.Errorf("Slerp to -b = %v, want %v", got, want)
+	}
+	// Nearly identical rotations take the linear branch
+	c := axisAngle(1, 0, 0, 1e-3)
+	if got := Slerp(a, c, 0.5); rotationAngle(got, axisAngle(1, 0, 0, 5e-4)) > 1e-7 {
+		t.Errorf("Slerp of close rotations = %v", got)
+	}
+}
+
+func TestInterpolateQuaternions(t *testing.T) {
+	// Steady rotation about a fixed axis, with some samples negated and scaled
+	in := make([]Quaternion, 6)
+	for i := range in {
+		in[i] = axisAngle(0.6, 0, 0.8, 0.5*float64(i)).scale(1 + float64(i))
+		if i%2 == 1 {
+			in[i] = in[i].scale(-1)
+		}
+	}
+	for _, method := range []RotationMethod{RotationSlerp, RotationSquad} {
+		out, err := InterpolateQuaternions(in, 26, method)
+		if err != nil {
+			t.Fatalf("InterpolateQuaternions(%v) returned unexpected error: %v", method, err)
+		}
+		for i, q := range out {
+			if norm := math.Sqrt(q.dot(q)); math.Abs(norm-1) > 1e-12 {
+				t.Errorf("method %d output %d has norm %v, want 1", method, i, norm)
+			}
+			want := axisAngle(0.6, 0, 0.8, 0.5*outputPosition(i, len(in), len(out)))
+			if rotationAngle(q, want) > 1e-7 {
+				t.Errorf("method %d output %d = %v, want %v", method, i, q, want)
+			}
+		}
+	}
+}
+
+func TestInterpolateQuaternionsSquad(t *testing.T) {
+	// Irregular orientations about changing axes
+	in := []Quaternion{
+		axisAngle(1, 0, 0, 0),
+		axisAngle(0, 1, 0, 0.8),
+		axisAngle(0, 0.6, 0.8, 1.9),
+		axisAngle(0.8, 0, 0.6, 0.7),
+		axisAngle(0, 0, 1, -2.2),
+	}
+	const per = 1000
+	outSamples := (len(in)-1)*per + 1
+	slerp, _ := InterpolateQuaternions(in, outSamples, RotationSlerp)
+	squad, err := InterpolateQuaternions(in, outSamples, RotationSquad)
+	if err != nil {
+		t.Fatalf("InterpolateQuaternions returned unexpected error: %v", err)
+	}
+	for i, q := range in {
+		if got := squad[i*per]; rotationAngle(got, q) > 1e-9 {
+			t.Errorf("squad at sample %d = %v, want %v", i, got, q)
+		}
+	}
+	// Angular speed on either side of an interior sample: continuous for squad,
+	// jumping for slerp
+	jump := func(out []Quaternion, i int) float64 {
+		before := rotationAngle(out[i*per-1], out[i*per])
+		after := rotationAngle(out[i*per], out[i*per+1])
+		return math.Abs(after-before) * per
+	}
+	for i := 1; i < len(in)-1; i++ {
+		if got := jump(squad, i); got > 0.01 {
+			t.Errorf("squad angular speed jumps by %v at sample %d", got, i)
+		}
+		if got := jump(slerp, i); got < 0.1 {
+			t.Errorf("slerp angular speed jumps by only %v at sample %d, the test data are too smooth", got, i)
+		}
+	}
+}
+
+func TestInterpolateQuaternionsErrors(t *testing.T) {
+	if _, err := InterpolateQuaternions([]Quaternion{{1, 0, 0, 0}, {}}, 4, RotationSlerp); err == nil {
+		t.Error("InterpolateQuaternions with a zero quaternion returned nil error")
+	}
+	if _, err := InterpolateQuaternions([]Quaternion{{math.NaN(), 0, 0, 0}}, 4, RotationSlerp); err == nil {
+		t.Error("InterpolateQuaternions with NaN returned nil error")
+	}
+	if _, err := InterpolateQuaternions([]Quaternion{{1, 0, 0, 0}}, 4, RotationMethod(9)); err == nil {
+		t.Error("InterpolateQuaternions with an unknown method returned nil error")
+	}
+	if _, err := InterpolateQuaternions([]Quaternion{{1, 0, 0, 0}}, 0, RotationSquad); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("InterpolateQuaternions(outSamples=0) returned %v, want ErrInvalidOutSamples", err)
+	}
+	out, err := InterpolateQuaternions([]Quaternion{{0, 0, 2, 0}}, 3, RotationSquad)
+	if err != nil || len(out) != 3 || out[2] != (Quaternion{0, 0, 1, 0}) {
+		t.Errorf("InterpolateQuaternions of one sample = %v, %v, want 3 normalized copies", out, err)
+	}
+}
@@ -0,0 +1,47 @@
+package interpolators
+
+import "fmt"
+
+// QuinticHermite evaluates the piecewise quintic through the samples y at the
+// strictly increasing coordinates x that also matches the first derivatives dy
+// and second derivatives ddy at every sample, at the coordinates xq. Each piece
+// depends only on the data at its two ends and the curve is C², so trajectories
+// built from known positions, velocities and accelerations keep a continuous
+// acceleration. Queries outside x extend the end quintics.
+func QuinticHermite(x, y, dy, ddy, xq []float64) ([]float64, error) {
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+	if len(dy) != len(x) || len(ddy) != len(x) {
+		return nil, fmt.Errorf("derivative lengths must match the %d samples, got %d and %d", len(x), len(dy), len(ddy))
+	}
+	if len(x) < 2 {
+		return nil, fmt.Errorf("%w: a quintic Hermite curve needs at least 2 samples, got %d", ErrTooFewPoints, len(x))
+	}
+	out := make([]float64, len(xq))
+	for i, q := range xq {
+		j := searchSegment(x, q)
+		c := quinticHermiteCoefficients(x[j+1]-x[j], y[j], y[j+1], dy[j], dy[j+1], ddy[j], ddy[j+1])
+		t := (q - x[j]) / (x[j+1] - x[j])
+		out[i] = c[0] + t*(c[1]+t*(c[2]+t*(c[3]+t*(c[4]+t*c[5]))))
+	}
+	return out, nil
+}
+
+// quinticHermiteCoefficients returns the coefficients in t = (q-x0)/h of the
+// quintic with values y0, y1, slopes d0, d1 and second derivatives a0, a1 at the
+// ends of an interval of length h
+func quinticHermiteCoefficients(h, y0, y1, d0, d1, a0, a1 float64) [6]float64 {
+	c0, c1, c2 := y0, d0*h, a0*h*h/2
+	// The misses of the quadratic part in value, slope and second derivative at
+	// t = 1, which the cubic to quintic terms make up
+	dv := y1 - c0 - c1 - c2
+	ds := d1*h - c1 - 2*c2
+	da := a1*h*h - 2*c2
+	return [6]float64{
+		c0, c1, c2,
+		10*dv - 4*ds + da/2,
+		-15*dv + 7*ds - da,
+		6*dv - 3*ds + da/2,
+	}
+}
@@ -0,0 +1,77 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestQuinticHermitePolynomial(t *testing.T) {
+	// A quintic is reproduced exactly from its own derivatives, outside x too
+	p := func(q float64) (float64, float64, float64) {
+		return 1 - 2*q + q*q*q - 0.3*q*q*q*q*q,
+			-2 + 3*q*q - 1.5*q*q*q*q,
+			6*q - 6*q*q*q
+	}
+	x := []float64{-1, 0.5, 2}
+	y, dy, ddy := make([]float64, 3), make([]float64, 3), make([]float64, 3)
+	for i, v := range x {
+		y[i], dy[i], ddy[i] = p(v)
+	}
+	xq := []float64{-1.5, -1, -0.2, 0.5, 1.3, 2, 2.4}
+	out, err := QuinticHermite(x, y, dy, ddy, xq)
+	if err != nil {
+		t.Fatalf("QuinticHermite returned unexpected error: %v", err)
+	}
+	for i, q := range xq {
+		if want, _, _ := p(q); math.Abs(out[i]-want) > 1e-12 {
+			t.Errorf("QuinticHermite at %v = %v, want %v", q, out[i], want)
+		}
+	}
+}
+
+func TestQuinticHermiteMatchesData(t *testing.T) {
+	// Arbitrary derivatives are matched at both ends of every piece, so velocity
+	// and acceleration are continuous across the samples
+	x := []float64{0, 1, 1.5, 4}
+	y := []float64{0, 2, 1, 3}
+	dy := []float64{1, -1, 0.5, 2}
+	ddy := []float64{0, 3, -2, 1}
+	out, err := QuinticHermite(x, y, dy, ddy, x)
+	if err != nil {
+		t.Fatalf("QuinticHermite returned unexpected error: %v", err)
+	}
+	for j := range x {
+		if math.Abs(out[j]-y[j]) > 1e-12 {
+			t.Errorf("value at %v = %v, want %v", x[j], out[j], y[j])
+		}
+	}
+	for j := 0; j < len(x)-1; j++ {
+		h := x[j+1] - x[j]
+		c := quinticHermiteCoefficients(h, y[j], y[j+1], dy[j], dy[j+1], ddy[j], ddy[j+1])
+		for end, k := range []int{j, j + 1} {
+			u := float64(end)
+			v, d1, d2 := 0.0, 0.0, 0.0
+			for i := 5; i >= 0; i-- {
+				d2 = d2*u + 2*d1
+				d1 = d1*u + v
+				v = v*u + c[i]
+			}
+			if math.Abs(v-y[k]) > 1e-12 || math.Abs(d1/h-dy[k]) > 1e-12 || math.Abs(d2/(h*h)-ddy[k]) > 1e-12 {
+				t.Errorf("piece %d at %v = %v, %v, %v, want %v, %v, %v", j, x[k], v, d1/h, d2/(h*h), y[k], dy[k], ddy[k])
+			}
+		}
+	}
+}
+
+func TestQuinticHermiteErrors(t *testing.T) {
+	if _, err := QuinticHermite([]float64{0}, []float64{1}, []float64{0}, []float64{0}, []float64{0}); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("QuinticHermite with one sample returned %v, want ErrTooFewPoints", err)
+	}
+	if _, err := QuinticHermite([]float64{0, 1}, []float64{1, 2}, []float64{0}, []float64{0, 0}, nil); err == nil {
+		t.Error("QuinticHermite with too few derivatives returned no error")
+	}
+	if _, err := QuinticHermite([]float64{1, 0}, []float64{1, 2}, []float64{0, 0}, []float64{0, 0}, nil); err == nil {
+		t.Error("QuinticHermite with decreasing coordinates returned no error")
+	}
+}
@@ -0,0 +1,168 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// RBFKernel selects the radial basis function
+type RBFKernel int
+
+const (
+	// RBFThinPlate is the thin-plate spline r² log r, which needs no shape parameter
+	RBFThinPlate RBFKernel = iota
+	// RBFMultiquadric is sqrt(1 + (εr)²)
+	RBFMultiquadric
+	// RBFGaussian is exp(-(εr)²)
+	RBFGaussian
+)
+
+// RBFOptions configures radial basis function interpolation. A zero Shape selects 1.
+type RBFOptions struct {
+	Kernel RBFKernel
+	// Shape is the shape parameter ε of the multiquadric and Gaussian kernels;
+	// larger values make each basis function more local
+	Shape float64
+	// Polynomial adds a linear polynomial term to the fit, so linear data is
+	// reproduced exactly and the thin-plate system is well posed
+	Polynomial bool
+}
+
+// RBF is a radial basis function interpolant through scattered points in any
+// number of dimensions
+type RBF struct {
+	opts    RBFOptions
+	centers [][]float64
+	weights []float64
+	// poly holds the constant and per-dimension linear coefficients, if enabled
+	poly []float64
+}
+
+// NewRBF fits a radial basis function interpolant through values at points, where
+// every point has the same number of coordinates. Fitting solves a dense system
+// and costs O(n³) in the number of points.
+func NewRBF(points [][]float64, values []float64, opts RBFOptions) (*RBF, error) {
+	if len(points) != len(values) {
+		return nil, fmt.Errorf("point and value lengths differ: %d and %d", len(points), len(values))
+	}
+	if len(points) == 0 {
+		return nil, errors.New("at least one point is required")
+	}
+	dim := len(points[0])
+	if dim == 0 {
+		return nil, errors.New("points must have at least one coordinate")
+	}
+	for i, p := range points {
+		if len(p) != dim {
+			return nil, fmt.Errorf("point %d has %d coordinates, want %d", i, len(p), dim)
+		}
+	}
+	switch opts.Kernel {
+	case RBFThinPlate, RBFMultiquadric, RBFGaussian:
+	default:
+		return nil, fmt.Errorf("unknown RBF kernel %d", opts.Kernel)
+	}
+	if opts.Shape == 0 {
+		opts.Shape = 1
+	}
+	if opts.Shape < 0 {
+		return nil, fmt.Errorf("shape parameter must be positive, got %v", opts.Shape)
+	}
+
+	n := len(points)
+	terms := 0
+	if opts.Polynomial {
+		terms = dim + 1
+	}
+	// Augmented system [Φ P; Pᵀ 0] [w; c] = [values; 0]
+	size := n + terms
+	m := newMatrix(size, size)
+	rhs := make([]float64, size)
+	r := &RBF{opts: opts, centers: make([][]float64, n)}
+	for i, p := range points {
+		r.centers[i] = append([]float64(nil), p...)
+		for j := range points {
+			m[i][j] = r.basis(distance(p, points[j]))
+		}
+		if opts.Polynomial {
+			m[i][n] = 1
+			m[n][i] = 1
+			for d, v := range p {
+				m[i][n+1+d] = v
+				m[n+1+d][i] = v
+			}
+		}
+		rhs[i] = values[i]
+	}
+
+	sol, err := solveLinear(m, rhs)
+	if err != nil {
+		return nil, fmt.Errorf("rbf system: %w", err)
+	}
+	r.weights = sol[:n]
+	if opts.Polynomial {
+		r.poly = sol[n:]
+	}
+	return r, nil
+}
+
+// basis evaluates the radial function at distance d
+func (r *RBF) basis(d float64) float64 {
+	switch r.opts.Kernel {
+	case RBFMultiquadric:
+		e := r.opts.Shape * d
+		return math.Sqrt(1 + e*e)
+	case RBFGaussian:
+		e := r.opts.Shape * d
+		return math.Exp(-e * e)
+	}
+	if d == 0 {
+		return 0
+	}
+	return d * d * math.Log(d)
+}
+
+// At evaluates the interpolant at point p, which must have as many coordinates as
+// the fitted points
+func (r *RBF) At(p ...float64) float64 {
+	sum := 0.0
+	for i, c := range r.centers {
+		sum += r.weights[i] * r.basis(distance(p, c))
+	}
+	if r.poly != nil {
+		sum += r.poly[0]
+		for d, v := range p {
+			sum += r.poly[1+d] * v
+		}
+	}
+	return sum
+}
+
+// InterpolateRBF fits a radial basis function through y at the coordinates x and
+// evaluates it at the coordinates xq. Unlike InterpolateXY, x need not be sorted.
+func InterpolateRBF(x, y, xq []float64, opts RBFOptions) ([]float64, error) {
+	points := make([][]float64, len(x))
+	for i, v := range x {
+		points[i] = []float64{v}
+	}
+	r, err := NewRBF(points, y, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, len(xq))
+	for i, q := range xq {
+		out[i] = r.At(q)
+	}
+	return out, nil
+}
+
+// distance returns the Euclidean distance between a and b
+func distance(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
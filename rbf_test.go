@@ -0,0 +1,98 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRBFInterpolatesScattered2D(t *testing.T) {
+	points := [][]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0.4, 0.6}, {0.8, 0.3}, {0.2, 0.2}}
+	values := make([]float64, len(points))
+	for i, p := range points {
+		values[i] = math.Sin(p[0]) + p[1]*p[1]
+	}
+	tests := []struct {
+		name string
+		opts RBFOptions
+	}{
+		{"thin-plate", RBFOptions{Kernel: RBFThinPlate, Polynomial: true}},
+		{"multiquadric", RBFOptions{Kernel: RBFMultiquadric, Shape: 2}},
+		{"gaussian", RBFOptions{Kernel: RBFGaussian, Shape: 3}},
+		{"gaussian with polynomial", RBFOptions{Kernel: RBFGaussian, Shape: 3, Polynomial: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRBF(points, values, tt.opts)
+			if err != nil {
+				t.Fatalf("NewRBF() returned unexpected error: %v", err)
+			}
+			for i, p := range points {
+				if got := r.At(p...); math.Abs(got-values[i]) > 1e-8 {
+					t.Errorf("At(%v) = %v, want %v", p, got, values[i])
+				}
+			}
+			want := math.Sin(0.5) + 0.25
+			if got := r.At(0.5, 0.5); math.Abs(got-want) > 0.1 {
+				t.Errorf("At(0.5, 0.5) = %v, want about %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRBFPolynomialReproducesLinear(t *testing.T) {
+	points := [][]float64{{0, 0}, {2, 0}, {0, 3}, {1, 1}, {3, 2}}
+	values := make([]float64, len(points))
+	for i, p := range points {
+		values[i] = 1 + 2*p[0] - p[1]
+	}
+	r, err := NewRBF(points, values, RBFOptions{Kernel: RBFThinPlate, Polynomial: true})
+	if err != nil {
+		t.Fatalf("NewRBF() returned unexpected error: %v", err)
+	}
+	for _, q := range [][]float64{{0.5, 0.5}, {2.5, 1}, {4, 4}} {
+		want := 1 + 2*q[0] - q[1]
+		if got := r.At(q...); math.Abs(got-want) > 1e-9 {
+			t.Errorf("At(%v) = %v, want %v", q, got, want)
+		}
+	}
+}
+
+func TestInterpolateRBF(t *testing.T) {
+	x := []float64{3, 0, 1, 2}
+	y := []float64{9, 0, 1, 4}
+	out, err := InterpolateRBF(x, y, []float64{0, 1, 2, 3, 1.5}, RBFOptions{Kernel: RBFMultiquadric})
+	if err != nil {
+		t.Fatalf("InterpolateRBF() returned unexpected error: %v", err)
+	}
+	for i, want := range []float64{0, 1, 4, 9} {
+		if math.Abs(out[i]-want) > 1e-9 {
+			t.Errorf("InterpolateRBF()[%d] = %v, want %v", i, out[i], want)
+		}
+	}
+	if math.Abs(out[4]-2.25) > 0.2 {
+		t.Errorf("InterpolateRBF() at 1.5 = %v, want about 2.25", out[4])
+	}
+}
+
+func TestNewRBFInvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		points [][]float64
+		values []float64
+		opts   RBFOptions
+	}{
+		{"length mismatch", [][]float64{{0}}, []float64{0, 1}, RBFOptions{}},
+		{"empty", [][]float64{}, []float64{}, RBFOptions{}},
+		{"ragged", [][]float64{{0, 0}, {1}}, []float64{0, 1}, RBFOptions{}},
+		{"unknown kernel", [][]float64{{0}}, []float64{0}, RBFOptions{Kernel: RBFKernel(99)}},
+		{"negative shape", [][]float64{{0}}, []float64{0}, RBFOptions{Shape: -1}},
+		{"duplicate points", [][]float64{{0}, {0}}, []float64{0, 1}, RBFOptions{Kernel: RBFGaussian}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRBF(tt.points, tt.values, tt.opts); err == nil {
+				t.Error("NewRBF() should return an error")
+			}
+		})
+	}
+}
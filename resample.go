@@ -0,0 +1,34 @@
+package interpolators
+
+// Resample converts in from sample rate srIn to srOut. Output sample k sits at input
+// position k*srIn/srOut, keeping the exact fractional ratio, and the output covers
+// the input up to its last sample. For the convolution-based interpolators this
+// produces the same samples as a Resampler fed the whole signal.
+func Resample(in []float64, srIn, srOut float64, interpolatorType InterpolatorType) ([]float64, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if err := checkRates(srIn, srOut); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+
+	step := srIn / srOut
+	last := float64(len(in) - 1)
+	n, err := steppedLength(last, step)
+	if err != nil {
+		return nil, err
+	}
+	f := newEvaluator(in, interpolatorType)
+	out := make([]float64, 0, n)
+	for k := 0; k < n; k++ {
+		pos := float64(k) * step
+		if pos > last {
+			break
+		}
+		out = append(out, f(pos))
+	}
+	return out, nil
+}
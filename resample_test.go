@@ -0,0 +1,85 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestResample(t *testing.T) {
+	in := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8}
+
+	tests := []struct {
+		name        string
+		srIn, srOut float64
+		expected    []float64
+	}{
+		{"identity", 100, 100, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8}},
+		{"double", 100, 200, []float64{0, 0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4, 4.5, 5, 5.5, 6, 6.5, 7, 7.5, 8}},
+		{"half", 200, 100, []float64{0, 2, 4, 6, 8}},
+		{"fractional", 300, 200, []float64{0, 1.5, 3, 4.5, 6, 7.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Resample(in, tt.srIn, tt.srOut, Linear)
+			if err != nil {
+				t.Fatalf("Resample() returned unexpected error: %v", err)
+			}
+			if len(out) != len(tt.expected) {
+				t.Fatalf("Resample() = %v, want %v", out, tt.expected)
+			}
+			for i := range out {
+				if math.Abs(out[i]-tt.expected[i]) > 1e-10 {
+					t.Errorf("Resample()[%d] = %v, want %v", i, out[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestResampleMatchesResampler(t *testing.T) {
+	in := make([]float64, 1000)
+	for i := range in {
+		in[i] = math.Sin(float64(i) * 0.03)
+	}
+	out, err := Resample(in, 44100, 48000, Lanczos3)
+	if err != nil {
+		t.Fatalf("Resample() returned unexpected error: %v", err)
+	}
+	r, _ := NewResampler(44100, 48000, Lanczos3)
+	streamed := append(r.Process(in), r.Flush()...)
+	if len(out) != len(streamed) {
+		t.Fatalf("Resample() length = %d, Resampler length = %d", len(out), len(streamed))
+	}
+	for i := range out {
+		if math.Abs(out[i]-streamed[i]) > 1e-12 {
+			t.Errorf("Resample()[%d] = %v, Resampler = %v", i, out[i], streamed[i])
+			break
+		}
+	}
+}
+
+func TestResampleErrors(t *testing.T) {
+	if _, err := Resample([]float64{1, 2}, 0, 48000, Linear); err == nil {
+		t.Error("Resample() with zero input rate should return an error")
+	}
+	for _, rates := range [][2]float64{{math.NaN(), 48000}, {44100, math.NaN()}, {math.Inf(1), 48000}, {44100, math.Inf(-1)}} {
+		if _, err := Resample([]float64{1, 2}, rates[0], rates[1], Linear); err == nil {
+			t.Errorf("Resample(%v, %v) should return an error", rates[0], rates[1])
+		}
+	}
+	// Finite rates whose ratio asks for more output than can be allocated
+	for _, rates := range [][2]float64{{1, 1e300}, {5e-324, 1}} {
+		if _, err := Resample([]float64{1, 2}, rates[0], rates[1], Linear); !errors.Is(err, ErrInvalidOutSamples) {
+			t.Errorf("Resample(%v, %v) error = %v, want %v", rates[0], rates[1], err, ErrInvalidOutSamples)
+		}
+	}
+	if out, err := Resample([]float64{1, 2}, 1e300, 1, Linear); err != nil || len(out) != 1 {
+		t.Errorf("Resample(1e300, 1) = %v, %v, want the first sample only", out, err)
+	}
+	out, err := Resample(nil, 44100, 48000, Linear)
+	if err != nil || len(out) != 0 {
+		t.Errorf("Resample() on empty input = %v, %v, want empty and no error", out, err)
+	}
+}
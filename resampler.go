@@ -0,0 +1,90 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// Resampler converts a continuous stream block by block. It keeps enough input
+// history that the kernel never sees a chunk boundary, so the concatenated output
+// is identical to resampling the whole stream at once.
+type Resampler struct {
+	k    kernel
+	step float64 // input samples advanced per output sample
+
+	history []float64 // buffered input samples
+	offset  int       // absolute input index of history[0]
+	next    int64     // index of the next output sample
+}
+
+// NewResampler creates a streaming resampler converting from sample rate srIn to
+// srOut with the given interpolator. Only the convolution-based interpolators are
+// supported, since the splines need the whole signal up front.
+func NewResampler(srIn, srOut float64, interpolatorType InterpolatorType) (*Resampler, error) {
+	if err := checkRates(srIn, srOut); err != nil {
+		return nil, err
+	}
+	k, ok := kernelFor(interpolatorType)
+	if !ok {
+		return nil, fmt.Errorf("interpolator type %d does not support streaming", interpolatorType)
+	}
+	return &Resampler{k: k, step: srIn / srOut}, nil
+}
+
+// Process appends chunk to the stream and returns every output sample whose
+// kernel taps are now available. Output lags the input by the kernel radius.
+func (r *Resampler) Process(chunk []float64) []float64 {
+	r.history = append(r.history, chunk...)
+	// An output is ready once its right-most tap, floor(pos)+radius, is buffered
+	return r.emit(float64(r.offset+len(r.history)-r.k.radius), false)
+}
+
+// Flush returns the remaining output samples up to the last input sample, treating
+// the end of the stream like the end of an array, and resets the Resampler so it
+// can be used for a new stream
+func (r *Resampler) Flush() []float64 {
+	out := r.emit(float64(r.offset+len(r.history)-1), true)
+	r.Reset()
+	return out
+}
+
+// Reset discards all buffered input and restarts the output position at zero
+func (r *Resampler) Reset() {
+	r.history = r.history[:0]
+	r.offset = 0
+	r.next = 0
+}
+
+// emit produces outputs for all positions below limit, or up to and including it
+// when inclusive is set
+func (r *Resampler) emit(limit float64, inclusive bool) []float64 {
+	if len(r.history) == 0 {
+		return []float64{}
+	}
+
+	var out []float64
+	for {
+		pos := float64(r.next) * r.step
+		if pos > limit || (pos == limit && !inclusive) {
+			break
+		}
+		out = append(out, r.k.eval(r.history, pos-float64(r.offset)))
+		r.next++
+	}
+
+	// Drop history no future output can reach. Index 0 is kept until every tap is
+	// past it so edge clamping at the start of the stream still sees it.
+	keep := int(math.Floor(float64(r.next)*r.step)) - r.k.radius + 1
+	if drop := keep - r.offset; drop > 0 {
+		if drop > len(r.history) {
+			drop = len(r.history)
+		}
+		r.history = append(r.history[:0], r.history[drop:]...)
+		r.offset += drop
+	}
+
+	if out == nil {
+		return []float64{}
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResamplerMatchesOneShot(t *testing.T) {
+	in := make([]float64, 500)
+	for i := range in {
+		in[i] = math.Sin(float64(i)*0.07) + 0.3*math.Cos(float64(i)*0.31)
+	}
+
+	rates := []struct {
+		name        string
+		srIn, srOut float64
+	}{
+		{"downsample", 48000, 44100},
+		{"upsample", 22050, 48000},
+		{"identity", 1000, 1000},
+	}
+	types := []InterpolatorType{DropSample, Linear, BSpline3, Lagrange6, Hermite4, Lanczos3}
+	chunkSizes := []int{1, 7, 64, 500}
+
+	for _, rate := range rates {
+		for _, typ := range types {
+			k, _ := kernelFor(typ)
+			step := rate.srIn / rate.srOut
+			var expected []float64
+			for i := 0; float64(i)*step <= float64(len(in)-1); i++ {
+				expected = append(expected, k.eval(in, float64(i)*step))
+			}
+
+			for _, chunkSize := range chunkSizes {
+				r, err := NewResampler(rate.srIn, rate.srOut, typ)
+				if err != nil {
+					t.Fatalf("NewResampler() returned unexpected error: %v", err)
+				}
+				var out []float64
+				for start := 0; start < len(in); start += chunkSize {
+					end := start + chunkSize
+					if end > len(in) {
+						end = len(in)
+					}
+					out = append(out, r.Process(in[start:end])...)
+				}
+				out = append(out, r.Flush()...)
+
+				if len(out) != len(expected) {
+					t.Fatalf("%s type %d chunk %d: got %d samples, want %d", rate.name, typ, chunkSize, len(out), len(expected))
+				}
+				for i := range out {
+					if math.Abs(out[i]-expected[i]) > 1e-10 {
+						t.Errorf("%s type %d chunk %d: out[%d] = %v, want %v", rate.name, typ, chunkSize, i, out[i], expected[i])
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestResamplerReuseAfterFlush(t *testing.T) {
+	r, err := NewResampler(2, 3, Linear)
+	if err != nil {
+		t.Fatalf("NewResampler() returned unexpected error: %v", err)
+	}
+	in := []float64{0, 1, 2, 3, 4}
+	first := append(r.Process(in), r.Flush()...)
+	second := append(r.Process(in), r.Flush()...)
+	if len(first) != len(second) {
+		t.Fatalf("second stream length = %d, want %d", len(second), len(first))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("second stream out[%d] = %v, want %v", i, second[i], first[i])
+		}
+	}
+}
+
+func TestNewResamplerErrors(t *testing.T) {
+	if _, err := NewResampler(0, 44100, Linear); err == nil {
+		t.Error("NewResampler() with zero input rate should return an error")
+	}
+	if _, err := NewResampler(44100, -1, Linear); err == nil {
+		t.Error("NewResampler() with negative output rate should return an error")
+	}
+	for _, rates := range [][2]float64{{math.NaN(), 48000}, {44100, math.NaN()}, {math.Inf(1), 48000}, {44100, math.Inf(1)}} {
+		if _, err := NewResampler(rates[0], rates[1], Linear); err == nil {
+			t.Errorf("NewResampler(%v, %v) should return an error", rates[0], rates[1])
+		}
+	}
+	if _, err := NewResampler(44100, 48000, CubicSpline); err == nil {
+		t.Error("NewResampler() with a global spline should return an error")
+	}
+}
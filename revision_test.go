@@ -0,0 +1,67 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Golden outputs of resampling revisionInput to 12 samples, recorded from each
+// revision's release. Never edit a revision's table: add a new revision instead.
+var revisionInput = []float64{0, 3, -1, 4, 2, -2, 5, 1}
+
+var revisionGolden = map[Revision]map[InterpolatorType][]float64{
+	Revision1: {
+		BSpline3:   {0.5, 1.63248184323, 1.49073378412, 0.489732031054, 1.63185574756, 2.99536689206, 2.17505634861, 0.299899824693, 0.0123966942149, 2.41610318057, 3.09704482845, 1.5},
+		Lagrange4:  {0, 2.19308790383, 1.93012772352, -0.767843726521, 1.81367392938, 4.01051840721, 2.62960180316, -0.351615326822, -1.48760330579, 3.34034560481, 4.0818933133, 1},
+		Hermite6_5: {0, 2.44735518562, 2.11511260408, -0.95952213895, 1.56157987221, 4.25873170611, 2.78966911103, -0.619729154119, -1.69166909861, 3.55184382587, 4.38723137391, 1},
+		Lanczos3:   {0, 2.60098353411, 2.02874284305, -0.961663109388, 1.40679578034, 4.43722296044, 3.02350227866, -0.961948784762, -1.63541011966, 3.4943948402, 4.57590337557, 1},
+		Linear:     {0, 1.90909090909, 1.90909090909, -0.636363636364, 1.72727272727, 3.63636363636, 2.36363636364, 0.181818181818, -1.36363636364, 3.09090909091, 3.54545454545, 1},
+	},
+	Revision2: {
+		BSpline3:   {0.5, 1.63248184323, 1.49073378412, 0.489732031054, 1.67881292261, 2.99536689206, 2.17405459554, 0.299899824693, 0.0123966942149, 2.42286501377, 3.09704482845, 1.5},
+		Lagrange4:  {0, 2.19308790383, 1.93012772352, -0.767843726521, 1.63335837716, 4.01051840721, 2.65890308039, -0.351615326822, -1.48760330579, 3.25619834711, 4.0818933133, 1},
+		Hermite6_5: {0, 2.44735518562, 2.11511260408, -0.95952213895, 1.56157987221, 4.25873170611, 2.79693389051, -0.619729154119, -1.69166909861, 3.5716636345, 4.38723137391, 1},
+		Lanczos3:   {0, 2.60098353411, 2.02874284305, -0.961663109388, 1.40679578034, 4.43722296044, 3.03524996443, -0.961948784762, -1.63541011966, 3.52919965358, 4.57590337557, 1},
+		Linear:     {0, 1.90909090909, 1.90909090909, -0.636363636364, 1.72727272727, 3.63636363636, 2.36363636364, 0.181818181818, -1.36363636364, 3.09090909091, 3.54545454545, 1},
+	},
+}
+
+func TestRevisionGolden(t *testing.T) {
+	for revision, outputs := range revisionGolden {
+		for interpolatorType, want := range outputs {
+			t.Run(interpolatorType.String(), func(t *testing.T) {
+				got, err := InterpolateWithOptions(revisionInput, len(want), interpolatorType, Options{Revision: revision})
+				if err != nil {
+					t.Fatalf("InterpolateWithOptions() returned unexpected error: %v", err)
+				}
+				for i := range want {
+					if math.Abs(got[i]-want[i]) > 1e-9 {
+						t.Fatalf("revision %d: InterpolateWithOptions()[%d] = %v, want %v", revision, i, got[i], want[i])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestRevisionLatest(t *testing.T) {
+	// The zero value tracks the newest revision, which is what Interpolate computes
+	for _, interpolatorType := range []InterpolatorType{BSpline3, Lagrange6, Hermite4, Lanczos2} {
+		want, _ := InterpolateWithOptions(revisionInput, 12, interpolatorType, Options{Revision: latestRevision})
+		got, _ := Interpolate(revisionInput, 12, interpolatorType)
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%v: Interpolate()[%d] = %v, want latest revision %v", interpolatorType, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestRevisionUnknown(t *testing.T) {
+	for _, revision := range []Revision{-1, latestRevision + 1} {
+		if _, err := InterpolateWithOptions(revisionInput, 12, Linear, Options{Revision: revision}); !errors.Is(err, ErrUnknownRevision) {
+			t.Errorf("InterpolateWithOptions() with revision %d error = %v, want ErrUnknownRevision", revision, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// WindowStats summarizes the continuous interpolant over one window
+type WindowStats struct {
+	// Start is the position of the start of the window in samples
+	Start float64
+	Mean  float64
+	Min   float64
+	Max   float64
+	// Std is the standard deviation of the interpolant over the window
+	Std float64
+}
+
+// RollingStats computes statistics of the continuous interpolant of in over sliding
+// windows of the given width, advancing by step; both are in samples and may be
+// fractional. Mean and standard deviation are integrals over the window, which are
+// exact for the piecewise-polynomial interpolators, and the extrema include peaks
+// between samples. Windows are produced while they fit inside the input.
+func RollingStats(in []float64, window, step float64, interpolatorType InterpolatorType) ([]WindowStats, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if !(window > 0) || !(step > 0) || math.IsInf(window, 0) || math.IsInf(step, 0) {
+		return nil, fmt.Errorf("window and step must be positive and finite, got %v and %v", window, step)
+	}
+	stats := []WindowStats{}
+	last := float64(len(in) - 1)
+	if len(in) == 0 || window > last {
+		return stats, nil
+	}
+	n, err := steppedLength(last-window, step)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newEvaluator(in, interpolatorType)
+	for k := 0; k < n; k++ {
+		start := float64(k) * step
+		end := start + window
+		if end > last {
+			break
+		}
+
+		mean := integrate(f, start, end) / window
+		variance := integrate(func(x float64) float64 {
+			d := f(x) - mean
+			return d * d
+		}, start, end) / window
+
+		ws := WindowStats{
+			Start: start,
+			Mean:  mean,
+			Min:   math.Inf(1),
+			Max:   math.Inf(-1),
+			Std:   math.Sqrt(variance),
+		}
+		// Search each piece between integer positions for its extrema
+		for lo := start; lo < end; {
+			hi := math.Min(end, math.Floor(lo)+1)
+			xMax := maximize(f, lo, hi)
+			xMin := maximize(func(x float64) float64 { return -f(x) }, lo, hi)
+			ws.Max = math.Max(ws.Max, f(xMax))
+			ws.Min = math.Min(ws.Min, f(xMin))
+			lo = hi
+		}
+		stats = append(stats, ws)
+	}
+	return stats, nil
+}
@@ -0,0 +1,66 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRollingStatsLinear(t *testing.T) {
+	// The linear interpolant of a ramp is the line itself
+	in := []float64{0, 1, 2, 3, 4, 5, 6}
+	stats, err := RollingStats(in, 2, 1.5, Linear)
+	if err != nil {
+		t.Fatalf("RollingStats() returned unexpected error: %v", err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("RollingStats() returned %d windows, want 3", len(stats))
+	}
+	for _, ws := range stats {
+		if math.Abs(ws.Mean-(ws.Start+1)) > 1e-10 {
+			t.Errorf("window at %v Mean = %v, want %v", ws.Start, ws.Mean, ws.Start+1)
+		}
+		if math.Abs(ws.Min-ws.Start) > 1e-8 || math.Abs(ws.Max-(ws.Start+2)) > 1e-8 {
+			t.Errorf("window at %v Min/Max = %v/%v, want %v/%v", ws.Start, ws.Min, ws.Max, ws.Start, ws.Start+2)
+		}
+		// Standard deviation of a uniform distribution of width 2
+		if math.Abs(ws.Std-2/math.Sqrt(12)) > 1e-10 {
+			t.Errorf("window at %v Std = %v, want %v", ws.Start, ws.Std, 2/math.Sqrt(12))
+		}
+	}
+}
+
+func TestRollingStatsInterSamplePeak(t *testing.T) {
+	// The spline overshoots the largest sample between samples 2 and 3
+	in := []float64{0, 0, 1, 1, 0, 0}
+	stats, err := RollingStats(in, 5, 1, CubicSpline)
+	if err != nil {
+		t.Fatalf("RollingStats() returned unexpected error: %v", err)
+	}
+	if len(stats) != 1 {
+		t.Fatalf("RollingStats() returned %d windows, want 1", len(stats))
+	}
+	if stats[0].Max <= 1 {
+		t.Errorf("RollingStats() Max = %v, want above the sample maximum 1", stats[0].Max)
+	}
+}
+
+func TestRollingStatsErrors(t *testing.T) {
+	if _, err := RollingStats([]float64{1, 2, 3}, 0, 1, Linear); err == nil {
+		t.Error("RollingStats() with zero window should return an error")
+	}
+	if _, err := RollingStats([]float64{1, 2, 3}, 1, -1, Linear); err == nil {
+		t.Error("RollingStats() with negative step should return an error")
+	}
+	for _, ws := range [][2]float64{{math.NaN(), 1}, {1, math.NaN()}, {math.Inf(1), 1}, {1, math.Inf(1)}} {
+		if _, err := RollingStats([]float64{1, 2, 3}, ws[0], ws[1], Linear); err == nil {
+			t.Errorf("RollingStats(window %v, step %v) should return an error", ws[0], ws[1])
+		}
+	}
+	if _, err := RollingStats([]float64{1, 2, 3}, 1, 5e-324, Linear); err == nil {
+		t.Error("RollingStats() with a subnormal step should return an error")
+	}
+	stats, err := RollingStats([]float64{1, 2}, 5, 1, Linear)
+	if err != nil || len(stats) != 0 {
+		t.Errorf("RollingStats() with window longer than input = %v, %v, want no windows", stats, err)
+	}
+}
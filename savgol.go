@@ -0,0 +1,80 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// SavitzkyGolayOptions configures Savitzky-Golay smoothing
+type SavitzkyGolayOptions struct {
+	// Window is the number of samples in each least-squares fit; it must exceed Order
+	Window int
+	// Order is the degree of the fitted polynomials. Higher orders preserve peak
+	// heights and widths better but smooth less.
+	Order int
+	// Derivative selects the returned quantity: 0 for the smoothed signal, 1 or
+	// higher for that derivative, in units per input sample. It must not exceed
+	// Order.
+	Derivative int
+}
+
+// SavitzkyGolay smooths in while resampling it onto the same output grid as
+// Interpolate. Each output is the value, or derivative, at its position of the
+// least-squares polynomial through the Window samples around it; near the ends the
+// window is shifted inward instead of running off the input.
+func SavitzkyGolay(in []float64, outSamples int, opts SavitzkyGolayOptions) ([]float64, error) {
+	if opts.Order < 0 || opts.Window <= opts.Order {
+		return nil, fmt.Errorf("Savitzky-Golay window of %d samples cannot fit order %d", opts.Window, opts.Order)
+	}
+	if opts.Derivative < 0 || opts.Derivative > opts.Order {
+		return nil, fmt.Errorf("Savitzky-Golay derivative %d must be between 0 and the order %d", opts.Derivative, opts.Order)
+	}
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	if len(in) < opts.Window {
+		return nil, fmt.Errorf("%w: a Savitzky-Golay window of %d needs at least %d samples, got %d", ErrTooFewPoints, opts.Window, opts.Window, len(in))
+	}
+
+	// d! converts the polynomial coefficient into the derivative
+	factorial := 1.0
+	for d := 2; d <= opts.Derivative; d++ {
+		factorial *= float64(d)
+	}
+	half := float64(opts.Window-1) / 2
+	terms := opts.Order + 1
+	out := make([]float64, outSamples)
+	for i := range out {
+		pos := outputPosition(i, len(in), outSamples)
+		start := int(math.Floor(pos - half + 0.5))
+		start = max(0, min(start, len(in)-opts.Window))
+
+		// Least squares in u = (j - pos) / scale, scaled for conditioning
+		scale := math.Max(half, 1)
+		normal := newMatrix(terms, terms)
+		rhs := make([]float64, terms)
+		powers := make([]float64, terms)
+		for j := start; j < start+opts.Window; j++ {
+			u := (float64(j) - pos) / scale
+			powers[0] = 1
+			for p := 1; p < terms; p++ {
+				powers[p] = powers[p-1] * u
+			}
+			for r := 0; r < terms; r++ {
+				for c := 0; c < terms; c++ {
+					normal[r][c] += powers[r] * powers[c]
+				}
+				rhs[r] += powers[r] * in[j]
+			}
+		}
+		coeffs, err := solveLinear(normal, rhs)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = coeffs[opts.Derivative] * factorial / math.Pow(scale, float64(opts.Derivative))
+	}
+	return out, nil
+}
@@ -0,0 +1,78 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSavitzkyGolayReproducesPolynomials(t *testing.T) {
+	// A cubic lies in the space of every fit of order 3, so the value and the
+	// derivatives are exact everywhere, including the shifted windows at the ends
+	f := []func(x float64) float64{
+		func(x float64) float64 { return 0.01*x*x*x - 0.2*x*x + x - 3 },
+		func(x float64) float64 { return 0.03*x*x - 0.4*x + 1 },
+		func(x float64) float64 { return 0.06*x - 0.4 },
+	}
+	in := make([]float64, 20)
+	for i := range in {
+		in[i] = f[0](float64(i))
+	}
+	for d := range f {
+		got, err := SavitzkyGolay(in, 39, SavitzkyGolayOptions{Window: 7, Order: 3, Derivative: d})
+		if err != nil {
+			t.Fatalf("SavitzkyGolay() returned unexpected error: %v", err)
+		}
+		for i, v := range got {
+			if want := f[d](float64(i) / 2); math.Abs(v-want) > 1e-9 {
+				t.Errorf("SavitzkyGolay(derivative %d)[%d] = %v, want %v", d, i, v, want)
+			}
+		}
+	}
+}
+
+func TestSavitzkyGolayClassicCoefficients(t *testing.T) {
+	// The 5-point quadratic smoother has weights (-3, 12, 17, 12, -3) / 35
+	in := []float64{0, 0, 0, 0, 0, 0, 35, 0, 0, 0, 0, 0, 0}
+	got, _ := SavitzkyGolay(in, len(in), SavitzkyGolayOptions{Window: 5, Order: 2})
+	want := []float64{0, 0, 0, 0, -3, 12, 17, 12, -3, 0, 0, 0, 0}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Errorf("SavitzkyGolay()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSavitzkyGolayReducesNoise(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	in := make([]float64, 200)
+	for i := range in {
+		in[i] = math.Sin(float64(i)/20) + 0.1*rng.NormFloat64()
+	}
+	out, _ := SavitzkyGolay(in, len(in), SavitzkyGolayOptions{Window: 21, Order: 3})
+	var before, after float64
+	for i := range in {
+		clean := math.Sin(float64(i) / 20)
+		before += (in[i] - clean) * (in[i] - clean)
+		after += (out[i] - clean) * (out[i] - clean)
+	}
+	if after > before/3 {
+		t.Errorf("SavitzkyGolay() squared error = %v, want well below the noise %v", after, before)
+	}
+}
+
+func TestSavitzkyGolayInvalidOptions(t *testing.T) {
+	in := make([]float64, 10)
+	for _, opts := range []SavitzkyGolayOptions{{Window: 3, Order: 3}, {Window: 5, Order: -1}, {Window: 5, Order: 2, Derivative: 3}} {
+		if _, err := SavitzkyGolay(in, 10, opts); err == nil {
+			t.Errorf("SavitzkyGolay(%+v) should return an error", opts)
+		}
+	}
+	if _, err := SavitzkyGolay(in[:4], 10, SavitzkyGolayOptions{Window: 5, Order: 2}); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("SavitzkyGolay() error = %v, want ErrTooFewPoints", err)
+	}
+	if _, err := SavitzkyGolay(in, 0, SavitzkyGolayOptions{Window: 5, Order: 2}); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("SavitzkyGolay() error = %v, want ErrInvalidOutSamples", err)
+	}
+}
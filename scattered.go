@@ -0,0 +1,135 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// IDWOptions configures inverse-distance weighting. A zero Power selects 2.
+type IDWOptions struct {
+	// Power is the exponent applied to the distances; larger values make nearby
+	// samples dominate
+	Power float64
+	// Neighbors limits each estimate to that many nearest samples; zero uses all
+	Neighbors int
+}
+
+// IDW estimates the scattered samples z at (x, y) on the regular grid spanned by
+// the axes gridX and gridY using Shepard's inverse-distance weighting. The result
+// is indexed [row][column], with rows following gridY and columns gridX. Grid
+// points coinciding with a sample take its value.
+func IDW(x, y, z, gridX, gridY []float64, opts IDWOptions) ([][]float64, error) {
+	if err := checkScattered(x, y, z); err != nil {
+		return nil, err
+	}
+	power := opts.Power
+	if power == 0 {
+		power = 2
+	}
+	if power < 0 || opts.Neighbors < 0 {
+		return nil, fmt.Errorf("power and neighbor count must not be negative, got %v and %d", power, opts.Neighbors)
+	}
+
+	n := len(z)
+	neighbors := opts.Neighbors
+	if neighbors == 0 || neighbors > n {
+		neighbors = n
+	}
+	out := newMatrix(len(gridY), len(gridX))
+	dist := make([]float64, n)
+	order := make([]int, n)
+	for r, gy := range gridY {
+		for c, gx := range gridX {
+			exact := -1
+			for i := range z {
+				dist[i] = math.Hypot(x[i]-gx, y[i]-gy)
+				order[i] = i
+				if dist[i] == 0 {
+					exact = i
+				}
+			}
+			if exact >= 0 {
+				out[r][c] = z[exact]
+				continue
+			}
+			if neighbors < n {
+				sort.Slice(order, func(a, b int) bool { return dist[order[a]] < dist[order[b]] })
+			}
+			sum, weights := 0.0, 0.0
+			for _, i := range order[:neighbors] {
+				w := math.Pow(dist[i], -power)
+				sum += w * z[i]
+				weights += w
+			}
+			out[r][c] = sum / weights
+		}
+	}
+	return out, nil
+}
+
+// NaturalNeighbor estimates the scattered samples z at (x, y) on the regular grid
+// spanned by the ascending axes gridX and gridY using discrete Sibson (natural
+// neighbor) interpolation: each grid point takes the value of its nearest sample
+// and spreads it to every grid point inside the circle reaching that sample, and
+// each estimate is the mean of the values received. The result stays within the
+// sample range, reproduces samples lying on grid points and is indexed like IDW.
+func NaturalNeighbor(x, y, z, gridX, gridY []float64) ([][]float64, error) {
+	if err := checkScattered(x, y, z); err != nil {
+		return nil, err
+	}
+	if !sort.Float64sAreSorted(gridX) || !sort.Float64sAreSorted(gridY) {
+		return nil, errors.New("grid axes must be ascending")
+	}
+
+	sums := newMatrix(len(gridY), len(gridX))
+	counts := newMatrix(len(gridY), len(gridX))
+	exact := make(map[[2]int]float64)
+	for r, gy := range gridY {
+		for c, gx := range gridX {
+			nearest, d := 0, math.Inf(1)
+			for i := range z {
+				if di := math.Hypot(x[i]-gx, y[i]-gy); di < d {
+					nearest, d = i, di
+				}
+			}
+			if d == 0 {
+				// Equidistant neighbors could otherwise pull a sample's own value
+				exact[[2]int{r, c}] = z[nearest]
+			}
+			// Scatter to all grid points within d of this one
+			r0 := sort.SearchFloat64s(gridY, gy-d)
+			c0 := sort.SearchFloat64s(gridX, gx-d)
+			for rr := r0; rr < len(gridY) && gridY[rr] <= gy+d; rr++ {
+				for cc := c0; cc < len(gridX) && gridX[cc] <= gx+d; cc++ {
+					if math.Hypot(gridX[cc]-gx, gridY[rr]-gy) <= d {
+						sums[rr][cc] += z[nearest]
+						counts[rr][cc]++
+					}
+				}
+			}
+		}
+	}
+
+	for r := range sums {
+		for c := range sums[r] {
+			sums[r][c] /= counts[r][c]
+		}
+	}
+	for rc, v := range exact {
+		sums[rc[0]][rc[1]] = v
+	}
+	return sums, nil
+}
+
+// checkScattered validates scattered 2D samples
+func checkScattered(x, y, z []float64) error {
+	if len(x) != len(y) || len(x) != len(z) {
+		return fmt.Errorf("coordinate and sample lengths differ: %d, %d and %d", len(x), len(y), len(z))
+	}
+	if len(z) == 0 {
+		return errors.New("at least one sample is required")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func linspace(lo, hi float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = lo + (hi-lo)*float64(i)/float64(n-1)
+	}
+	return out
+}
+
+func TestIDW(t *testing.T) {
+	x := []float64{0, 1, 0, 1}
+	y := []float64{0, 0, 1, 1}
+	z := []float64{1, 2, 3, 4}
+
+	out, err := IDW(x, y, z, []float64{0, 0.5, 1}, []float64{0, 0.5, 1}, IDWOptions{})
+	if err != nil {
+		t.Fatalf("IDW() returned unexpected error: %v", err)
+	}
+	tests := []struct {
+		r, c int
+		want float64
+	}{
+		{0, 0, 1}, {0, 2, 2}, {2, 0, 3}, {2, 2, 4},
+		// Equidistant from all samples
+		{1, 1, 2.5},
+	}
+	for _, tt := range tests {
+		if math.Abs(out[tt.r][tt.c]-tt.want) > 1e-12 {
+			t.Errorf("IDW()[%d][%d] = %v, want %v", tt.r, tt.c, out[tt.r][tt.c], tt.want)
+		}
+	}
+
+	// With one neighbor IDW reduces to nearest-sample lookup
+	out, _ = IDW(x, y, z, []float64{0.2}, []float64{0.9}, IDWOptions{Neighbors: 1})
+	if out[0][0] != 3 {
+		t.Errorf("IDW() with one neighbor = %v, want 3", out[0][0])
+	}
+}
+
+func TestNaturalNeighbor(t *testing.T) {
+	x := []float64{0, 4, 0, 4, 2}
+	y := []float64{0, 0, 4, 4, 2}
+	z := []float64{0, 4, 4, 8, 4}
+	grid := linspace(0, 4, 41)
+
+	out, err := NaturalNeighbor(x, y, z, grid, grid)
+	if err != nil {
+		t.Fatalf("NaturalNeighbor() returned unexpected error: %v", err)
+	}
+	if len(out) != 41 || len(out[0]) != 41 {
+		t.Fatalf("NaturalNeighbor() shape = %dx%d, want 41x41", len(out), len(out[0]))
+	}
+	// Samples are reproduced and estimates stay within the sample range
+	for i := range z {
+		r, c := int(y[i]*10), int(x[i]*10)
+		if math.Abs(out[r][c]-z[i]) > 1e-12 {
+			t.Errorf("NaturalNeighbor() at sample %d = %v, want %v", i, out[r][c], z[i])
+		}
+	}
+	for r := range out {
+		for c, v := range out[r] {
+			if v < 0 || v > 8 {
+				t.Fatalf("NaturalNeighbor()[%d][%d] = %v, outside sample range", r, c, v)
+			}
+		}
+	}
+	// The samples lie on the plane z = x + y, which Sibson interpolation follows
+	// closely between them
+	if v := out[10][30]; math.Abs(v-4) > 0.5 {
+		t.Errorf("NaturalNeighbor() at (3, 1) = %v, want about 4", v)
+	}
+}
+
+func TestScatteredInvalidInput(t *testing.T) {
+	grid := []float64{0, 1}
+	if _, err := IDW([]float64{0}, []float64{0, 1}, []float64{0}, grid, grid, IDWOptions{}); err == nil {
+		t.Error("IDW() with mismatched lengths should return an error")
+	}
+	if _, err := IDW(nil, nil, nil, grid, grid, IDWOptions{}); err == nil {
+		t.Error("IDW() with no samples should return an error")
+	}
+	if _, err := IDW([]float64{0}, []float64{0}, []float64{0}, grid, grid, IDWOptions{Power: -1}); err == nil {
+		t.Error("IDW() with negative power should return an error")
+	}
+	if _, err := NaturalNeighbor([]float64{0}, []float64{0}, []float64{0}, []float64{1, 0}, grid); err == nil {
+		t.Error("NaturalNeighbor() with descending axis should return an error")
+	}
+}
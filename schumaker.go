@@ -0,0 +1,107 @@
+package interpolators
+
+import "math"
+
+// schumaker is Schumaker's shape-preserving quadratic spline through y at the
+// coordinates x. Every interval holds two quadratic pieces joined with matching
+// slope at an extra knot, placed so the pieces rise or fall with the data and
+// bend the way it bends: the curve is C¹, monotone wherever the data are, and
+// convex or concave wherever the data are. Runs of exactly collinear samples
+// are the exception: next to them the curve can bend slightly the wrong way.
+type schumaker struct {
+	x, y []float64
+	s    []float64 // slopes at the input knots
+	knot []float64 // extra knot inside each interval
+	mid  []float64 // slope at the extra knot
+}
+
+// newSchumaker fits the spline to at least two samples
+func newSchumaker(x, y []float64) schumaker {
+	n := len(x)
+	sp := schumaker{x: x, y: y, s: schumakerSlopes(x, y), knot: make([]float64, n-1), mid: make([]float64, n-1)}
+	for i := range sp.knot {
+		h := x[i+1] - x[i]
+		delta := (y[i+1] - y[i]) / h
+		a, b := sp.s[i]-delta, sp.s[i+1]-delta
+		// When the slopes are on opposite sides of the secant the extra knot must
+		// sit where the two pieces can meet; it is put halfway into that range
+		var k float64
+		switch {
+		case a*b >= 0:
+			k = x[i] + h/2
+		case math.Abs(b) < math.Abs(a):
+			k = x[i] + h*b/(b-a)
+		default:
+			k = x[i+1] + h*a/(b-a)
+		}
+		alpha, beta := k-x[i], x[i+1]-k
+		sp.knot[i] = k
+		sp.mid[i] = (2*(y[i+1]-y[i]) - alpha*sp.s[i] - beta*sp.s[i+1]) / h
+	}
+	return sp
+}
+
+// schumakerSlopes returns the slopes at the knots: zero at local extrema,
+// otherwise the secants of the neighbouring intervals averaged with weights
+// proportional to their lengths in the plane, as in Judd's presentation of the
+// method. They are limited to twice the smaller secant, which keeps the
+// quadratic pieces monotone, and the end slopes make the end pieces single
+// quadratics.
+func schumakerSlopes(x, y []float64) []float64 {
+	n := len(x)
+	s := make([]float64, n)
+	delta := make([]float64, n-1)
+	length := make([]float64, n-1)
+	for i := range delta {
+		h, dy := x[i+1]-x[i], y[i+1]-y[i]
+		delta[i] = dy / h
+		length[i] = math.Hypot(h, dy)
+	}
+	if n == 2 {
+		s[0], s[1] = delta[0], delta[0]
+		return s
+	}
+	for i := 1; i < n-1; i++ {
+		if delta[i-1]*delta[i] <= 0 {
+			continue
+		}
+		m := (length[i-1]*delta[i-1] + length[i]*delta[i]) / (length[i-1] + length[i])
+		limit := 2 * math.Min(math.Abs(delta[i-1]), math.Abs(delta[i]))
+		s[i] = math.Copysign(math.Min(math.Abs(m), limit), m)
+	}
+	s[0] = 2*delta[0] - s[1]
+	s[n-1] = 2*delta[n-2] - s[n-2]
+	return s
+}
+
+// eval returns the spline and its first two derivatives at q, extending the end
+// pieces beyond the knots
+func (sp schumaker) eval(q float64) (v, d1, d2 float64) {
+	j := searchSegment(sp.x, q)
+	k := sp.knot[j]
+	alpha, beta := k-sp.x[j], sp.x[j+1]-k
+	if q < k {
+		t := q - sp.x[j]
+		c := (sp.mid[j] - sp.s[j]) / alpha
+		return sp.y[j] + sp.s[j]*t + c*t*t/2, sp.s[j] + c*t, c
+	}
+	t := q - k
+	c := (sp.s[j+1] - sp.mid[j]) / beta
+	return sp.y[j] + alpha*(sp.s[j]+sp.mid[j])/2 + sp.mid[j]*t + c*t*t/2, sp.mid[j] + c*t, c
+}
+
+// at evaluates the spline at q
+func (sp schumaker) at(q float64) float64 {
+	v, _, _ := sp.eval(q)
+	return v
+}
+
+// derivative evaluates the order-th derivative (1 or 2) of the spline at q. The
+// second derivative is piecewise constant.
+func (sp schumaker) derivative(q float64, order int) float64 {
+	_, d1, d2 := sp.eval(q)
+	if order == 1 {
+		return d1
+	}
+	return d2
+}
@@ -0,0 +1,101 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSchumakerShape(t *testing.T) {
+	// Increasing convex data with a sharp bend stay increasing and convex; the
+	// cubic spline is neither
+	x := []float64{0, 1, 2, 3, 4, 5, 6, 7, 10}
+	y := []float64{0, 0.01, 0.03, 0.06, 0.5, 2, 4, 6.1, 12.5}
+	xq := make([]float64, 401)
+	for i := range xq {
+		xq[i] = 10 * float64(i) / 400
+	}
+	out, err := InterpolateXY(x, y, xq, Schumaker)
+	if err != nil {
+		t.Fatalf("InterpolateXY(Schumaker) returned unexpected error: %v", err)
+	}
+	for i := 1; i < len(out); i++ {
+		if out[i] < out[i-1] {
+			t.Errorf("Schumaker at %v = %v after %v, want increasing", xq[i], out[i], out[i-1])
+		}
+	}
+	for i := 1; i < len(out)-1; i++ {
+		if out[i-1]-2*out[i]+out[i+1] < -1e-12 {
+			t.Errorf("Schumaker second difference at %v = %v, want convex", xq[i], out[i-1]-2*out[i]+out[i+1])
+		}
+	}
+	spline, _ := InterpolateXY(x, y, xq, CubicSpline)
+	convex := true
+	for i := 1; i < len(spline)-1; i++ {
+		convex = convex && spline[i-1]-2*spline[i]+spline[i+1] >= -1e-12
+	}
+	if convex {
+		t.Error("CubicSpline is convex on the test data, the test checks nothing")
+	}
+	f := newSchumaker(x, y)
+	for j, v := range x {
+		if math.Abs(f.at(v)-y[j]) > 1e-12 {
+			t.Errorf("Schumaker at knot %v = %v, want %v", v, f.at(v), y[j])
+		}
+		if d := f.derivative(v, 2); d < 0 {
+			t.Errorf("Schumaker second derivative at %v = %v, want non-negative", v, d)
+		}
+	}
+}
+
+func TestSchumakerMonotone(t *testing.T) {
+	// Steps and plateaus, where the cubic spline overshoots
+	in := []float64{0, 0, 0.1, 1, 1, 1.05, 3, 3, 2, -1}
+	out, err := Interpolate(in, 181, Schumaker)
+	if err != nil {
+		t.Fatalf("Interpolate(Schumaker) returned unexpected error: %v", err)
+	}
+	for i := 1; i < len(out); i++ {
+		rising := i <= 140
+		if rising && out[i] < out[i-1]-1e-12 || !rising && out[i] > out[i-1]+1e-12 {
+			t.Errorf("Schumaker [%d] = %v after %v, want monotone between extrema", i, out[i], out[i-1])
+		}
+	}
+	for j, v := range in {
+		if got := out[j*20]; math.Abs(got-v) > 1e-12 {
+			t.Errorf("Schumaker at sample %d = %v, want %v", j, got, v)
+		}
+	}
+}
+
+func TestSchumakerQuadratic(t *testing.T) {
+	// Two samples give the line through them
+	out, err := InterpolateXY([]float64{1, 3}, []float64{2, 6}, []float64{0, 1, 2, 4}, Schumaker)
+	if err != nil {
+		t.Fatalf("InterpolateXY(Schumaker) returned unexpected error: %v", err)
+	}
+	for i, want := range []float64{0, 2, 4, 8} {
+		if math.Abs(out[i]-want) > 1e-12 {
+			t.Errorf("Schumaker [%d] = %v, want %v", i, out[i], want)
+		}
+	}
+}
+
+func TestSchumakerDerivative(t *testing.T) {
+	x := []float64{0, 0.5, 2, 2.4, 4, 6}
+	y := []float64{1, -1, 3, 2, 0, 4}
+	s := newSchumaker(x, y)
+	// The slope is continuous across the input and the extra knots
+	for _, k := range append(append([]float64{}, x[1:len(x)-1]...), s.knot...) {
+		h := 1e-9
+		if l, r := s.derivative(k-h, 1), s.derivative(k+h, 1); math.Abs(l-r) > 1e-6 {
+			t.Errorf("slope at %v: %v and %v, want continuous", k, l, r)
+		}
+	}
+	for _, q := range []float64{0.2, 1.3, 3.1, 5.5} {
+		h := 1e-6
+		want := (s.at(q+h) - s.at(q-h)) / (2 * h)
+		if got := s.derivative(q, 1); math.Abs(got-want) > 1e-5 {
+			t.Errorf("derivative at %v = %v, want %v", q, got, want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Segments splits strictly increasing times into runs without a gap larger than
+// maxGap between consecutive times. Each run is returned as a half-open index range
+// [start, end) into times.
+func Segments(times []float64, maxGap float64) [][2]int {
+	segments := [][2]int{}
+	if len(times) == 0 {
+		return segments
+	}
+	start := 0
+	for i := 1; i < len(times); i++ {
+		if times[i]-times[i-1] > maxGap {
+			segments = append(segments, [2]int{start, i})
+			start = i
+		}
+	}
+	return append(segments, [2]int{start, len(times)})
+}
+
+// InterpolateSegmented interpolates the samples y at the strictly increasing
+// coordinates x at the coordinates xq like InterpolateXY, but only within the
+// segments found by Segments. Each segment is interpolated on its own samples, so
+// no kernel reaches across a gap, and queries in a gap or outside the sampled range
+// take the fill value, typically NaN, instead of invented data.
+func InterpolateSegmented(x, y, xq []float64, maxGap, fill float64, interpolatorType InterpolatorType) ([]float64, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+	if !(maxGap > 0) {
+		return nil, fmt.Errorf("maximum gap must be positive, got %v", maxGap)
+	}
+
+	segments := Segments(x, maxGap)
+	evaluators := make([]func(float64) float64, len(segments))
+	out := make([]float64, len(xq))
+	for i, q := range xq {
+		out[i] = fill
+		// Last segment starting at or before q
+		s := sort.Search(len(segments), func(k int) bool { return x[segments[k][0]] > q }) - 1
+		if s < 0 || math.IsNaN(q) {
+			continue
+		}
+		start, end := segments[s][0], segments[s][1]
+		if q > x[end-1] {
+			continue
+		}
+		if evaluators[s] == nil {
+			evaluators[s] = newXYEvaluator(x[start:end], y[start:end], interpolatorType)
+		}
+		out[i] = evaluators[s](q)
+	}
+	return out, nil
+}
@@ -0,0 +1,71 @@
+package interpolators
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name   string
+		times  []float64
+		maxGap float64
+		want   [][2]int
+	}{
+		{"empty", nil, 1, [][2]int{}},
+		{"single", []float64{3}, 1, [][2]int{{0, 1}}},
+		{"no gaps", []float64{0, 1, 2, 3}, 1, [][2]int{{0, 4}}},
+		{"two gaps", []float64{0, 1, 5, 6, 7, 20}, 2, [][2]int{{0, 2}, {2, 5}, {5, 6}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Segments(tt.times, tt.maxGap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateSegmented(t *testing.T) {
+	x := []float64{0, 1, 2, 10, 11, 12, 20}
+	y := []float64{0, 1, 2, 10, 11, 12, 20}
+	xq := []float64{-1, 0.5, 2, 5, 10.5, 12.5, 20, 21}
+	got, err := InterpolateSegmented(x, y, xq, 3, math.NaN(), Linear)
+	if err != nil {
+		t.Fatalf("InterpolateSegmented() returned unexpected error: %v", err)
+	}
+	want := []float64{math.NaN(), 0.5, 2, math.NaN(), 10.5, math.NaN(), 20, math.NaN()}
+	for i := range want {
+		if math.IsNaN(want[i]) != math.IsNaN(got[i]) || (!math.IsNaN(want[i]) && math.Abs(got[i]-want[i]) > 1e-12) {
+			t.Errorf("InterpolateSegmented()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	filled, _ := InterpolateSegmented(x, y, []float64{5}, 3, -1, Linear)
+	if filled[0] != -1 {
+		t.Errorf("InterpolateSegmented() in a gap = %v, want fill -1", filled[0])
+	}
+}
+
+func TestInterpolateSegmentedDoesNotReachAcrossGaps(t *testing.T) {
+	// A step across the gap must not ring into the first segment
+	x := []float64{0, 1, 2, 3, 4, 10, 11, 12, 13, 14}
+	y := []float64{0, 0, 0, 0, 0, 100, 100, 100, 100, 100}
+	xq := []float64{2.5, 3.5, 11.5}
+	got, _ := InterpolateSegmented(x, y, xq, 2, math.NaN(), Hermite6_5)
+	for i, want := range []float64{0, 0, 100} {
+		if math.Abs(got[i]-want) > 1e-9 {
+			t.Errorf("InterpolateSegmented()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestInterpolateSegmentedErrors(t *testing.T) {
+	if _, err := InterpolateSegmented([]float64{0, 1}, []float64{0, 1}, nil, 0, 0, Linear); err == nil {
+		t.Error("InterpolateSegmented() with zero maximum gap should return an error")
+	}
+	if _, err := InterpolateSegmented([]float64{1, 0}, []float64{0, 1}, nil, 1, 0, Linear); err == nil {
+		t.Error("InterpolateSegmented() with decreasing coordinates should return an error")
+	}
+}
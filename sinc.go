@@ -0,0 +1,161 @@
+package interpolators
+
+import "math"
+
+// sincImpulse is the ideal band-limited interpolation kernel sin(πx)/(πx). It has
+// infinite support, so Sinc uses every input sample for every output sample:
+// O(N·M) work for N inputs and M outputs.
+func sincImpulse(x float64) float64 {
+	s, _, _ := sincDerivatives(x)
+	return s
+}
+
+// dirichletImpulse returns the periodic sinc of period n, the band-limited
+// interpolation kernel of a cycle of n samples: sin(πx)/(n·sin(πx/n)) for odd n,
+// and sin(πx)/(n·tan(πx/n)) for even n, which splits the Nyquist term evenly
+func dirichletImpulse(n int) func(x float64) float64 {
+	period := float64(n)
+	return func(x float64) float64 {
+		den := math.Sin(math.Pi * x / period)
+		if math.Abs(den) < 1e-12 {
+			return 1
+		}
+		if n%2 == 0 {
+			den /= math.Cos(math.Pi * x / period)
+		}
+		return math.Sin(math.Pi*x) / (period * den)
+	}
+}
+
+// sincSum evaluates the full-support convolution Σ in[j]·impulse(pos-j)
+func sincSum(in []float64, pos float64, impulse func(float64) float64) float64 {
+	sum := 0.0
+	for j, v := range in {
+		sum += v * impulse(pos-float64(j))
+	}
+	return sum
+}
+
+// sincWindow returns a window function on ±a and its first two derivatives at x,
+// for |x| < a
+type sincWindow func(x float64) (w, d1, d2 float64)
+
+// windowedSincKernel returns the kernel sinc(x)·window(x) with support ±a. The
+// derivatives follow from the product rule, so derivative evaluation stays exact.
+func windowedSincKernel(a int, window sincWindow) kernel {
+	r := float64(a)
+	impulse := func(x float64) float64 {
+		if math.Abs(x) >= r {
+			return 0
+		}
+		s, _, _ := sincDerivatives(x)
+		w, _, _ := window(x)
+		return s * w
+	}
+	derivative := func(x float64, order int) float64 {
+		if math.Abs(x) >= r {
+			return 0
+		}
+		s0, s1, s2 := sincDerivatives(x)
+		w0, w1, w2 := window(x)
+		if order == 1 {
+			return s1*w0 + s0*w1
+		}
+		return s2*w0 + 2*s1*w1 + s0*w2
+	}
+	return kernel{impulse: impulse, radius: a, boundary: BoundaryClamp, derivative: derivative}
+}
+
+// lanczosWindow returns the Lanczos window sinc(x/a) on ±a
+func lanczosWindow(a int) sincWindow {
+	fa := float64(a)
+	return func(x float64) (w, d1, d2 float64) {
+		w, d1, d2 = sincDerivatives(x / fa)
+		return w, d1 / fa, d2 / (fa * fa)
+	}
+}
+
+// cosineWindow returns the cosine-sum window Σ c[k]·cos(kπx/a) on ±a
+func cosineWindow(a int, c ...float64) sincWindow {
+	fa := float64(a)
+	return func(x float64) (w, d1, d2 float64) {
+		for k, ck := range c {
+			f := float64(k) * math.Pi / fa
+			sin, cos := math.Sincos(f * x)
+			w += ck * cos
+			d1 -= ck * f * sin
+			d2 -= ck * f * f * cos
+		}
+		return w, d1, d2
+	}
+}
+
+// kaiserWindow returns the Kaiser window I0(β√(1-(x/a)²))/I0(β) on ±a. It is
+// evaluated as a power series in q = β²(1-(x/a)²)/4, which is smooth in x up to
+// the edges.
+func kaiserWindow(a int, beta float64) sincWindow {
+	fa := float64(a)
+	norm := besselI0(beta)
+	return func(x float64) (w, d1, d2 float64) {
+		q := beta * beta * (1 - x*x/(fa*fa)) / 4
+		// f = Σ q^k/(k!)², with f' and f'' summed alongside
+		f, df, ddf := 1.0, 0.0, 0.0
+		t0, t1, t2 := 1.0, 1.0, 0.5
+		for k := 1; k < 500; k++ {
+			fk := float64(k)
+			t0 *= q / (fk * fk)
+			f += t0
+			df += t1
+			if k >= 2 {
+				ddf += t2
+				t2 *= q / ((fk + 1) * (fk - 1))
+			}
+			t1 *= q / ((fk + 1) * fk)
+			if k >= 2 && t0 <= f*1e-17 && t1 <= df*1e-17 && t2 <= ddf*1e-17 {
+				break
+			}
+		}
+		dq := -beta * beta * x / (2 * fa * fa)
+		ddq := -beta * beta / (2 * fa * fa)
+		return f / norm, df * dq / norm, (ddf*dq*dq + df*ddq) / norm
+	}
+}
+
+// kaiserSincKernel returns the Kaiser-windowed sinc with support ±radius and
+// window shape beta, or 16 taps (radius 8) and beta 8.6 for zero values
+func kaiserSincKernel(radius int, beta float64) kernel {
+	if radius == 0 {
+		radius = 8
+	}
+	if beta == 0 {
+		beta = 8.6
+	}
+	return windowedSincKernel(radius, kaiserWindow(radius, beta))
+}
+
+// lanczosNKernel returns the Lanczos kernel with support ±a, or ±4 for a = 0
+func lanczosNKernel(a int) kernel {
+	if a == 0 {
+		a = 4
+	}
+	return windowedSincKernel(a, lanczosWindow(a))
+}
+
+// cosineSincKernel returns the Hann, Hamming or Blackman windowed sinc with
+// support ±a, or ±4 for a = 0. The coefficients are the usual ones of DSP
+// libraries, so the kernels match theirs exactly.
+func cosineSincKernel(interpolatorType InterpolatorType, a int) kernel {
+	if a == 0 {
+		a = 4
+	}
+	var window sincWindow
+	switch interpolatorType {
+	case HannSinc:
+		window = cosineWindow(a, 0.5, 0.5)
+	case HammingSinc:
+		window = cosineWindow(a, 0.54, 0.46)
+	default:
+		window = cosineWindow(a, 0.42, 0.5, 0.08)
+	}
+	return windowedSincKernel(a, window)
+}
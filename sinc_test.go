@@ -0,0 +1,234 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKaiserWindow(t *testing.T) {
+	const a, beta = 6, 7.5
+	window := kaiserWindow(a, beta)
+	for _, x := range []float64{0, -1.3, 2.6, 4.9, -5.99} {
+		r := x / a
+		want := besselI0(beta*math.Sqrt(1-r*r)) / besselI0(beta)
+		w, d1, d2 := window(x)
+		if math.Abs(w-want) > 1e-12 {
+			t.Errorf("kaiserWindow(%v) = %v, want %v", x, w, want)
+		}
+		h := 1e-4
+		wp, d1p, _ := window(x + h)
+		wm, d1m, _ := window(x - h)
+		if want := (wp - wm) / (2 * h); math.Abs(d1-want) > 1e-6 {
+			t.Errorf("kaiserWindow(%v) first derivative = %v, want %v", x, d1, want)
+		}
+		if want := (d1p - d1m) / (2 * h); math.Abs(d2-want) > 1e-6 {
+			t.Errorf("kaiserWindow(%v) second derivative = %v, want %v", x, d2, want)
+		}
+	}
+}
+
+func TestKaiserSinc(t *testing.T) {
+	k, _ := kernelFor(KaiserSinc)
+	if k.radius != 8 {
+		t.Errorf("KaiserSinc default radius = %d, want 8", k.radius)
+	}
+	for x := -8; x <= 8; x++ {
+		want := 0.0
+		if x == 0 {
+			want = 1
+		}
+		if got := k.impulse(float64(x)); math.Abs(got-want) > 1e-12 {
+			t.Errorf("KaiserSinc impulse at %d = %v, want %v", x, got, want)
+		}
+	}
+	d := mustKernelDerivative(t, KaiserSinc, k, 1)
+	for _, x := range []float64{-5.3, -0.4, 2.2, 7.6} {
+		h := 1e-5
+		if want := (k.impulse(x+h) - k.impulse(x-h)) / (2 * h); math.Abs(d(x)-want) > 1e-6 {
+			t.Errorf("KaiserSinc derivative at %v = %v, want %v", x, d(x), want)
+		}
+	}
+}
+
+func TestKaiserSincTaps(t *testing.T) {
+	// A band-limited sine is reconstructed more accurately with more taps
+	in := make([]float64, 200)
+	for i := range in {
+		in[i] = math.Sin(2 * math.Pi * 0.23 * float64(i))
+	}
+	positions := make([]float64, 0, 100)
+	for p := 50.25; p < 150; p += 1 {
+		positions = append(positions, p)
+	}
+	maxErr := func(radius int, beta float64) float64 {
+		k := kaiserSincKernel(radius, beta)
+		worst := 0.0
+		for _, p := range positions {
+			worst = max(worst, math.Abs(k.eval(in, p)-math.Sin(2*math.Pi*0.23*p)))
+		}
+		return worst
+	}
+	short, long := maxErr(4, 5), maxErr(24, 8.6)
+	if long >= short || long > 1e-3 {
+		t.Errorf("KaiserSinc max error 8 taps: %v, 48 taps: %v, want lower and below 1e-3 for more taps", short, long)
+	}
+	if _, err := InterpolateWithOptions(in, 50, KaiserSinc, Options{Kernel: KernelParams{Beta: -1}}); err == nil {
+		t.Error("InterpolateWithOptions() with a negative beta should return an error")
+	}
+}
+
+func TestLanczosN(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2, 6, -3}
+	// a = 2 and 3 reproduce the fixed Lanczos interpolators
+	for a, fixed := range map[int]InterpolatorType{2: Lanczos2, 3: Lanczos3} {
+		want, _ := Interpolate(in, 37, fixed)
+		got, err := InterpolateWithOptions(in, 37, LanczosN, Options{Kernel: KernelParams{Radius: a}})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(LanczosN, a=%d) returned unexpected error: %v", a, err)
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Errorf("LanczosN a=%d [%d] = %v, want %v", a, i, got[i], want[i])
+			}
+		}
+	}
+	k, _ := kernelFor(LanczosN)
+	if k.radius != 4 {
+		t.Errorf("LanczosN default radius = %d, want 4", k.radius)
+	}
+	// The kernel interpolates: it is 1 at zero and 0 at the other integers
+	for x := -4; x <= 4; x++ {
+		want := 0.0
+		if x == 0 {
+			want = 1
+		}
+		if got := k.impulse(float64(x)); math.Abs(got-want) > 1e-12 {
+			t.Errorf("LanczosN impulse at %d = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestLanczosNDerivative(t *testing.T) {
+	k := lanczosNKernel(5)
+	d := mustKernelDerivative(t, LanczosN, k, 1)
+	for _, x := range []float64{-3.7, -0.6, 1.3, 4.2} {
+		h := 1e-5
+		if want := (k.impulse(x+h) - k.impulse(x-h)) / (2 * h); math.Abs(d(x)-want) > 1e-6 {
+			t.Errorf("LanczosN derivative at %v = %v, want %v", x, d(x), want)
+		}
+	}
+}
+
+func TestCosineWindows(t *testing.T) {
+	const a = 5
+	tests := []struct {
+		name     string
+		interp   InterpolatorType
+		window   func(x float64) float64
+		edgeZero bool
+	}{
+		{"Hann", HannSinc, func(x float64) float64 { return 0.5 + 0.5*math.Cos(math.Pi*x/a) }, true},
+		{"Hamming", HammingSinc, func(x float64) float64 { return 0.54 + 0.46*math.Cos(math.Pi*x/a) }, false},
+		{"Blackman", BlackmanSinc, func(x float64) float64 {
+			return 0.42 + 0.5*math.Cos(math.Pi*x/a) + 0.08*math.Cos(2*math.Pi*x/a)
+		}, true},
+	}
+	for _, tt := range tests {
+		k := cosineSincKernel(tt.interp, a)
+		for _, x := range []float64{-4.6, -1.5, 0.3, 2.8, 4.99} {
+			s, _, _ := sincDerivatives(x)
+			if got, want := k.impulse(x), s*tt.window(x); math.Abs(got-want) > 1e-12 {
+				t.Errorf("%s sinc impulse at %v = %v, want %v", tt.name, x, got, want)
+			}
+			for _, order := range []int{1, 2} {
+				f := k.impulse
+				if order == 2 {
+					f = mustKernelDerivative(t, tt.interp, k, 1)
+				}
+				h := 1e-4
+				if got, want := mustKernelDerivative(t, tt.interp, k, order)(x), (f(x+h)-f(x-h))/(2*h); math.Abs(got-want) > 1e-6 {
+					t.Errorf("%s sinc derivative %d at %v = %v, want %v", tt.name, order, x, got, want)
+				}
+			}
+		}
+		for x := -a; x <= a; x++ {
+			want := 0.0
+			if x == 0 {
+				want = 1
+			}
+			if got := k.impulse(float64(x)); math.Abs(got-want) > 1e-12 {
+				t.Errorf("%s sinc impulse at %d = %v, want %v", tt.name, x, got, want)
+			}
+		}
+		// Windows that vanish at the edges make the derivative continuous there
+		if edge := math.Abs(mustKernelDerivative(t, tt.interp, k, 1)(a - 1e-9)); (edge < 1e-6) != tt.edgeZero {
+			t.Errorf("%s sinc derivative at the edge = %v, want zero: %v", tt.name, edge, tt.edgeZero)
+		}
+	}
+	k, _ := kernelFor(HannSinc)
+	if k.radius != 4 {
+		t.Errorf("HannSinc default radius = %d, want 4", k.radius)
+	}
+}
+
+func TestSinc(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2}
+	out, err := Interpolate(in, 19, Sinc)
+	if err != nil {
+		t.Fatalf("Interpolate(Sinc) returned unexpected error: %v", err)
+	}
+	positions := make([]float64, len(out))
+	for i := range positions {
+		positions[i] = outputPosition(i, len(in), len(out))
+	}
+	at, _ := InterpolateAt(in, positions, Sinc)
+	for i := range out {
+		if math.Abs(out[i]-at[i]) > 1e-12 {
+			t.Errorf("InterpolateAt(Sinc) at %v = %v, want %v", positions[i], at[i], out[i])
+		}
+		if i%2 == 0 && math.Abs(out[i]-in[i/2]) > 1e-12 {
+			t.Errorf("Interpolate(Sinc) [%d] = %v, want the sample %v", i, out[i], in[i/2])
+		}
+	}
+
+	// A band-limited sine is reconstructed better than by the windowed kernels
+	sine := make([]float64, 400)
+	for i := range sine {
+		sine[i] = math.Sin(2 * math.Pi * 0.37 * float64(i))
+	}
+	maxErr := func(interpolatorType InterpolatorType) float64 {
+		f := newEvaluator(sine, interpolatorType)
+		worst := 0.0
+		for p := 190.5; p < 210; p++ {
+			worst = max(worst, math.Abs(f(p)-math.Sin(2*math.Pi*0.37*p)))
+		}
+		return worst
+	}
+	if exact, lanczos := maxErr(Sinc), maxErr(Lanczos3); exact >= lanczos/10 {
+		t.Errorf("Sinc max error %v, want well below Lanczos3 %v", exact, lanczos)
+	}
+}
+
+func TestSincDerivative(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2}
+	positions := []float64{1.3, 4.5, 7.8}
+	for _, order := range []int{1, 2} {
+		var got []float64
+		if order == 1 {
+			got, _ = DerivativeAt(in, positions, Sinc)
+		} else {
+			got, _ = SecondDerivativeAt(in, positions, Sinc)
+		}
+		for i, pos := range positions {
+			h := 1e-4
+			f, _ := InterpolateAt(in, []float64{pos - h, pos, pos + h}, Sinc)
+			want := (f[2] - f[0]) / (2 * h)
+			if order == 2 {
+				want = (f[2] - 2*f[1] + f[0]) / (h * h)
+			}
+			if math.Abs(got[i]-want) > 1e-4 {
+				t.Errorf("derivative %d of Interpolate(Sinc) at %v = %v, want %v", order, pos, got[i], want)
+			}
+		}
+	}
+}
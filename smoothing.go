@@ -0,0 +1,125 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SmoothingSpline is a cubic smoothing spline, the function f minimizing
+//
+//	Σ (y[i] - f(x[i]))² + λ ∫ f''(x)² dx
+//
+// which trades closeness to noisy samples against roughness. λ = 0 gives the
+// natural cubic spline through the samples and λ → ∞ the least-squares line.
+type SmoothingSpline struct {
+	x []float64
+	// g holds the fitted values at x and gamma the second derivatives there, which
+	// are zero at both ends as for a natural spline
+	g, gamma []float64
+}
+
+// NewSmoothingSpline fits a smoothing spline with roughness penalty lambda to the
+// samples y at the strictly increasing coordinates x. Lambda scales with the cube
+// of the coordinate units. The fit solves a banded system in O(n).
+func NewSmoothingSpline(x, y []float64, lambda float64) (*SmoothingSpline, error) {
+	if err := checkXY(x, y); err != nil {
+		return nil, err
+	}
+	if len(x) == 0 {
+		return nil, errors.New("at least one sample is required")
+	}
+	if lambda < 0 {
+		return nil, fmt.Errorf("lambda must not be negative, got %v", lambda)
+	}
+	n := len(x)
+	s := &SmoothingSpline{x: append([]float64(nil), x...), g: append([]float64(nil), y...), gamma: make([]float64, n)}
+	if n < 3 {
+		// At most a line, which carries no roughness
+		return s, nil
+	}
+
+	// Reinsch's method: with the tridiagonal Q (n × n-2) and R (n-2 × n-2) of the
+	// natural spline, solve (R + λQᵀQ)γ = Qᵀy and set g = y - λQγ
+	h := make([]float64, n-1)
+	for i := range h {
+		h[i] = x[i+1] - x[i]
+	}
+	m := n - 2
+	// q[j] holds the three nonzero entries of column j, in rows j, j+1 and j+2
+	q := make([][3]float64, m)
+	for j := range q {
+		q[j] = [3]float64{1 / h[j], -1/h[j] - 1/h[j+1], 1 / h[j+1]}
+	}
+	band := newMatrix(m, 3)
+	rhs := make([]float64, m)
+	for j := 0; j < m; j++ {
+		band[j][0] = (h[j]+h[j+1])/3 + lambda*(q[j][0]*q[j][0]+q[j][1]*q[j][1]+q[j][2]*q[j][2])
+		if j+1 < m {
+			band[j][1] = h[j+1]/6 + lambda*(q[j][1]*q[j+1][0]+q[j][2]*q[j+1][1])
+		}
+		if j+2 < m {
+			band[j][2] = lambda * q[j][2] * q[j+2][0]
+		}
+		rhs[j] = q[j][0]*y[j] + q[j][1]*y[j+1] + q[j][2]*y[j+2]
+	}
+	gamma, err := bandSolve(band, rhs)
+	if err != nil {
+		return nil, err
+	}
+	for j, v := range gamma {
+		s.gamma[j+1] = v
+		for r := 0; r < 3; r++ {
+			s.g[j+r] -= lambda * q[j][r] * v
+		}
+	}
+	return s, nil
+}
+
+// At evaluates the spline at coordinate q, extending it linearly beyond the samples
+func (s *SmoothingSpline) At(q float64) float64 {
+	n := len(s.x)
+	if n == 1 {
+		return s.g[0]
+	}
+	x, g, gamma := s.x, s.g, s.gamma
+	// Natural splines have zero curvature at the ends, so continue with the end slopes
+	if q < x[0] {
+		h := x[1] - x[0]
+		slope := (g[1]-g[0])/h - h*(2*gamma[0]+gamma[1])/6
+		return g[0] + slope*(q-x[0])
+	}
+	if q > x[n-1] {
+		h := x[n-1] - x[n-2]
+		slope := (g[n-1]-g[n-2])/h + h*(gamma[n-2]+2*gamma[n-1])/6
+		return g[n-1] + slope*(q-x[n-1])
+	}
+	i := searchSegment(x, q)
+	h := x[i+1] - x[i]
+	a, b := q-x[i], x[i+1]-q
+	return (a*g[i+1]+b*g[i])/h - a*b/6*((1+a/h)*gamma[i+1]+(1+b/h)*gamma[i])
+}
+
+// InterpolateSmoothingSpline fits a smoothing spline to uniformly spaced samples
+// and evaluates it on the same output grid as Interpolate, with the coordinates in
+// input samples
+func InterpolateSmoothingSpline(in []float64, outSamples int, lambda float64) ([]float64, error) {
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	x := make([]float64, len(in))
+	for i := range x {
+		x[i] = float64(i)
+	}
+	s, err := NewSmoothingSpline(x, in, lambda)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, outSamples)
+	for i := range out {
+		out[i] = s.At(outputPosition(i, len(in), outSamples))
+	}
+	return out, nil
+}
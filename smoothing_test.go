@@ -0,0 +1,86 @@
+package interpolators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSmoothingSplineZeroLambdaInterpolates(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1}
+	got, err := InterpolateSmoothingSpline(in, 29, 0)
+	if err != nil {
+		t.Fatalf("InterpolateSmoothingSpline() returned unexpected error: %v", err)
+	}
+	want, _ := Interpolate(in, 29, CubicSpline)
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Errorf("InterpolateSmoothingSpline(λ=0)[%d] = %v, want natural spline %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSmoothingSplineLargeLambdaFitsLine(t *testing.T) {
+	x := []float64{0, 1, 2, 4, 5, 7}
+	y := []float64{1, 3, 2, 6, 5, 9}
+	s, err := NewSmoothingSpline(x, y, 1e9)
+	if err != nil {
+		t.Fatalf("NewSmoothingSpline() returned unexpected error: %v", err)
+	}
+	// Least-squares line through the samples
+	var sx, sy, sxx, sxy float64
+	for i := range x {
+		sx += x[i]
+		sy += y[i]
+		sxx += x[i] * x[i]
+		sxy += x[i] * y[i]
+	}
+	n := float64(len(x))
+	slope := (n*sxy - sx*sy) / (n*sxx - sx*sx)
+	intercept := (sy - slope*sx) / n
+	for _, q := range []float64{-1, 0, 3.3, 7, 9} {
+		if got, want := s.At(q), intercept+slope*q; math.Abs(got-want) > 1e-5 {
+			t.Errorf("SmoothingSpline.At(%v) = %v, want regression line %v", q, got, want)
+		}
+	}
+}
+
+func TestSmoothingSplineReducesNoise(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	n := 200
+	x := make([]float64, n)
+	clean := make([]float64, n)
+	noisy := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i) / 20
+		clean[i] = math.Sin(x[i])
+		noisy[i] = clean[i] + 0.2*rng.NormFloat64()
+	}
+	s, err := NewSmoothingSpline(x, noisy, 0.05)
+	if err != nil {
+		t.Fatalf("NewSmoothingSpline() returned unexpected error: %v", err)
+	}
+	var before, after float64
+	for i := range x {
+		before += (noisy[i] - clean[i]) * (noisy[i] - clean[i])
+		after += (s.At(x[i]) - clean[i]) * (s.At(x[i]) - clean[i])
+	}
+	if after > before/5 {
+		t.Errorf("SmoothingSpline squared error = %v, want well below the noise %v", after, before)
+	}
+}
+
+func TestSmoothingSplineInvalidInput(t *testing.T) {
+	if _, err := NewSmoothingSpline([]float64{0, 1}, []float64{0, 1}, -1); err == nil {
+		t.Error("NewSmoothingSpline() with negative lambda should return an error")
+	}
+	if _, err := NewSmoothingSpline([]float64{1, 0}, []float64{0, 1}, 1); err == nil {
+		t.Error("NewSmoothingSpline() with decreasing coordinates should return an error")
+	}
+	if _, err := NewSmoothingSpline(nil, nil, 1); err == nil {
+		t.Error("NewSmoothingSpline() without samples should return an error")
+	}
+	if _, err := InterpolateSmoothingSpline([]float64{1, 2}, 0, 1); err == nil {
+		t.Error("InterpolateSmoothingSpline() with no output samples should return an error")
+	}
+}
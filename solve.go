@@ -0,0 +1,54 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// solveSubdivisions is the number of pieces each unit interval is scanned in, so
+// that an interpolant crossing a level twice between two samples is still found
+const solveSubdivisions = 8
+
+// Solve returns the fractional positions where the interpolant of in crosses or
+// touches the level y, in increasing order: the inverse of InterpolateAt. Each unit
+// interval is scanned in solveSubdivisions pieces for sign changes of the
+// interpolant minus y, which are refined by bisection, so crossings closer together
+// than a piece may be missed. A constant stretch lying exactly at y is reported at
+// its scan points.
+func Solve(in []float64, y float64, interpolatorType InterpolatorType) ([]float64, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return nil, fmt.Errorf("%w: got no samples", ErrTooFewPoints)
+	}
+	if math.IsNaN(y) || math.IsInf(y, 0) {
+		return nil, errors.New("level must be finite")
+	}
+	if err := validate(in, 1, interpolatorType); err != nil {
+		return nil, err
+	}
+
+	roots := []float64{}
+	g := newEvaluator(in, interpolatorType)
+	f := func(pos float64) float64 { return g(pos) - y }
+	prevPos := 0.0
+	prev := f(prevPos)
+	if prev == 0 {
+		roots = append(roots, 0)
+	}
+	steps := (len(in) - 1) * solveSubdivisions
+	for s := 1; s <= steps; s++ {
+		pos := float64(s) / solveSubdivisions
+		cur := f(pos)
+		if prev*cur < 0 {
+			roots = append(roots, bisect(f, prevPos, pos, prev))
+		}
+		if cur == 0 {
+			roots = append(roots, pos)
+		}
+		prevPos, prev = pos, cur
+	}
+	return roots, nil
+}
@@ -0,0 +1,76 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []float64
+		y    float64
+		typ  InterpolatorType
+		want []float64
+	}{
+		{"linear rising", []float64{0, 10, 20}, 15, Linear, []float64{1.5}},
+		{"linear up and down", []float64{0, 4, 0}, 1, Linear, []float64{0.25, 1.75}},
+		{"touches at sample", []float64{0, 2, 4, 2}, 2, Linear, []float64{1, 3}},
+		{"never reached", []float64{0, 1, 2}, 5, CubicSpline, []float64{}},
+		{"single sample", []float64{3}, 3, Linear, []float64{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Solve(tt.in, tt.y, tt.typ)
+			if err != nil {
+				t.Fatalf("Solve() returned unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Solve() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("Solve()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSolveInvertsInterpolant(t *testing.T) {
+	in := []float64{0, 3, -1, 2, 5, 1, -2, 0}
+	for _, typ := range []InterpolatorType{CubicSpline, Hermite4, Lanczos3, Akima} {
+		roots, err := Solve(in, 0.5, typ)
+		if err != nil {
+			t.Fatalf("Solve(%v) returned unexpected error: %v", typ, err)
+		}
+		if len(roots) < 4 {
+			t.Errorf("Solve(%v) found %d crossings, want at least 4", typ, len(roots))
+		}
+		values, _ := InterpolateAt(in, roots, typ)
+		for i, v := range values {
+			if math.Abs(v-0.5) > 1e-8 {
+				t.Errorf("interpolant at Solve(%v)[%d] = %v, want 0.5", typ, i, v)
+			}
+		}
+	}
+}
+
+func TestSolveTwoCrossingsInOneInterval(t *testing.T) {
+	// The spline bulges above 1.05 between samples 1 and 2 and back below it
+	in := []float64{0, 1, 1, 0}
+	roots, _ := Solve(in, 1.05, CubicSpline)
+	if len(roots) != 2 || roots[0] < 1 || roots[1] > 2 {
+		t.Errorf("Solve() = %v, want two crossings between 1 and 2", roots)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	if _, err := Solve(nil, 1, Linear); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("Solve() of no samples error = %v, want ErrTooFewPoints", err)
+	}
+	if _, err := Solve([]float64{1, 2}, math.NaN(), Linear); err == nil {
+		t.Error("Solve() with a NaN level should return an error")
+	}
+}
@@ -0,0 +1,250 @@
+package interpolators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// boundaryNames holds the canonical name of each boundary mode
+var boundaryNames = map[Boundary]string{
+	BoundaryDefault: "default",
+	BoundaryZero:    "zero",
+	BoundaryClamp:   "clamp",
+	BoundaryMirror:  "mirror",
+	BoundaryWrap:    "wrap",
+}
+
+// String returns the canonical name of the boundary mode, e.g. "mirror"
+func (b Boundary) String() string {
+	if name, ok := boundaryNames[b]; ok {
+		return name
+	}
+	return fmt.Sprintf("Boundary(%d)", int(b))
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical name
+func (b Boundary) MarshalText() ([]byte, error) {
+	name, ok := boundaryNames[b]
+	if !ok {
+		return nil, fmt.Errorf("unknown boundary mode %d", int(b))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, ignoring case
+func (b *Boundary) UnmarshalText(text []byte) error {
+	key := normalizeName(string(text))
+	for mode, name := range boundaryNames {
+		if name == key {
+			*b = mode
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown boundary mode %q", text)
+}
+
+// Spec records everything that determines the output of InterpolateWithOptions,
+// so a resampled dataset can be reproduced later. It serializes to JSON with
+// interpolator and boundary names, and to a compact string such as
+// "lanczos3,out=1000,boundary=mirror,normalize,revision=2".
+type Spec struct {
+	Type       InterpolatorType `json:"type"`
+	OutSamples int              `json:"out_samples"`
+	Options    Options          `json:"options"`
+}
+
+// specFlags lists the boolean options by their names in the compact form
+var specFlags = []struct {
+	name  string
+	field func(*Options) *bool
+}{
+	{"normalize", func(o *Options) *bool { return &o.Normalize }},
+	{"gradientdomain", func(o *Options) *bool { return &o.GradientDomain }},
+	{"pixelcenters", func(o *Options) *bool { return &o.PixelCenters }},
+	{"antialias", func(o *Options) *bool { return &o.AntiAlias }},
+	{"logdomain", func(o *Options) *bool { return &o.LogDomain }},
+}
+
+// specParam formats and parses one kernel parameter of the compact form
+type specParam struct {
+	name string
+	// get returns the formatted value, or "" at the default
+	get func(KernelParams) string
+	set func(p *KernelParams, value string) error
+}
+
+// specParams lists the kernel parameters by their names in the compact form
+var specParams = []specParam{
+	floatParam("a", func(p *KernelParams) *float64 { return &p.A }),
+	floatParam("b", func(p *KernelParams) *float64 { return &p.B }),
+	floatParam("c", func(p *KernelParams) *float64 { return &p.C }),
+	floatParam("sigma", func(p *KernelParams) *float64 { return &p.Sigma }),
+	intParam("radius", func(p *KernelParams) *int { return &p.Radius }),
+	floatParam("beta", func(p *KernelParams) *float64 { return &p.Beta }),
+	intParam("degree", func(p *KernelParams) *int { return &p.Degree }),
+	floatParam("tension", func(p *KernelParams) *float64 { return &p.Tension }),
+}
+
+// floatParam describes a real-valued kernel parameter
+func floatParam(name string, field func(*KernelParams) *float64) specParam {
+	return specParam{
+		name: name,
+		get: func(p KernelParams) string {
+			if v := *field(&p); v != 0 {
+				return strconv.FormatFloat(v, 'g', -1, 64)
+			}
+			return ""
+		},
+		set: func(p *KernelParams, value string) (err error) {
+			*field(p), err = strconv.ParseFloat(value, 64)
+			return err
+		},
+	}
+}
+
+// intParam describes an integer kernel parameter
+func intParam(name string, field func(*KernelParams) *int) specParam {
+	return specParam{
+		name: name,
+		get: func(p KernelParams) string {
+			if v := *field(&p); v != 0 {
+				return strconv.Itoa(v)
+			}
+			return ""
+		},
+		set: func(p *KernelParams, value string) (err error) {
+			*field(p), err = strconv.Atoi(value)
+			return err
+		},
+	}
+}
+
+// String returns the compact form of the spec, omitting options at their defaults
+func (s Spec) String() string {
+	parts := []string{s.Type.String(), "out=" + strconv.Itoa(s.OutSamples)}
+	if s.Options.Boundary != BoundaryDefault {
+		parts = append(parts, "boundary="+s.Options.Boundary.String())
+	}
+	for _, flag := range specFlags {
+		if *flag.field(&s.Options) {
+			parts = append(parts, flag.name)
+		}
+	}
+	if s.Options.Revision != RevisionLatest {
+		parts = append(parts, "revision="+strconv.Itoa(int(s.Options.Revision)))
+	}
+	for _, param := range specParams {
+		if v := param.get(s.Options.Kernel); v != "" {
+			parts = append(parts, param.name+"="+v)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseSpec parses the compact form produced by Spec.String
+func ParseSpec(text string) (Spec, error) {
+	fields := strings.Split(text, ",")
+	t, err := ParseInterpolatorType(fields[0])
+	if err != nil {
+		return Spec{}, err
+	}
+	spec := Spec{Type: t}
+	for _, field := range fields[1:] {
+		key, value, hasValue := strings.Cut(strings.TrimSpace(field), "=")
+		key = normalizeName(key)
+		switch {
+		case key == "out" && hasValue:
+			if spec.OutSamples, err = strconv.Atoi(value); err != nil {
+				return Spec{}, fmt.Errorf("spec %q: output samples: %w", text, err)
+			}
+		case key == "boundary" && hasValue:
+			if err := spec.Options.Boundary.UnmarshalText([]byte(value)); err != nil {
+				return Spec{}, fmt.Errorf("spec %q: %w", text, err)
+			}
+		case key == "revision" && hasValue:
+			revision, err := strconv.Atoi(value)
+			if err != nil {
+				return Spec{}, fmt.Errorf("spec %q: revision: %w", text, err)
+			}
+			spec.Options.Revision = Revision(revision)
+		default:
+			if hasValue {
+				found, err := spec.setParam(key, value)
+				if err != nil {
+					return Spec{}, fmt.Errorf("spec %q: %s: %w", text, key, err)
+				}
+				if found {
+					continue
+				}
+			}
+			if !spec.setFlag(key, hasValue) {
+				return Spec{}, fmt.Errorf("spec %q: unknown option %q", text, field)
+			}
+		}
+	}
+	return spec, nil
+}
+
+// setParam parses value into the kernel parameter named key, reporting whether it
+// exists
+func (s *Spec) setParam(key, value string) (bool, error) {
+	for _, param := range specParams {
+		if param.name == key {
+			return true, param.set(&s.Options.Kernel, value)
+		}
+	}
+	return false, nil
+}
+
+// setFlag turns on the boolean option named key, reporting whether it exists
+func (s *Spec) setFlag(key string, hasValue bool) bool {
+	if hasValue {
+		return false
+	}
+	for _, flag := range specFlags {
+		if flag.name == key {
+			*flag.field(&s.Options) = true
+			return true
+		}
+	}
+	return false
+}
+
+// Interpolate resamples in as the spec describes
+func (s Spec) Interpolate(in []float64) ([]float64, error) {
+	return InterpolateWithOptions(in, s.OutSamples, s.Type, s.Options)
+}
+
+// Report describes how InterpolateWithReport produced its output
+type Report struct {
+	// Spec reproduces the output, with the revision pinned so later kernel fixes
+	// do not change it
+	Spec Spec `json:"spec"`
+	// InSamples is the length of the input
+	InSamples int `json:"in_samples"`
+	// Scale is the output spacing in input samples
+	Scale float64 `json:"scale"`
+}
+
+// InterpolateWithReport performs InterpolateWithOptions and also returns a report
+// whose Spec, stored alongside the output, reproduces it exactly
+func InterpolateWithReport(in []float64, outSamples int, interpolatorType InterpolatorType, opts Options) ([]float64, Report, error) {
+	out, err := InterpolateWithOptions(in, outSamples, interpolatorType, opts)
+	if err != nil {
+		return nil, Report{}, err
+	}
+	// resolve cannot fail once InterpolateWithOptions has accepted the options
+	opts.Revision, _ = opts.Revision.resolve()
+	report := Report{
+		Spec:      Spec{Type: interpolatorType, OutSamples: outSamples, Options: opts},
+		InSamples: len(in),
+	}
+	if outSamples > 1 {
+		report.Scale = outputPosition(1, len(in), outSamples)
+		if opts.PixelCenters && !opts.GradientDomain {
+			report.Scale = float64(len(in)) / float64(outSamples)
+		}
+	}
+	return out, report, nil
+}
@@ -0,0 +1,94 @@
+package interpolators
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSpecStringRoundTrip(t *testing.T) {
+	tests := []struct {
+		spec Spec
+		want string
+	}{
+		{Spec{Type: Linear, OutSamples: 10}, "linear,out=10"},
+		{Spec{Type: Lanczos3, OutSamples: 1000, Options: Options{Boundary: BoundaryMirror, Normalize: true, Revision: Revision2}}, "lanczos3,out=1000,boundary=mirror,normalize,revision=2"},
+		{Spec{Type: Hermite6_3, OutSamples: 4, Options: Options{PixelCenters: true, AntiAlias: true, GradientDomain: true}}, "hermite6_3,out=4,gradientdomain,pixelcenters,antialias"},
+		{Spec{Type: CubicSpline, OutSamples: 16, Options: Options{LogDomain: true}}, "cubicspline,out=16,logdomain"},
+		{Spec{Type: Keys, OutSamples: 8, Options: Options{Kernel: KernelParams{A: -0.75}}}, "keys,out=8,a=-0.75"},
+		{Spec{Type: Gaussian, OutSamples: 8, Options: Options{Kernel: KernelParams{Sigma: 1.5, Radius: 4}}}, "gaussian,out=8,sigma=1.5,radius=4"},
+		{Spec{Type: LanczosN, OutSamples: 8, Options: Options{Kernel: KernelParams{Radius: 6}}}, "lanczosn,out=8,radius=6"},
+		{Spec{Type: BlackmanSinc, OutSamples: 8, Options: Options{Kernel: KernelParams{Radius: 5}}}, "blackmansinc,out=8,radius=5"},
+		{Spec{Type: BSplineN, OutSamples: 8, Options: Options{Kernel: KernelParams{Degree: 7}}}, "bsplinen,out=8,degree=7"},
+		{Spec{Type: LagrangeN, OutSamples: 8, Options: Options{Kernel: KernelParams{Radius: 5}}}, "lagrangen,out=8,radius=5"},
+		{Spec{Type: FloaterHormann, OutSamples: 8, Options: Options{Kernel: KernelParams{Degree: 2}}}, "floaterhormann,out=8,degree=2"},
+		{Spec{Type: TensionSpline, OutSamples: 8, Options: Options{Kernel: KernelParams{Tension: 2.5}}}, "tensionspline,out=8,tension=2.5"},
+		{Spec{Type: KaiserSinc, OutSamples: 8, Options: Options{Kernel: KernelParams{Radius: 12, Beta: 9.5}}}, "kaisersinc,out=8,radius=12,beta=9.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.spec.String(); got != tt.want {
+				t.Errorf("Spec.String() = %q, want %q", got, tt.want)
+			}
+			parsed, err := ParseSpec(tt.want)
+			if err != nil {
+				t.Fatalf("ParseSpec() returned unexpected error: %v", err)
+			}
+			if parsed != tt.spec {
+				t.Errorf("ParseSpec() = %+v, want %+v", parsed, tt.spec)
+			}
+		})
+	}
+}
+
+func TestSpecJSONRoundTrip(t *testing.T) {
+	spec := Spec{Type: Lanczos3, OutSamples: 32, Options: Options{Boundary: BoundaryWrap, AntiAlias: true, Revision: Revision1}}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned unexpected error: %v", err)
+	}
+	want := `{"type":"lanczos3","out_samples":32,"options":{"boundary":"wrap","anti_alias":true,"revision":1}}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+	var parsed Spec
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("json.Unmarshal() returned unexpected error: %v", err)
+	}
+	if parsed != spec {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", parsed, spec)
+	}
+}
+
+func TestParseSpecErrors(t *testing.T) {
+	for _, text := range []string{"", "cubic", "linear,out=x", "linear,boundary=sideways", "linear,smooth", "linear,normalize=yes", "linear,revision=two", "keys,a=sharp", "gaussian,radius=2.5"} {
+		if _, err := ParseSpec(text); err == nil {
+			t.Errorf("ParseSpec(%q) should return an error", text)
+		}
+	}
+}
+
+func TestInterpolateWithReport(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1}
+	opts := Options{Boundary: BoundaryMirror, Normalize: true}
+	out, report, err := InterpolateWithReport(in, 15, Lanczos3, opts)
+	if err != nil {
+		t.Fatalf("InterpolateWithReport() returned unexpected error: %v", err)
+	}
+	if report.InSamples != 8 || report.Scale != 0.5 {
+		t.Errorf("InterpolateWithReport() report = %+v, want 8 input samples at scale 0.5", report)
+	}
+	if report.Spec.Options.Revision != latestRevision {
+		t.Errorf("InterpolateWithReport() revision = %d, want it pinned to %d", report.Spec.Options.Revision, latestRevision)
+	}
+	// The recorded spec reproduces the output through its compact form
+	spec, err := ParseSpec(report.Spec.String())
+	if err != nil {
+		t.Fatalf("ParseSpec() returned unexpected error: %v", err)
+	}
+	again, _ := spec.Interpolate(in)
+	for i := range out {
+		if again[i] != out[i] {
+			t.Fatalf("Spec.Interpolate()[%d] = %v, want %v", i, again[i], out[i])
+		}
+	}
+}
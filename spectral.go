@@ -0,0 +1,132 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// ResampleFFT treats in as one period of a band-limited signal and resamples it to
+// outSamples by zero-padding or truncating its spectrum. Output sample i sits at
+// position i*len(in)/outSamples, as in InterpolatePeriodic. Upsampling reproduces
+// every harmonic of in exactly, and downsampling drops the harmonics the shorter
+// length cannot hold, so the result is free of aliasing. Both lengths may be any
+// size; lengths that are not powers of two use Bluestein's algorithm.
+func ResampleFFT(in []float64, outSamples int) ([]float64, error) {
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	n := len(in)
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	x := realFFT(in)
+	y := make([]complex128, outSamples)
+	keep := min(n, outSamples)
+	// Harmonics below the Nyquist frequency of the shorter length keep both bins
+	for k := 0; k <= (keep-1)/2; k++ {
+		y[k] = x[k]
+		if k > 0 {
+			y[outSamples-k] = x[n-k]
+		}
+	}
+	if keep%2 == 0 {
+		// The Nyquist bin of the shorter length is shared between the positive
+		// and negative frequencies of the longer one
+		h := keep / 2
+		switch {
+		case n < outSamples:
+			y[h] = x[h] / 2
+			y[outSamples-h] = x[h] / 2
+		case n > outSamples:
+			y[h] = x[h] + x[n-h]
+		default:
+			y[h] = x[h]
+		}
+	}
+
+	out := make([]float64, outSamples)
+	scale := float64(outSamples) / float64(n)
+	for i, v := range fft(y, true) {
+		out[i] = real(v) * scale
+	}
+	return out, nil
+}
+
+// ResampleChirpZ evaluates the band-limited periodic interpolant of in at the
+// outSamples positions start + i*step, measured in input samples, for any start
+// and step. It is the spectral counterpart of InterpolateAt on a uniform grid:
+// step = len(in)/outSamples reproduces ResampleFFT, and step =
+// (len(in)-1)/(outSamples-1) gives the output grid of Interpolate. The spectrum
+// is evaluated along the grid with a chirp-z transform, which costs
+// O((n+m) log(n+m)) instead of the O(n·m) of a direct sum.
+func ResampleChirpZ(in []float64, start, step float64, outSamples int) ([]float64, error) {
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	if math.IsNaN(start) || math.IsInf(start, 0) || math.IsNaN(step) || math.IsInf(step, 0) {
+		return nil, fmt.Errorf("start and step must be finite, got %v and %v", start, step)
+	}
+	n := len(in)
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	// x(t) = Re Σ a[k]·exp(2πik·t/n)/n over the non-negative harmonics, with the
+	// positive and negative frequencies of a real signal folded together and the
+	// Nyquist bin, if any, taken once
+	x := realFFT(in)
+	a := make([]complex128, n/2+1)
+	for k := range a {
+		w := 2.0
+		if k == 0 || 2*k == n {
+			w = 1
+		}
+		a[k] = x[k] * complex(w/float64(n), 0) * cmplx.Rect(1, 2*math.Pi*float64(k)*math.Mod(start, float64(n))/float64(n))
+	}
+	y := chirpZ(a, outSamples, 2*math.Pi*step/float64(n))
+	out := make([]float64, outSamples)
+	for i, v := range y {
+		out[i] = real(v)
+	}
+	return out, nil
+}
+
+// chirpZ returns y[i] = Σ a[k]·exp(iθ·i·k) for i < m using Bluestein's
+// identity i·k = (i² + k² - (i-k)²)/2, which turns the sum into a convolution
+func chirpZ(a []complex128, m int, theta float64) []complex128 {
+	n := len(a)
+	// chirp(j) = exp(iθj²/2), with the angle reduced before it loses precision
+	chirp := func(j int) complex128 {
+		return cmplx.Rect(1, math.Mod(theta*float64(j)*float64(j)/2, 2*math.Pi))
+	}
+
+	size := 1 << bits.Len(uint(n+m-1))
+	u := make([]complex128, size)
+	v := make([]complex128, size)
+	for k := range a {
+		u[k] = a[k] * chirp(k)
+	}
+	// v holds exp(-iθd²/2) for the lags d = i-k in [-(n-1), m-1], negative lags wrapped
+	for d := 0; d < m; d++ {
+		v[d] = cmplx.Conj(chirp(d))
+	}
+	for d := 1; d < n; d++ {
+		v[size-d] = cmplx.Conj(chirp(d))
+	}
+	radix2(u, false)
+	radix2(v, false)
+	for i := range u {
+		u[i] *= v[i]
+	}
+	radix2(u, true)
+
+	y := make([]complex128, m)
+	scale := complex(1/float64(size), 0)
+	for i := range y {
+		y[i] = u[i] * scale * chirp(i)
+	}
+	return y
+}
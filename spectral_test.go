@@ -0,0 +1,138 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// harmonics returns n samples of one period of Σ cos(2πh·t + h) for h in hs
+func harmonics(n int, hs ...int) func(t float64) float64 {
+	return func(t float64) float64 {
+		sum := 0.0
+		for _, h := range hs {
+			sum += math.Cos(2*math.Pi*float64(h)*t/float64(n) + float64(h))
+		}
+		return sum
+	}
+}
+
+func TestResampleFFT(t *testing.T) {
+	tests := []struct {
+		name     string
+		n, m     int
+		hs, kept []int
+	}{
+		{"upsample power of two", 16, 64, []int{1, 3, 7}, []int{1, 3, 7}},
+		{"upsample odd lengths", 15, 37, []int{2, 5, 7}, []int{2, 5, 7}},
+		{"downsample drops high harmonics", 64, 20, []int{1, 4, 15}, []int{1, 4}},
+		{"downsample odd", 49, 17, []int{3, 8, 20}, []int{3, 8}},
+		{"same length", 12, 12, []int{1, 5}, []int{1, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := harmonics(tt.n, tt.hs...)
+			in := make([]float64, tt.n)
+			for i := range in {
+				in[i] = f(float64(i))
+			}
+			out, err := ResampleFFT(in, tt.m)
+			if err != nil {
+				t.Fatalf("ResampleFFT() returned unexpected error: %v", err)
+			}
+			want := harmonics(tt.n, tt.kept...)
+			for i, v := range out {
+				pos := float64(i) * float64(tt.n) / float64(tt.m)
+				if math.Abs(v-want(pos)) > 1e-9 {
+					t.Errorf("ResampleFFT()[%d] = %v, want %v", i, v, want(pos))
+				}
+			}
+		})
+	}
+}
+
+func TestResampleFFTNyquist(t *testing.T) {
+	// The Nyquist cosine of an even-length input is split across both
+	// frequencies when upsampling, so it stays a real cosine
+	in := []float64{1, -1, 1, -1, 1, -1}
+	out, _ := ResampleFFT(in, 12)
+	for i, v := range out {
+		if want := math.Cos(math.Pi * float64(i) / 2); math.Abs(v-want) > 1e-12 {
+			t.Errorf("ResampleFFT() of the Nyquist cosine [%d] = %v, want %v", i, v, want)
+		}
+	}
+	// Round trips through a longer length are exact
+	back, _ := ResampleFFT(out, 6)
+	for i := range in {
+		if math.Abs(back[i]-in[i]) > 1e-12 {
+			t.Errorf("ResampleFFT() round trip [%d] = %v, want %v", i, back[i], in[i])
+		}
+	}
+}
+
+func TestResampleFFTErrors(t *testing.T) {
+	if _, err := ResampleFFT([]float64{1, 2}, 0); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("ResampleFFT(outSamples=0) error = %v, want %v", err, ErrInvalidOutSamples)
+	}
+	if out, err := ResampleFFT(nil, 5); err != nil || len(out) != 0 {
+		t.Errorf("ResampleFFT(nil) = %v, %v, want empty output", out, err)
+	}
+	out, _ := ResampleFFT([]float64{3}, 4)
+	for i, v := range out {
+		if math.Abs(v-3) > 1e-12 {
+			t.Errorf("ResampleFFT() of a single sample [%d] = %v, want 3", i, v)
+		}
+	}
+}
+
+func TestResampleChirpZ(t *testing.T) {
+	f := harmonics(21, 1, 4, 9)
+	in := make([]float64, 21)
+	for i := range in {
+		in[i] = f(float64(i))
+	}
+	tests := []struct {
+		name        string
+		start, step float64
+		m           int
+	}{
+		{"Interpolate grid", 0, 20.0 / 36, 37},
+		{"irrational step", 0.3, math.Sqrt2 / 3, 50},
+		{"beyond the period", -25.7, 1.9, 40},
+		{"reversed", 19, -0.25, 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := ResampleChirpZ(in, tt.start, tt.step, tt.m)
+			if err != nil {
+				t.Fatalf("ResampleChirpZ() returned unexpected error: %v", err)
+			}
+			for i, v := range out {
+				pos := tt.start + float64(i)*tt.step
+				if math.Abs(v-f(pos)) > 1e-9 {
+					t.Errorf("ResampleChirpZ() at %v = %v, want %v", pos, v, f(pos))
+				}
+			}
+		})
+	}
+}
+
+func TestResampleChirpZMatchesFFT(t *testing.T) {
+	in := []float64{0, 3, -1, 4, 2, -2, 5, 1, 0, 2, 6, -3}
+	want, _ := ResampleFFT(in, 29)
+	got, err := ResampleChirpZ(in, 0, float64(len(in))/29, 29)
+	if err != nil {
+		t.Fatalf("ResampleChirpZ() returned unexpected error: %v", err)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Errorf("ResampleChirpZ()[%d] = %v, want ResampleFFT() %v", i, got[i], want[i])
+		}
+	}
+	if _, err := ResampleChirpZ(in, 0, math.NaN(), 5); err == nil {
+		t.Error("ResampleChirpZ() with a NaN step should return an error")
+	}
+	if _, err := ResampleChirpZ(in, 0, 1, 0); !errors.Is(err, ErrInvalidOutSamples) {
+		t.Errorf("ResampleChirpZ(outSamples=0) error = %v, want %v", err, ErrInvalidOutSamples)
+	}
+}
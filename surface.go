@@ -0,0 +1,197 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// bspline3Pole is the pole of the cubic B-spline prefilter
+var bspline3Pole = math.Sqrt(3) - 2
+
+// prefilterTolerance bounds the truncation error of the prefilter's causal
+// initialization
+const prefilterTolerance = 1e-12
+
+// bsplinePrefilter converts samples into cubic B-spline coefficients in place, so
+// that the spline through the coefficients passes through the samples. The signal
+// is mirrored about its first and last samples, matching BoundaryMirror.
+func bsplinePrefilter(c []float64) {
+	prefilter(c, bspline3Pole)
+}
+
+// prefilter inverts in place the convolution with a symmetric three-tap kernel
+// (a, 1-2a, a) whose pole z solves z + 1/z = 2 - 1/a, mirroring the signal about
+// its ends. This turns samples into the coefficients of a kernel taking those
+// values at the integers, such as the cubic B-spline.
+func prefilter(c []float64, z float64) {
+	n := len(c)
+	if n < 2 {
+		return
+	}
+	gain := (1 - z) * (1 - 1/z)
+	for i := range c {
+		c[i] *= gain
+	}
+
+	// Causal initialization, truncated once the pole's powers are negligible
+	horizon := int(math.Ceil(math.Log(prefilterTolerance) / math.Log(math.Abs(z))))
+	if horizon < n {
+		zn, sum := z, c[0]
+		for k := 1; k < horizon; k++ {
+			sum += zn * c[k]
+			zn *= z
+		}
+		c[0] = sum
+	} else {
+		// Exact initialization for the mirrored signal
+		zn := z
+		iz := 1 / z
+		z2n := math.Pow(z, float64(n-1))
+		sum := c[0] + z2n*c[n-1]
+		z2n *= z2n * iz
+		for k := 1; k < n-1; k++ {
+			sum += (zn + z2n) * c[k]
+			zn *= z
+			z2n *= iz
+		}
+		c[0] = sum / (1 - zn*zn)
+	}
+	for k := 1; k < n; k++ {
+		c[k] += z * c[k-1]
+	}
+
+	// Anti-causal pass
+	c[n-1] = (z / (z*z - 1)) * (z*c[n-2] + c[n-1])
+	for k := n - 2; k >= 0; k-- {
+		c[k] = z * (c[k+1] - c[k])
+	}
+}
+
+// bspline3Derivative is the derivative of bspline3Impulse
+func bspline3Derivative(x float64) float64 {
+	absX := math.Abs(x)
+	var d float64
+	switch {
+	case absX < 1:
+		d = -2*absX + 1.5*absX*absX
+	case absX < 2:
+		d = -0.5 * (2 - absX) * (2 - absX)
+	default:
+		return 0
+	}
+	if x < 0 {
+		return -d
+	}
+	return d
+}
+
+// Surface is a bicubic B-spline surface interpolating a regular 2D grid of samples.
+// The samples are prefiltered into spline coefficients once, so the surface passes
+// through every sample and is C² everywhere; edges are mirrored.
+type Surface struct {
+	rows, cols int
+	// coeffs[r][c] are the tensor-product B-spline coefficients
+	coeffs [][]float64
+}
+
+// NewSurface fits a surface to grid, indexed [row][column]. All rows must have the
+// same non-zero length.
+func NewSurface(grid [][]float64) (*Surface, error) {
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return nil, errors.New("grid must have at least one row and column")
+	}
+	rows, cols := len(grid), len(grid[0])
+	coeffs := newMatrix(rows, cols)
+	for r := range grid {
+		if len(grid[r]) != cols {
+			return nil, fmt.Errorf("row %d has %d columns, want %d", r, len(grid[r]), cols)
+		}
+		copy(coeffs[r], grid[r])
+		bsplinePrefilter(coeffs[r])
+	}
+	column := make([]float64, rows)
+	for c := 0; c < cols; c++ {
+		for r := range coeffs {
+			column[r] = coeffs[r][c]
+		}
+		bsplinePrefilter(column)
+		for r := range coeffs {
+			coeffs[r][c] = column[r]
+		}
+	}
+	return &Surface{rows: rows, cols: cols, coeffs: coeffs}, nil
+}
+
+// Size returns the number of rows and columns of the fitted grid
+func (s *Surface) Size() (rows, cols int) {
+	return s.rows, s.cols
+}
+
+// At evaluates the surface at column position u and row position v, where (c, r)
+// is the sample grid[r][c]
+func (s *Surface) At(u, v float64) float64 {
+	return s.eval(u, v, bspline3Impulse, bspline3Impulse)
+}
+
+// Gradient returns the partial derivatives of the surface along u and v
+func (s *Surface) Gradient(u, v float64) (du, dv float64) {
+	du = s.eval(u, v, bspline3Derivative, bspline3Impulse)
+	dv = s.eval(u, v, bspline3Impulse, bspline3Derivative)
+	return du, dv
+}
+
+// ResampleAt evaluates the surface on the grid spanned by the column positions us
+// and row positions vs, indexed [row][column]
+func (s *Surface) ResampleAt(us, vs []float64) [][]float64 {
+	out := newMatrix(len(vs), len(us))
+	for r, v := range vs {
+		for c, u := range us {
+			out[r][c] = s.At(u, v)
+		}
+	}
+	return out
+}
+
+// Resample evaluates the surface on a rows x cols grid with the corner samples
+// aligned, the 2D counterpart of Interpolate
+func (s *Surface) Resample(rows, cols int) [][]float64 {
+	us := make([]float64, cols)
+	for i := range us {
+		us[i] = outputPosition(i, s.cols, cols)
+	}
+	vs := make([]float64, rows)
+	for i := range vs {
+		vs[i] = outputPosition(i, s.rows, rows)
+	}
+	return s.ResampleAt(us, vs)
+}
+
+// eval sums the coefficients weighted by the basis functions fu along u and fv along v
+func (s *Surface) eval(u, v float64, fu, fv func(float64) float64) float64 {
+	bu := int(math.Floor(u))
+	bv := int(math.Floor(v))
+	var wu [4]float64
+	var iu [4]int
+	for t := range wu {
+		j := bu - 1 + t
+		wu[t] = fu(u - float64(j))
+		iu[t], _ = BoundaryMirror.index(j, s.cols)
+	}
+
+	sum := 0.0
+	for t := 0; t < 4; t++ {
+		j := bv - 1 + t
+		w := fv(v - float64(j))
+		if w == 0 {
+			continue
+		}
+		r, _ := BoundaryMirror.index(j, s.rows)
+		row := 0.0
+		for k := range wu {
+			row += s.coeffs[r][iu[k]] * wu[k]
+		}
+		sum += row * w
+	}
+	return sum
+}
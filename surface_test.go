@@ -0,0 +1,92 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func sampleGrid(rows, cols int, f func(u, v float64) float64) [][]float64 {
+	grid := newMatrix(rows, cols)
+	for r := range grid {
+		for c := range grid[r] {
+			grid[r][c] = f(float64(c), float64(r))
+		}
+	}
+	return grid
+}
+
+func TestSurfaceInterpolatesSamples(t *testing.T) {
+	for _, size := range [][2]int{{1, 1}, {1, 5}, {3, 2}, {8, 40}} {
+		grid := sampleGrid(size[0], size[1], func(u, v float64) float64 {
+			return math.Sin(u*0.7) * math.Cos(v*1.3)
+		})
+		s, err := NewSurface(grid)
+		if err != nil {
+			t.Fatalf("NewSurface() returned unexpected error: %v", err)
+		}
+		for r := range grid {
+			for c := range grid[r] {
+				if got := s.At(float64(c), float64(r)); math.Abs(got-grid[r][c]) > 1e-9 {
+					t.Errorf("%v grid: At(%d, %d) = %v, want %v", size, c, r, got, grid[r][c])
+				}
+			}
+		}
+	}
+}
+
+func TestSurfaceSmoothFunction(t *testing.T) {
+	f := func(u, v float64) float64 { return math.Sin(u/4) + math.Cos(v/5) }
+	s, err := NewSurface(sampleGrid(30, 30, f))
+	if err != nil {
+		t.Fatalf("NewSurface() returned unexpected error: %v", err)
+	}
+	for _, p := range [][2]float64{{10.5, 12.25}, {15.3, 7.8}, {20.1, 20.9}} {
+		if got, want := s.At(p[0], p[1]), f(p[0], p[1]); math.Abs(got-want) > 1e-4 {
+			t.Errorf("At(%v) = %v, want %v", p, got, want)
+		}
+		du, dv := s.Gradient(p[0], p[1])
+		wantDu := math.Cos(p[0]/4) / 4
+		wantDv := -math.Sin(p[1]/5) / 5
+		if math.Abs(du-wantDu) > 1e-3 || math.Abs(dv-wantDv) > 1e-3 {
+			t.Errorf("Gradient(%v) = %v, %v, want %v, %v", p, du, dv, wantDu, wantDv)
+		}
+	}
+}
+
+func TestSurfaceGradientMatchesFiniteDifference(t *testing.T) {
+	grid := [][]float64{{0, 1, 4, 2}, {3, -1, 0, 5}, {2, 2, 1, 0}}
+	s, _ := NewSurface(grid)
+	const h = 1e-6
+	for _, p := range [][2]float64{{0.3, 0.4}, {1.5, 1.5}, {2.9, 1.1}} {
+		du, dv := s.Gradient(p[0], p[1])
+		fdU := (s.At(p[0]+h, p[1]) - s.At(p[0]-h, p[1])) / (2 * h)
+		fdV := (s.At(p[0], p[1]+h) - s.At(p[0], p[1]-h)) / (2 * h)
+		if math.Abs(du-fdU) > 1e-6 || math.Abs(dv-fdV) > 1e-6 {
+			t.Errorf("Gradient(%v) = %v, %v, want %v, %v", p, du, dv, fdU, fdV)
+		}
+	}
+}
+
+func TestSurfaceResample(t *testing.T) {
+	grid := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	s, _ := NewSurface(grid)
+	out := s.Resample(3, 5)
+	if len(out) != 3 || len(out[0]) != 5 {
+		t.Fatalf("Resample() shape = %dx%d, want 3x5", len(out), len(out[0]))
+	}
+	// Bilinear data is reproduced exactly away from the mirrored edges' influence
+	if math.Abs(out[1][2]-3.5) > 1e-9 {
+		t.Errorf("Resample()[1][2] = %v, want 3.5", out[1][2])
+	}
+	if out[0][0] != s.At(0, 0) || out[2][4] != s.At(2, 1) {
+		t.Errorf("Resample() corners = %v, %v, want %v, %v", out[0][0], out[2][4], s.At(0, 0), s.At(2, 1))
+	}
+}
+
+func TestNewSurfaceInvalidInput(t *testing.T) {
+	for _, grid := range [][][]float64{nil, {{}}, {{1, 2}, {3}}} {
+		if _, err := NewSurface(grid); err == nil {
+			t.Errorf("NewSurface(%v) should return an error", grid)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTensionSplineCubicLimit(t *testing.T) {
+	// Zero and tiny tensions give the natural cubic spline
+	in := []float64{0, 3, -1, 4, 2, -2, 5}
+	want, _ := Interpolate(in, 25, CubicSpline)
+	for _, tension := range []float64{0, 1e-5} {
+		got, err := InterpolateWithOptions(in, 25, TensionSpline, Options{Kernel: KernelParams{Tension: tension}})
+		if err != nil {
+			t.Fatalf("InterpolateWithOptions(TensionSpline) returned unexpected error: %v", err)
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-8 {
+				t.Errorf("TensionSpline σ=%v [%d] = %v, want %v", tension, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestTensionSplineOvershoot(t *testing.T) {
+	// A step overshoots with the cubic spline, less with tension and hardly at all
+	// with high tension, which approaches linear interpolation
+	in := []float64{0, 0, 0, 1, 1, 1}
+	overshoot := func(tension float64) float64 {
+		out, _ := InterpolateWithOptions(in, 101, TensionSpline, Options{Kernel: KernelParams{Tension: tension}})
+		worst := 0.0
+		for _, v := range out {
+			worst = max(worst, -v, v-1)
+		}
+		return worst
+	}
+	cubic, some, high := overshoot(0), overshoot(2), overshoot(1000)
+	if !(cubic > some && some > high && high < 1e-3) {
+		t.Errorf("overshoot σ=0: %v, σ=2: %v, σ=1000: %v, want decreasing to nearly 0", cubic, some, high)
+	}
+	linear, _ := Interpolate(in, 101, Linear)
+	out, _ := InterpolateWithOptions(in, 101, TensionSpline, Options{Kernel: KernelParams{Tension: 1000}})
+	for i := range out {
+		if math.Abs(out[i]-linear[i]) > 1e-2 {
+			t.Errorf("TensionSpline σ=1000 [%d] = %v, want about %v", i, out[i], linear[i])
+		}
+	}
+}
+
+func TestTensionSplineContinuity(t *testing.T) {
+	x := []float64{0, 0.5, 2, 2.4, 4, 6}
+	y := []float64{1, -1, 3, 2, 0, 4}
+	for _, tension := range []float64{0, 0.8, 5} {
+		s := newTensionSpline(x, y, tension)
+		for j, xj := range x {
+			if math.Abs(s.at(xj)-y[j]) > 1e-12 {
+				t.Errorf("σ=%v spline at knot %v = %v, want %v", tension, xj, s.at(xj), y[j])
+			}
+		}
+		// Slope and curvature agree across every inner knot
+		for _, xj := range x[1 : len(x)-1] {
+			h := 1e-9
+			_, l1, l2 := s.eval(xj - h)
+			_, r1, r2 := s.eval(xj + h)
+			if math.Abs(l1-r1) > 1e-6 || math.Abs(l2-r2) > 1e-5 {
+				t.Errorf("σ=%v derivatives at knot %v: %v, %v and %v, %v, want continuous", tension, xj, l1, l2, r1, r2)
+			}
+		}
+		// The analytic derivatives match finite differences
+		for _, q := range []float64{0.2, 1.3, 3.1, 5.5} {
+			h := 1e-5
+			want := (s.at(q+h) - s.at(q-h)) / (2 * h)
+			if got := s.derivative(q, 1); math.Abs(got-want) > 1e-6 {
+				t.Errorf("σ=%v derivative at %v = %v, want %v", tension, q, got, want)
+			}
+			want = (s.derivative(q+h, 1) - s.derivative(q-h, 1)) / (2 * h)
+			if got := s.derivative(q, 2); math.Abs(got-want) > 1e-5 {
+				t.Errorf("σ=%v second derivative at %v = %v, want %v", tension, q, got, want)
+			}
+		}
+	}
+	if _, err := InterpolateWithOptions(y, 5, TensionSpline, Options{Kernel: KernelParams{Tension: -1}}); err == nil {
+		t.Error("InterpolateWithOptions() with a negative tension should return an error")
+	}
+}
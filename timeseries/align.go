@@ -0,0 +1,144 @@
+package timeseries
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+// GapPolicy decides what a series contributes at grid times that fall in a gap
+// between its observations or outside the range it was observed over
+type GapPolicy int
+
+const (
+	// GapInterpolate interpolates across every gap and holds the first and last
+	// observations outside the observed range, where Resample would extrapolate
+	GapInterpolate GapPolicy = iota
+	// GapNaN marks gaps and times outside the observed range as NaN
+	GapNaN
+	// GapHold carries the last observation forward through gaps and past the end;
+	// times before the first observation are NaN
+	GapHold
+	// GapZero fills gaps and times outside the observed range with zero
+	GapZero
+)
+
+// Series is an irregularly sampled series to be aligned
+type Series struct {
+	// Times holds the strictly increasing observation times
+	Times  []time.Time
+	Values []float64
+	// Gap is applied wherever the grid time lies outside the observed range or
+	// between two observations more than MaxGap apart
+	Gap GapPolicy
+	// MaxGap is the longest spacing still interpolated across; zero treats only
+	// the times outside the observed range as gaps
+	MaxGap time.Duration
+}
+
+// Grid is a regular grid of Count times starting at Start, Step apart
+type Grid struct {
+	Start time.Time
+	Step  time.Duration
+	Count int
+}
+
+// NewGrid returns the grid of times from start up to and including end, step apart
+func NewGrid(start, end time.Time, step time.Duration) Grid {
+	g := Grid{Start: start, Step: step}
+	if step > 0 && !end.Before(start) {
+		g.Count = int(end.Sub(start)/step) + 1
+	}
+	return g
+}
+
+// Times returns the grid times
+func (g Grid) Times() []time.Time {
+	times := make([]time.Time, g.Count)
+	for i := range times {
+		times[i] = g.Start.Add(time.Duration(i) * g.Step)
+	}
+	return times
+}
+
+// AlignSeries resamples every series onto the grid with the given interpolator and
+// applies each series' gap policy. The result is indexed [grid time][series], one
+// row per grid time ready to be joined into a table.
+func AlignSeries(series []Series, grid Grid, interpolatorType interpolators.InterpolatorType) ([][]float64, error) {
+	if grid.Step <= 0 || grid.Count < 0 {
+		return nil, fmt.Errorf("grid needs a positive step and a non-negative count, got %v and %d", grid.Step, grid.Count)
+	}
+	times := grid.Times()
+	out := make([][]float64, len(times))
+	for i := range out {
+		out[i] = make([]float64, len(series))
+	}
+	for s, ser := range series {
+		column, err := alignOne(ser, times, interpolatorType)
+		if err != nil {
+			return nil, fmt.Errorf("series %d: %w", s, err)
+		}
+		for i, v := range column {
+			out[i][s] = v
+		}
+	}
+	return out, nil
+}
+
+// alignOne resamples a single series onto times and applies its gap policy
+func alignOne(ser Series, times []time.Time, interpolatorType interpolators.InterpolatorType) ([]float64, error) {
+	if ser.MaxGap < 0 {
+		return nil, fmt.Errorf("maximum gap must not be negative, got %v", ser.MaxGap)
+	}
+	switch ser.Gap {
+	case GapInterpolate, GapNaN, GapHold, GapZero:
+	default:
+		return nil, fmt.Errorf("unknown gap policy %d", ser.Gap)
+	}
+	if len(ser.Times) == 0 {
+		return nil, errors.New("at least one observation is required")
+	}
+	column, err := Resample(ser.Times, ser.Values, times, interpolatorType)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(ser.Times)
+	for i, t := range times {
+		// Index of the last observation at or before t
+		j := sort.Search(n, func(k int) bool { return ser.Times[k].After(t) }) - 1
+		switch {
+		case j >= 0 && ser.Times[j].Equal(t):
+			// Observed exactly
+			continue
+		case ser.Gap == GapInterpolate:
+			// Clamped outside the observed range, interpolated inside it
+			if j < 0 {
+				column[i] = ser.Values[0]
+			} else if j == n-1 {
+				column[i] = ser.Values[n-1]
+			}
+			continue
+		case j >= 0 && j < n-1 && (ser.MaxGap == 0 || ser.Times[j+1].Sub(ser.Times[j]) <= ser.MaxGap):
+			// Inside a short enough spacing
+			continue
+		}
+		switch ser.Gap {
+		case GapNaN:
+			column[i] = math.NaN()
+		case GapZero:
+			column[i] = 0
+		case GapHold:
+			if j < 0 {
+				column[i] = math.NaN()
+			} else {
+				column[i] = ser.Values[j]
+			}
+		}
+	}
+	return column, nil
+}
@@ -0,0 +1,114 @@
+package timeseries
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+func TestNewGrid(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	g := NewGrid(start, start.Add(time.Hour), 15*time.Minute)
+	if g.Count != 5 {
+		t.Fatalf("NewGrid().Count = %d, want 5", g.Count)
+	}
+	times := g.Times()
+	if !times[4].Equal(start.Add(time.Hour)) {
+		t.Errorf("Times()[4] = %v, want %v", times[4], start.Add(time.Hour))
+	}
+}
+
+func TestAlignSeries(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes ...int) []time.Time {
+		out := make([]time.Time, len(minutes))
+		for i, m := range minutes {
+			out[i] = start.Add(time.Duration(m) * time.Minute)
+		}
+		return out
+	}
+	// Grid at 0, 10, ..., 60 minutes
+	grid := NewGrid(start, start.Add(time.Hour), 10*time.Minute)
+	nan := math.NaN()
+
+	tests := []struct {
+		name   string
+		series Series
+		want   []float64
+	}{
+		{"interpolate", Series{Times: at(0, 20, 60), Values: []float64{0, 20, 60}}, []float64{0, 10, 20, 30, 40, 50, 60}},
+		{"interpolate clamps outside range", Series{Times: at(10, 20, 30), Values: []float64{1, 2, 4}}, []float64{1, 1, 2, 4, 4, 4, 4}},
+		{"nan outside range", Series{Times: at(10, 30), Values: []float64{1, 3}, Gap: GapNaN}, []float64{nan, 1, 2, 3, nan, nan, nan}},
+		{"nan in gap", Series{Times: at(0, 10, 50, 60), Values: []float64{0, 1, 5, 6}, Gap: GapNaN, MaxGap: 15 * time.Minute}, []float64{0, 1, nan, nan, nan, 5, 6}},
+		{"hold", Series{Times: at(10, 20, 50), Values: []float64{1, 2, 5}, Gap: GapHold, MaxGap: 15 * time.Minute}, []float64{nan, 1, 2, 2, 2, 5, 5}},
+		{"zero", Series{Times: at(20, 40), Values: []float64{2, 4}, Gap: GapZero}, []float64{0, 0, 2, 3, 4, 0, 0}},
+	}
+	series := make([]Series, len(tests))
+	for i, tt := range tests {
+		series[i] = tt.series
+	}
+	got, err := AlignSeries(series, grid, interpolators.Linear)
+	if err != nil {
+		t.Fatalf("AlignSeries() returned unexpected error: %v", err)
+	}
+	if len(got) != grid.Count {
+		t.Fatalf("AlignSeries() returned %d rows, want %d", len(got), grid.Count)
+	}
+	for s, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.want {
+				v := got[i][s]
+				if math.IsNaN(want) != math.IsNaN(v) || (!math.IsNaN(want) && math.Abs(v-want) > 1e-9) {
+					t.Errorf("AlignSeries()[%d][%d] = %v, want %v", i, s, v, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAlignSeriesInterpolateClampsSpline(t *testing.T) {
+	// A spline through y = x² would extrapolate below zero before the first
+	// observation; GapInterpolate holds the end values instead
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	ser := Series{Values: []float64{0, 1, 4, 9, 16}}
+	for m := range ser.Values {
+		ser.Times = append(ser.Times, start.Add(time.Duration(m)*time.Minute))
+	}
+	grid := NewGrid(start.Add(-2*time.Minute), start.Add(6*time.Minute), time.Minute)
+	got, err := AlignSeries([]Series{ser}, grid, interpolators.CubicSpline)
+	if err != nil {
+		t.Fatalf("AlignSeries() returned unexpected error: %v", err)
+	}
+	for i, want := range map[int]float64{0: 0, 1: 0, 2: 0, 6: 16, 7: 16, 8: 16} {
+		if got[i][0] != want {
+			t.Errorf("AlignSeries()[%d] = %v, want %v", i, got[i][0], want)
+		}
+	}
+}
+
+func TestAlignSeriesErrors(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	grid := NewGrid(start, start.Add(time.Hour), time.Minute)
+	ok := Series{Times: []time.Time{start}, Values: []float64{1}}
+
+	tests := []struct {
+		name   string
+		series []Series
+		grid   Grid
+	}{
+		{"zero step", []Series{ok}, Grid{Start: start, Count: 3}},
+		{"empty series", []Series{ok, {}}, grid},
+		{"unknown policy", []Series{{Times: ok.Times, Values: ok.Values, Gap: GapPolicy(9)}}, grid},
+		{"negative gap", []Series{{Times: ok.Times, Values: ok.Values, MaxGap: -time.Second}}, grid},
+		{"length mismatch", []Series{{Times: ok.Times, Values: []float64{1, 2}}}, grid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AlignSeries(tt.series, tt.grid, interpolators.Linear); err == nil {
+				t.Error("AlignSeries() should return an error")
+			}
+		})
+	}
+}
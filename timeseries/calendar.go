@@ -0,0 +1,130 @@
+package timeseries
+
+import (
+	"errors"
+	"time"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+// Calendar describes which days are business (trading) days: every day except the
+// weekend days and the supplied holidays
+type Calendar struct {
+	weekend  [7]bool
+	holidays map[date]bool
+}
+
+// date is a civil date, independent of time of day and location
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{y, m, d}
+}
+
+// NewCalendar creates a calendar with the given holidays, of which only the dates
+// matter. The weekend defaults to Saturday and Sunday.
+func NewCalendar(holidays []time.Time, weekend ...time.Weekday) *Calendar {
+	if len(weekend) == 0 {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	c := &Calendar{holidays: make(map[date]bool, len(holidays))}
+	for _, d := range weekend {
+		c.weekend[d] = true
+	}
+	for _, h := range holidays {
+		c.holidays[dateOf(h)] = true
+	}
+	return c
+}
+
+// IsBusinessDay reports whether the date of t is a business day
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.weekend[t.Weekday()] && !c.holidays[dateOf(t)]
+}
+
+// BusinessDays returns midnight of every business day from the date of start to
+// the date of end inclusive, in the location of start
+func (c *Calendar) BusinessDays(start, end time.Time) []time.Time {
+	days := []time.Time{}
+	for d := midnight(start); !d.After(end); d = d.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// MonthEnds returns midnight of the last business day of every month from the
+// date of start to the date of end, in the location of start. A month is included
+// when its last business day falls within the range.
+func (c *Calendar) MonthEnds(start, end time.Time) []time.Time {
+	ends := []time.Time{}
+	first := midnight(start)
+	for m := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, first.Location()); !m.After(end); m = m.AddDate(0, 1, 0) {
+		d := m.AddDate(0, 1, -1)
+		for !c.IsBusinessDay(d) && !d.Before(m) {
+			d = d.AddDate(0, 0, -1)
+		}
+		if d.Before(m) || d.Before(first) || d.After(end) {
+			continue
+		}
+		ends = append(ends, d)
+	}
+	return ends
+}
+
+// Resample evaluates the series values observed at the strictly increasing times
+// on the grid of times like the package-level Resample, but measures time in
+// business days: weekends and holidays take no time, so an interpolator sees
+// Friday's close and Monday's close as adjacent. A time on a non-business day is
+// treated as the start of the next business day.
+func (c *Calendar) Resample(times []time.Time, values []float64, grid []time.Time, interpolatorType interpolators.InterpolatorType) ([]float64, error) {
+	if len(times) == 0 {
+		return nil, errors.New("at least one observation is required")
+	}
+	origin := midnight(times[0])
+	for _, t := range grid {
+		if t.Before(origin) {
+			origin = midnight(t)
+		}
+	}
+	end := times[len(times)-1]
+	for _, t := range grid {
+		if t.After(end) {
+			end = t
+		}
+	}
+
+	// ordinal maps each date to the number of business days from origin before it
+	ordinal := map[date]float64{}
+	count := 0.0
+	for d := origin; !d.After(end); d = d.AddDate(0, 0, 1) {
+		ordinal[dateOf(d)] = count
+		if c.IsBusinessDay(d) {
+			count++
+		}
+	}
+	businessTime := func(ts []time.Time) []float64 {
+		out := make([]float64, len(ts))
+		for i, t := range ts {
+			out[i] = ordinal[dateOf(t)]
+			if c.IsBusinessDay(t) {
+				day := midnight(t)
+				out[i] += t.Sub(day).Hours() / day.AddDate(0, 0, 1).Sub(day).Hours()
+			}
+		}
+		return out
+	}
+	return interpolators.InterpolateXY(businessTime(times), values, businessTime(grid), interpolatorType)
+}
+
+// midnight returns the start of the day of t in its location
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
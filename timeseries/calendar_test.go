@@ -0,0 +1,105 @@
+package timeseries
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestBusinessDays(t *testing.T) {
+	// Friday 2024-03-29 is a holiday; the range spans a weekend
+	c := NewCalendar([]time.Time{day(2024, 3, 29)})
+	got := c.BusinessDays(day(2024, 3, 27), day(2024, 4, 2).Add(15*time.Hour))
+	want := []time.Time{day(2024, 3, 27), day(2024, 3, 28), day(2024, 4, 1), day(2024, 4, 2)}
+	if len(got) != len(want) {
+		t.Fatalf("BusinessDays() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("BusinessDays()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCustomWeekend(t *testing.T) {
+	c := NewCalendar(nil, time.Friday, time.Saturday)
+	if c.IsBusinessDay(day(2024, 3, 29)) {
+		t.Error("IsBusinessDay(Friday) = true with a Friday-Saturday weekend")
+	}
+	if !c.IsBusinessDay(day(2024, 3, 31)) {
+		t.Error("IsBusinessDay(Sunday) = false with a Friday-Saturday weekend")
+	}
+}
+
+func TestMonthEnds(t *testing.T) {
+	// 2024-06-30 is a Sunday and 2024-08-31 a Saturday
+	c := NewCalendar(nil)
+	got := c.MonthEnds(day(2024, 6, 1), day(2024, 8, 31))
+	want := []time.Time{day(2024, 6, 28), day(2024, 7, 31), day(2024, 8, 30)}
+	if len(got) != len(want) {
+		t.Fatalf("MonthEnds() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("MonthEnds()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	// A range ending before the last business day of its month excludes that month
+	if got := c.MonthEnds(day(2024, 6, 1), day(2024, 6, 20)); len(got) != 0 {
+		t.Errorf("MonthEnds() = %v, want none", got)
+	}
+}
+
+func TestCalendarResampleSkipsWeekend(t *testing.T) {
+	c := NewCalendar(nil)
+	// Daily closes Thursday, Friday, Monday, Tuesday
+	times := []time.Time{day(2024, 3, 7), day(2024, 3, 8), day(2024, 3, 11), day(2024, 3, 12)}
+	values := []float64{10, 11, 12, 13}
+	grid := []time.Time{day(2024, 3, 8).Add(12 * time.Hour), day(2024, 3, 9), day(2024, 3, 11)}
+
+	got, err := c.Resample(times, values, grid, interpolators.Linear)
+	if err != nil {
+		t.Fatalf("Resample() returned unexpected error: %v", err)
+	}
+	// Friday noon is halfway to Monday in business time; Saturday counts as Monday
+	want := []float64{11.5, 12, 12}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("Resample()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// Calendar time spreads the same step over the weekend
+	naive, _ := Resample(times, values, grid[:1], interpolators.Linear)
+	if want := 11 + 0.5/3; math.Abs(naive[0]-want) > 1e-12 {
+		t.Errorf("Resample() = %v, want %v", naive[0], want)
+	}
+}
+
+func TestResampleToTradingDays(t *testing.T) {
+	c := NewCalendar([]time.Time{day(2024, 1, 1)})
+	// Weekly observations resampled onto the trading days between them
+	times := []time.Time{day(2023, 12, 29), day(2024, 1, 5)}
+	values := []float64{0, 4}
+	grid := c.BusinessDays(times[0], times[1])
+	got, err := c.Resample(times, values, grid, interpolators.Linear)
+	if err != nil {
+		t.Fatalf("Resample() returned unexpected error: %v", err)
+	}
+	// Dec 29, Jan 2, 3, 4, 5: four business-day steps of one unit each
+	want := []float64{0, 1, 2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("Resample() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("Resample()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Package timeseries resamples timestamped series with the interpolators of the
+// parent package, including onto business-day calendars.
+package timeseries
+
+import (
+	"errors"
+	"time"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+// Resample evaluates the series values observed at the strictly increasing times
+// on the grid of times using the given interpolator, measuring time in seconds
+func Resample(times []time.Time, values []float64, grid []time.Time, interpolatorType interpolators.InterpolatorType) ([]float64, error) {
+	if len(times) == 0 {
+		return nil, errors.New("at least one observation is required")
+	}
+	origin := times[0]
+	seconds := func(ts []time.Time) []float64 {
+		out := make([]float64, len(ts))
+		for i, t := range ts {
+			out[i] = t.Sub(origin).Seconds()
+		}
+		return out
+	}
+	return interpolators.InterpolateXY(seconds(times), values, seconds(grid), interpolatorType)
+}
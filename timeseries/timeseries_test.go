@@ -0,0 +1,38 @@
+package timeseries
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	interpolators "github.com/schollz/interpolation"
+)
+
+func TestResample(t *testing.T) {
+	start := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	times := []time.Time{start, start.Add(10 * time.Minute), start.Add(30 * time.Minute)}
+	values := []float64{0, 10, 0}
+	grid := []time.Time{start.Add(5 * time.Minute), start.Add(20 * time.Minute), start.Add(25 * time.Minute)}
+
+	got, err := Resample(times, values, grid, interpolators.Linear)
+	if err != nil {
+		t.Fatalf("Resample() returned unexpected error: %v", err)
+	}
+	want := []float64{5, 5, 2.5}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("Resample()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	prev, _ := Resample(times, values, grid, interpolators.PreviousHold)
+	for i, want := range []float64{0, 10, 10} {
+		if prev[i] != want {
+			t.Errorf("Resample(PreviousHold)[%d] = %v, want %v", i, prev[i], want)
+		}
+	}
+
+	if _, err := Resample(nil, nil, grid, interpolators.Linear); err == nil {
+		t.Error("Resample() with no observations should return an error")
+	}
+}
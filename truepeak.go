@@ -0,0 +1,48 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// truePeakRadius gives the true-peak filter 12 taps per phase, the length of the
+// 4x oversampling filter in ITU-R BS.1770
+const truePeakRadius = 6
+
+// TruePeak estimates the true (inter-sample) peak magnitude of in following the
+// ITU-R BS.1770 approach: the signal is upsampled by oversample (4 or 8 are
+// typical) with a polyphase windowed-sinc filter and the largest absolute value of
+// the upsampled signal is taken. Sample peaks under-read inter-sample peaks, so the
+// result is never below the sample peak.
+func TruePeak(in []float64, oversample int) (float64, error) {
+	if oversample < 1 {
+		return 0, fmt.Errorf("oversampling factor must be at least 1, got %d", oversample)
+	}
+
+	peak := 0.0
+	for _, v := range in {
+		peak = math.Max(peak, math.Abs(v))
+	}
+	if oversample == 1 || len(in) < 2 {
+		return peak, nil
+	}
+
+	p, err := newPolyphase(oversample, 1, kernel{impulse: lanczosImpulse(truePeakRadius), radius: truePeakRadius, boundary: BoundaryZero})
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range p.Resample(in) {
+		peak = math.Max(peak, math.Abs(v))
+	}
+	return peak, nil
+}
+
+// TruePeakDB returns the true peak of in in decibels relative to full scale (dBTP),
+// where a magnitude of 1 is 0 dBTP
+func TruePeakDB(in []float64, oversample int) (float64, error) {
+	peak, err := TruePeak(in, oversample)
+	if err != nil {
+		return 0, err
+	}
+	return 20 * math.Log10(peak), nil
+}
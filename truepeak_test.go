@@ -0,0 +1,53 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTruePeakInterSample(t *testing.T) {
+	// A sine at a quarter of the sample rate with a 45 degree phase offset never
+	// has a sample on its crest: every sample reads 1/sqrt(2)
+	in := make([]float64, 400)
+	for i := range in {
+		in[i] = math.Sin(math.Pi/2*float64(i) + math.Pi/4)
+	}
+
+	samplePeak, err := TruePeak(in, 1)
+	if err != nil {
+		t.Fatalf("TruePeak() returned unexpected error: %v", err)
+	}
+	if math.Abs(samplePeak-math.Sqrt2/2) > 1e-10 {
+		t.Errorf("TruePeak(1) = %v, want %v", samplePeak, math.Sqrt2/2)
+	}
+
+	for _, oversample := range []int{4, 8} {
+		peak, err := TruePeak(in, oversample)
+		if err != nil {
+			t.Fatalf("TruePeak() returned unexpected error: %v", err)
+		}
+		if math.Abs(peak-1) > 0.02 {
+			t.Errorf("TruePeak(%d) = %v, want about 1", oversample, peak)
+		}
+	}
+}
+
+func TestTruePeakDB(t *testing.T) {
+	in := []float64{0, 0.5, -1, 0.5, 0}
+	db, err := TruePeakDB(in, 4)
+	if err != nil {
+		t.Fatalf("TruePeakDB() returned unexpected error: %v", err)
+	}
+	if db < 0 {
+		t.Errorf("TruePeakDB() = %v, want at least 0 dBTP for a full-scale sample", db)
+	}
+}
+
+func TestTruePeakErrors(t *testing.T) {
+	if _, err := TruePeak([]float64{1}, 0); err == nil {
+		t.Error("TruePeak() with zero oversampling should return an error")
+	}
+	if peak, err := TruePeak(nil, 4); err != nil || peak != 0 {
+		t.Errorf("TruePeak() on empty input = %v, %v, want 0", peak, err)
+	}
+}
@@ -0,0 +1,62 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// minVarispeedSpeed is the speed below which Varispeed treats playback as stopped,
+// so a speed that only approaches zero still ends the output
+const minVarispeedSpeed = 1e-6
+
+// Varispeed resamples in with a playback speed that changes over time, for pitch
+// bends, tape-stop effects and doppler simulation. speed is called with the current
+// input position and returns how many input samples to advance for the next output
+// sample (1 is unchanged, 2 is an octave up). A phase accumulator walks through the
+// input, so the output ends once the position passes the last input sample or the
+// speed drops to (nearly) zero.
+func Varispeed(in []float64, speed func(pos float64) float64, interpolatorType InterpolatorType) ([]float64, error) {
+	if err := checkEvaluable(interpolatorType); err != nil {
+		return nil, err
+	}
+	if speed == nil {
+		return nil, errors.New("speed function must not be nil")
+	}
+	out := []float64{}
+	if len(in) == 0 {
+		return out, nil
+	}
+
+	f := newEvaluator(in, interpolatorType)
+	last := float64(len(in) - 1)
+	for pos := 0.0; pos <= last; {
+		out = append(out, f(pos))
+		s := speed(pos)
+		if math.IsNaN(s) || s < minVarispeedSpeed {
+			break
+		}
+		pos += s
+	}
+	return out, nil
+}
+
+// VarispeedEnvelope resamples in with speeds given by an envelope spread evenly
+// across the input: envelope[0] applies at the first sample, the last entry at the
+// last sample, and speeds in between are linearly interpolated.
+func VarispeedEnvelope(in, envelope []float64, interpolatorType InterpolatorType) ([]float64, error) {
+	if len(envelope) == 0 {
+		return nil, errors.New("speed envelope must not be empty")
+	}
+	for i, s := range envelope {
+		if s <= 0 {
+			return nil, fmt.Errorf("speed envelope must be positive, envelope[%d] = %v", i, s)
+		}
+	}
+	scale := 0.0
+	if len(in) > 1 {
+		scale = float64(len(envelope)-1) / float64(len(in)-1)
+	}
+	env := newEvaluator(envelope, Linear)
+	return Varispeed(in, func(pos float64) float64 { return env(pos * scale) }, interpolatorType)
+}
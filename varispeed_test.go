@@ -0,0 +1,74 @@
+package interpolators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVarispeedConstantMatchesResample(t *testing.T) {
+	in := make([]float64, 200)
+	for i := range in {
+		in[i] = math.Sin(float64(i) * 0.1)
+	}
+	out, err := Varispeed(in, func(float64) float64 { return 0.75 }, Hermite4)
+	if err != nil {
+		t.Fatalf("Varispeed() returned unexpected error: %v", err)
+	}
+	expected, _ := Resample(in, 3, 4, Hermite4)
+	if len(out) != len(expected) {
+		t.Fatalf("Varispeed() length = %d, want %d", len(out), len(expected))
+	}
+	for i := range out {
+		if math.Abs(out[i]-expected[i]) > 1e-9 {
+			t.Errorf("Varispeed()[%d] = %v, want %v", i, out[i], expected[i])
+			break
+		}
+	}
+}
+
+func TestVarispeedTapeStop(t *testing.T) {
+	in := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	// Speed ramps down to zero at position 4
+	out, err := Varispeed(in, func(pos float64) float64 { return 1 - pos/4 }, Linear)
+	if err != nil {
+		t.Fatalf("Varispeed() returned unexpected error: %v", err)
+	}
+	for i := 1; i < len(out); i++ {
+		if out[i] < out[i-1] {
+			t.Errorf("Varispeed() out[%d] = %v decreased from %v", i, out[i], out[i-1])
+		}
+	}
+	if last := out[len(out)-1]; last > 4 {
+		t.Errorf("Varispeed() last output = %v, want at most 4 where the tape stops", last)
+	}
+}
+
+func TestVarispeedEnvelope(t *testing.T) {
+	in := make([]float64, 101)
+	for i := range in {
+		in[i] = float64(i)
+	}
+	out, err := VarispeedEnvelope(in, []float64{1, 2}, Linear)
+	if err != nil {
+		t.Fatalf("VarispeedEnvelope() returned unexpected error: %v", err)
+	}
+	// Speeding up from 1x to 2x takes fewer outputs than constant 1x but more than 2x
+	if len(out) >= 101 || len(out) <= 51 {
+		t.Errorf("VarispeedEnvelope() length = %d, want between 51 and 101", len(out))
+	}
+	if out[1]-out[0] > 1.01 {
+		t.Errorf("VarispeedEnvelope() first step = %v, want about 1", out[1]-out[0])
+	}
+}
+
+func TestVarispeedErrors(t *testing.T) {
+	if _, err := Varispeed([]float64{1, 2}, nil, Linear); err == nil {
+		t.Error("Varispeed() with nil speed should return an error")
+	}
+	if _, err := VarispeedEnvelope([]float64{1, 2}, nil, Linear); err == nil {
+		t.Error("VarispeedEnvelope() with empty envelope should return an error")
+	}
+	if _, err := VarispeedEnvelope([]float64{1, 2}, []float64{1, 0}, Linear); err == nil {
+		t.Error("VarispeedEnvelope() with zero speed should return an error")
+	}
+}
@@ -0,0 +1,123 @@
+package interpolators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// BuildBandlimitedTables prepares a single-cycle waveform for alias-free wavetable
+// playback. It returns octaves tables of the same length as cycle: table 0 keeps
+// every harmonic below the table's Nyquist bin, and each following table keeps only
+// half the harmonics of the one before, removing the rest exactly in the frequency
+// domain. Higher-pitched notes play from the sparser tables.
+func BuildBandlimitedTables(cycle []float64, octaves int) ([][]float64, error) {
+	n := len(cycle)
+	if n < 4 {
+		return nil, fmt.Errorf("%w: a cycle needs at least 4 samples, got %d", ErrTooFewPoints, n)
+	}
+	top := tableHarmonics(n)
+	if octaves < 1 || top>>(octaves-1) < 1 {
+		return nil, fmt.Errorf("a %d-sample cycle supports 1 to %d octaves, got %d", n, bits.Len(uint(top)), octaves)
+	}
+
+	spectrum := realFFT(cycle)
+	tables := make([][]float64, octaves)
+	band := make([]complex128, n)
+	for k := range tables {
+		keep := top >> k
+		for j := range band {
+			// Bins j and n-j hold harmonic j
+			h := min(j, n-j)
+			if h <= keep {
+				band[j] = spectrum[j]
+			} else {
+				band[j] = 0
+			}
+		}
+		table := fft(band, true)
+		tables[k] = make([]float64, n)
+		for i, v := range table {
+			tables[k][i] = real(v)
+		}
+	}
+	return tables, nil
+}
+
+// tableHarmonics is the highest harmonic a table of n samples holds unambiguously
+func tableHarmonics(n int) int {
+	return (n - 1) / 2
+}
+
+// Wavetable is an oscillator playing band-limited tables from
+// BuildBandlimitedTables. Over the octave below the pitch at which a table's top
+// harmonic would reach the Nyquist frequency, it crossfades from that table to the
+// next sparser one, so a rising sweep neither aliases nor clicks between tables.
+// Notes too high for the sparsest table play it regardless.
+type Wavetable struct {
+	levels     []func(pos float64) float64
+	size       float64
+	top        int
+	sampleRate float64
+	phase      float64 // position within the cycle, in table samples
+}
+
+// NewWavetable creates an oscillator at the given sample rate reading tables, all
+// of the same length, with the interpolator looking up between table samples
+func NewWavetable(tables [][]float64, sampleRate float64, interpolatorType InterpolatorType) (*Wavetable, error) {
+	if len(tables) == 0 {
+		return nil, errors.New("at least one table is required")
+	}
+	if !(sampleRate > 0) {
+		return nil, fmt.Errorf("sample rate must be positive, got %v", sampleRate)
+	}
+	n := len(tables[0])
+	if n < 4 {
+		return nil, fmt.Errorf("%w: tables need at least 4 samples, got %d", ErrTooFewPoints, n)
+	}
+	w := &Wavetable{size: float64(n), top: tableHarmonics(n), sampleRate: sampleRate}
+	for k, table := range tables {
+		if len(table) != n {
+			return nil, fmt.Errorf("table %d has %d samples, want %d", k, len(table), n)
+		}
+		w.levels = append(w.levels, newPeriodicEvaluator(table, interpolatorType))
+	}
+	return w, nil
+}
+
+// Next returns the next sample of a note at frequency freq in Hz and advances the
+// oscillator by one sample
+func (w *Wavetable) Next(freq float64) float64 {
+	// Table k reaches Nyquist at level k; one level above that it must be fully
+	// faded out
+	level := 0.0
+	if freq > 0 {
+		level = math.Max(0, math.Log2(float64(w.top)*2*freq/w.sampleRate)+1)
+	}
+	last := float64(len(w.levels) - 1)
+	level = math.Min(level, last)
+	k := int(level)
+	v := w.levels[k](w.phase)
+	if frac := level - float64(k); frac > 0 {
+		v += frac * (w.levels[k+1](w.phase) - v)
+	}
+
+	w.phase = math.Mod(w.phase+freq*w.size/w.sampleRate, w.size)
+	if w.phase < 0 {
+		w.phase += w.size
+	}
+	return v
+}
+
+// Process fills out with successive samples of a note at frequency freq in Hz
+func (w *Wavetable) Process(out []float64, freq float64) {
+	for i := range out {
+		out[i] = w.Next(freq)
+	}
+}
+
+// Reset restarts the oscillator at the beginning of the cycle
+func (w *Wavetable) Reset() {
+	w.phase = 0
+}
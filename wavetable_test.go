@@ -0,0 +1,110 @@
+package interpolators
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// sawCycle returns one cycle of a naive sawtooth
+func sawCycle(n int) []float64 {
+	cycle := make([]float64, n)
+	for i := range cycle {
+		cycle[i] = 2*float64(i)/float64(n) - 1
+	}
+	return cycle
+}
+
+func TestBuildBandlimitedTables(t *testing.T) {
+	const n = 256
+	tables, err := BuildBandlimitedTables(sawCycle(n), 6)
+	if err != nil {
+		t.Fatalf("BuildBandlimitedTables() returned unexpected error: %v", err)
+	}
+	if len(tables) != 6 {
+		t.Fatalf("BuildBandlimitedTables() returned %d tables, want 6", len(tables))
+	}
+	top := (n - 1) / 2
+	for k, table := range tables {
+		spectrum := realFFT(table)
+		keep := top >> k
+		for h := 1; h <= n/2; h++ {
+			mag := cmplx.Abs(spectrum[h])
+			if h > keep && mag > 1e-9 {
+				t.Errorf("table %d harmonic %d = %v, want removed above %d", k, h, mag, keep)
+			}
+			if h <= keep && mag < 1e-6 {
+				t.Errorf("table %d harmonic %d = %v, want kept", k, h, mag)
+			}
+		}
+	}
+
+	// A cycle already within the band comes back unchanged
+	sine := make([]float64, 64)
+	for i := range sine {
+		sine[i] = math.Sin(2 * math.Pi * 3 * float64(i) / 64)
+	}
+	tables, _ = BuildBandlimitedTables(sine, 3)
+	for i := range sine {
+		if math.Abs(tables[0][i]-sine[i]) > 1e-12 || math.Abs(tables[2][i]-sine[i]) > 1e-12 {
+			t.Errorf("band-limited sine[%d] = %v, %v, want %v", i, tables[0][i], tables[2][i], sine[i])
+		}
+	}
+}
+
+func TestBuildBandlimitedTablesErrors(t *testing.T) {
+	if _, err := BuildBandlimitedTables([]float64{0, 1}, 1); err == nil {
+		t.Error("BuildBandlimitedTables() with a 2-sample cycle should return an error")
+	}
+	if _, err := BuildBandlimitedTables(sawCycle(16), 0); err == nil {
+		t.Error("BuildBandlimitedTables() with no octaves should return an error")
+	}
+	if _, err := BuildBandlimitedTables(sawCycle(16), 4); err == nil {
+		t.Error("BuildBandlimitedTables() with more octaves than harmonics should return an error")
+	}
+}
+
+func TestWavetablePlaysTable(t *testing.T) {
+	tables, _ := BuildBandlimitedTables(sawCycle(256), 7)
+	w, err := NewWavetable(tables, 48000, Hermite4)
+	if err != nil {
+		t.Fatalf("NewWavetable() returned unexpected error: %v", err)
+	}
+	// A low note plays the full-band table, read at freq*256/48000 samples a step
+	const freq = 20
+	f := newPeriodicEvaluator(tables[0], Hermite4)
+	out := make([]float64, 1000)
+	w.Process(out, freq)
+	for i := range out {
+		want := f(float64(i) * freq * 256 / 48000)
+		if math.Abs(out[i]-want) > 1e-9 {
+			t.Fatalf("Next() sample %d = %v, want %v", i, out[i], want)
+		}
+	}
+}
+
+func TestWavetableDoesNotAlias(t *testing.T) {
+	tables, _ := BuildBandlimitedTables(sawCycle(2048), 10)
+	w, _ := NewWavetable(tables, 48000, Lanczos3)
+	// 4800 samples hold exactly f/10 cycles, so harmonics fall on multiples of
+	// bin f/10 and any energy elsewhere is aliasing
+	for _, freq := range []float64{440, 1000, 3000, 7000} {
+		out := make([]float64, 4800)
+		w.Reset()
+		w.Process(out, freq)
+		spectrum := realFFT(out)
+		harmonic := int(freq / 10)
+		var signal, alias float64
+		for b := 1; b < len(spectrum)/2; b++ {
+			p := cmplx.Abs(spectrum[b])
+			if b%harmonic == 0 {
+				signal += p * p
+			} else {
+				alias += p * p
+			}
+		}
+		if ratio := alias / signal; ratio > 1e-4 {
+			t.Errorf("alias to signal ratio at %v Hz = %v, want below 1e-4", freq, ratio)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package interpolators
+
+import (
+	"fmt"
+	"math"
+)
+
+// WhittakerSmooth smooths in with the Whittaker-Eilers smoother, which finds the
+// series z minimizing
+//
+//	Σ w[i] (in[i] - z[i])² + λ Σ (z[i] - 2z[i+1] + z[i+2])²
+//
+// by a banded solve in O(n), so it handles long series quickly. Larger lambda gives
+// smoother output. Weights may be nil for equal weights; a zero weight, or a NaN
+// sample, marks a missing value that the smoother fills in from its neighbors.
+func WhittakerSmooth(in, weights []float64, lambda float64) ([]float64, error) {
+	n := len(in)
+	if weights != nil && len(weights) != n {
+		return nil, fmt.Errorf("sample and weight lengths differ: %d and %d", n, len(weights))
+	}
+	if lambda < 0 {
+		return nil, fmt.Errorf("lambda must not be negative, got %v", lambda)
+	}
+	if n == 0 {
+		return []float64{}, nil
+	}
+	if n < 3 {
+		return nil, fmt.Errorf("%w: the Whittaker smoother needs at least 3 samples, got %d", ErrTooFewPoints, n)
+	}
+
+	band := newMatrix(n, 3)
+	rhs := make([]float64, n)
+	for i, v := range in {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+			if w < 0 {
+				return nil, fmt.Errorf("weight %d is negative: %v", i, w)
+			}
+		}
+		if math.IsNaN(v) {
+			w = 0
+		}
+		band[i][0] = w
+		if w != 0 {
+			rhs[i] = w * v
+		}
+	}
+	// Accumulate λDᵀD, one second difference [1 -2 1] at a time
+	diff := [3]float64{1, -2, 1}
+	for k := 0; k+2 < n; k++ {
+		for a := 0; a < 3; a++ {
+			for b := a; b < 3; b++ {
+				band[k+a][b-a] += lambda * diff[a] * diff[b]
+			}
+		}
+	}
+	z, err := bandSolve(band, rhs)
+	if err != nil {
+		return nil, fmt.Errorf("the Whittaker smoother needs at least two weighted samples, or a positive lambda and three: %w", err)
+	}
+	return z, nil
+}
+
+// InterpolateWhittaker smooths in with WhittakerSmooth, treating NaN samples as
+// missing, and resamples the result like Interpolate
+func InterpolateWhittaker(in []float64, outSamples int, lambda float64, interpolatorType InterpolatorType) ([]float64, error) {
+	if len(in) == 0 {
+		return []float64{}, nil
+	}
+	if outSamples <= 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidOutSamples, outSamples)
+	}
+	z, err := WhittakerSmooth(in, nil, lambda)
+	if err != nil {
+		return nil, err
+	}
+	return Interpolate(z, outSamples, interpolatorType)
+}
@@ -0,0 +1,91 @@
+package interpolators
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWhittakerSmoothKeepsLines(t *testing.T) {
+	// A line has no second differences, so it is its own smoothed series
+	in := []float64{1, 3, 5, 7, 9, 11}
+	got, err := WhittakerSmooth(in, nil, 1000)
+	if err != nil {
+		t.Fatalf("WhittakerSmooth() returned unexpected error: %v", err)
+	}
+	for i := range in {
+		if math.Abs(got[i]-in[i]) > 1e-9 {
+			t.Errorf("WhittakerSmooth()[%d] = %v, want %v", i, got[i], in[i])
+		}
+	}
+}
+
+func TestWhittakerSmoothFillsMissing(t *testing.T) {
+	// Missing samples of a line are filled in on it, whether marked by NaN or by
+	// a zero weight
+	in := []float64{0, 2, math.NaN(), 6, 99, 10, 12}
+	weights := []float64{1, 1, 1, 1, 0, 1, 1}
+	got, err := WhittakerSmooth(in, weights, 10)
+	if err != nil {
+		t.Fatalf("WhittakerSmooth() returned unexpected error: %v", err)
+	}
+	for i, want := range []float64{0, 2, 4, 6, 8, 10, 12} {
+		if math.Abs(got[i]-want) > 1e-9 {
+			t.Errorf("WhittakerSmooth()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestWhittakerSmoothReducesNoise(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	n := 5000
+	in := make([]float64, n)
+	for i := range in {
+		in[i] = math.Sin(float64(i)/300) + 0.3*rng.NormFloat64()
+	}
+	got, err := WhittakerSmooth(in, nil, 1e6)
+	if err != nil {
+		t.Fatalf("WhittakerSmooth() returned unexpected error: %v", err)
+	}
+	var before, after float64
+	for i := range in {
+		clean := math.Sin(float64(i) / 300)
+		before += (in[i] - clean) * (in[i] - clean)
+		after += (got[i] - clean) * (got[i] - clean)
+	}
+	if after > before/20 {
+		t.Errorf("WhittakerSmooth() squared error = %v, want far below the noise %v", after, before)
+	}
+}
+
+func TestInterpolateWhittaker(t *testing.T) {
+	in := []float64{0, 1, math.NaN(), 3, 4}
+	got, err := InterpolateWhittaker(in, 9, 1, Linear)
+	if err != nil {
+		t.Fatalf("InterpolateWhittaker() returned unexpected error: %v", err)
+	}
+	for i, v := range got {
+		if want := float64(i) / 2; math.Abs(v-want) > 1e-9 {
+			t.Errorf("InterpolateWhittaker()[%d] = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestWhittakerSmoothInvalidInput(t *testing.T) {
+	if _, err := WhittakerSmooth([]float64{1, 2}, nil, 1); !errors.Is(err, ErrTooFewPoints) {
+		t.Errorf("WhittakerSmooth() error = %v, want ErrTooFewPoints", err)
+	}
+	if _, err := WhittakerSmooth([]float64{1, 2, 3}, []float64{1, 1}, 1); err == nil {
+		t.Error("WhittakerSmooth() with mismatched weights should return an error")
+	}
+	if _, err := WhittakerSmooth([]float64{1, 2, 3}, []float64{1, -1, 1}, 1); err == nil {
+		t.Error("WhittakerSmooth() with a negative weight should return an error")
+	}
+	if _, err := WhittakerSmooth([]float64{1, 2, 3}, nil, -1); err == nil {
+		t.Error("WhittakerSmooth() with negative lambda should return an error")
+	}
+	if _, err := WhittakerSmooth([]float64{math.NaN(), 2, math.NaN()}, nil, 1); err == nil {
+		t.Error("WhittakerSmooth() with a single valid sample should return an error")
+	}
+}